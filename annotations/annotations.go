@@ -0,0 +1,206 @@
+// Package annotations extracts structured annotations (TODO, FIXME, etc. and
+// deprecation notices) from the comments of source files, e.g. for issue
+// tracker tooling, along with the declarations owning them.
+//
+// The comments are the extra nodes of the syntax trees (as they are for most
+// grammars) and the nodes captured as @comment by the [Rules]; the declarations
+// are the nodes captured as @declaration, named by their @name capture.
+// For instance, for Go:
+//
+//	(function_declaration name: (identifier) @name) @declaration
+//	(type_spec name: (type_identifier) @name) @declaration
+package annotations
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Option configures [NewRules].
+type Option func(*Rules)
+
+// Rules are the annotations rules of a language, see [NewRules].
+type Rules struct {
+	query *sitter.Query
+	re    *regexp.Regexp
+}
+
+// Annotation is an annotation found in a comment, e.g. "TODO(alice): fix it"
+// or "Deprecated: use Bar instead".
+type Annotation struct {
+	// Owner is the declaration owning the annotation, if any.
+	Owner *Declaration
+	Tag   string
+	// Author is the text in parentheses after the tag, if any.
+	Author string
+	Text   string
+	// Comment is the range of the comment holding the annotation.
+	Comment sitter.Range
+	// Position is the position of the tag.
+	Position sitter.Point
+}
+
+// Declaration is a declaration owning annotations.
+type Declaration struct {
+	Name  string
+	Range sitter.Range
+}
+
+// DefaultTags are the annotation tags looked for by default.
+var DefaultTags = []string{"TODO", "FIXME", "XXX", "HACK", "BUG", "NOTE", "Deprecated"} //nolint:gochecknoglobals // ok
+
+// NewRules compiles the annotations rules of a language. The pattern may be
+// empty, if the owning declarations are of no interest.
+func NewRules(lang *sitter.Language, pattern string, opts ...Option) (r *Rules, err error) {
+	r = &Rules{}
+	WithTags(DefaultTags...)(r)
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.query, err = sitter.NewQuery(lang, []byte(pattern)); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// WithTags sets the annotation tags looked for (instead of [DefaultTags]).
+// A tag is matched as a whole word, optionally prefixed with an @ (as in
+// "@deprecated") and followed by a parenthesized author and/or a colon.
+func WithTags(tags ...string) Option {
+	return func(r *Rules) {
+		quoted := make([]string, len(tags))
+		for i, tag := range tags {
+			quoted[i] = regexp.QuoteMeta(tag)
+		}
+
+		r.re = regexp.MustCompile(`(?:^|[^\w@])@?(` + strings.Join(quoted, "|") + `)\b(?:\(([^)]*)\))?:?[ \t]*(.*)`)
+	}
+}
+
+// Scan returns the annotations found in the comments of the file rooted at
+// root, whose source text is src, in order. An annotation is owned by the
+// declaration starting on the row after the comment (i.e. the comment
+// documents it), or else by the innermost declaration containing the comment.
+func (r *Rules) Scan(root sitter.Node, src []byte) (annotations []Annotation) {
+	comments, decls := r.collect(root, src)
+
+	for _, c := range comments {
+		text := c.Content(src)
+		offset := c.StartByte()
+
+		for _, line := range strings.SplitAfter(text, "\n") {
+			if m := r.re.FindStringSubmatchIndex(line); m != nil {
+				a := Annotation{
+					Tag:      line[m[2]:m[3]],
+					Text:     strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(line[m[6]:m[7]]), "*/")),
+					Comment:  c.Range(),
+					Position: position(c.StartPoint(), src[c.StartByte():offset+uint(m[2])]),
+				}
+
+				if m[4] >= 0 {
+					a.Author = line[m[4]:m[5]]
+				}
+
+				a.Owner = owner(decls, a.Comment)
+				annotations = append(annotations, a)
+			}
+
+			offset += uint(len(line))
+		}
+	}
+
+	return
+}
+
+// collect returns the comments, sorted and deduplicated, and the declarations.
+func (r *Rules) collect(root sitter.Node, src []byte) (comments []sitter.Node, decls []*Declaration) {
+	comments = extras(root)
+	names := r.query.CaptureNames()
+	qc := sitter.NewQueryCursor()
+
+	matches := qc.Matches(r.query, root, src)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		var d *Declaration
+
+		for _, capture := range m.Captures {
+			switch names[capture.Index] {
+			case "comment":
+				comments = append(comments, capture.Node)
+			case "declaration":
+				d = &Declaration{Range: capture.Node.Range()}
+				decls = append(decls, d)
+			}
+		}
+
+		for _, capture := range m.Captures {
+			if d != nil && names[capture.Index] == "name" {
+				d.Name = capture.Node.Content(src)
+			}
+		}
+	}
+
+	slices.SortFunc(comments, func(a, b sitter.Node) int { return cmp.Compare(a.StartByte(), b.StartByte()) })
+	comments = slices.CompactFunc(comments, func(a, b sitter.Node) bool { return a.Range() == b.Range() })
+
+	return
+}
+
+// extras returns the named extra nodes (i.e. the comments, for most grammars)
+// of the tree rooted at n, in order.
+func extras(n sitter.Node) (nodes []sitter.Node) {
+	c := sitter.NewTreeCursor(n)
+
+	for {
+		if n = c.CurrentNode(); n.IsExtra() && n.IsNamed() {
+			nodes = append(nodes, n)
+		}
+
+		if c.GoToFirstChild() {
+			continue
+		}
+
+		for !c.GoToNextSibling() {
+			if !c.GoToParent() {
+				return
+			}
+		}
+	}
+}
+
+// owner returns the declaration owning a comment, if any.
+func owner(decls []*Declaration, comment sitter.Range) (d *Declaration) {
+	for _, decl := range decls {
+		if decl.Range.StartByte >= comment.EndByte && decl.Range.StartPoint.Row == comment.EndPoint.Row+1 {
+			return decl
+		}
+	}
+
+	for _, decl := range decls {
+		if decl.Range.StartByte <= comment.StartByte && comment.EndByte <= decl.Range.EndByte &&
+			(d == nil || d.Range.StartByte <= decl.Range.StartByte && decl.Range.EndByte <= d.Range.EndByte) {
+			d = decl
+		}
+	}
+
+	return
+}
+
+// position returns the position reached after the text, starting at p.
+func position(p sitter.Point, text []byte) sitter.Point {
+	for _, b := range text {
+		if b == '\n' {
+			p.Row, p.Column = p.Row+1, 0
+		} else {
+			p.Column++
+		}
+	}
+
+	return p
+}
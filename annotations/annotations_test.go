@@ -0,0 +1,157 @@
+package annotations
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+// rng returns a range spanning the given bytes, from startRow to endRow.
+func rng(start, end, startRow, endRow uint) sitter.Range {
+	return sitter.Range{
+		StartByte: start, EndByte: end,
+		StartPoint: sitter.Point{Row: startRow}, EndPoint: sitter.Point{Row: endRow},
+	}
+}
+
+func TestNewRules(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesScan()")
+}
+
+func TestWithTags(t *testing.T) {
+	t.Parallel()
+
+	r := &Rules{}
+	WithTags("TODO", "deprecated")(r)
+
+	testCases := []struct {
+		line string
+		exp  []string
+	}{
+		{"// TODO: fix it", []string{"TODO", "", "fix it"}},
+		{"# TODO(alice) later", []string{"TODO", "alice", "later"}},
+		{" * @deprecated use Bar", []string{"deprecated", "", "use Bar"}},
+		{"// TODOS: none", nil},
+		{"// xTODO: none", nil},
+		{"// todo: none", nil},
+	}
+
+	for _, tc := range testCases {
+		var act []string
+		if m := r.re.FindStringSubmatch(tc.line); m != nil {
+			act = m[1:]
+		}
+
+		if !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %q for %q, got %q", tc.exp, tc.line, act)
+		}
+	}
+}
+
+func TestRulesScan(t *testing.T) {
+	t.Parallel()
+
+	// In these calc "programs", parenthesized sums are declarations, named
+	// after their first number.
+	rules, err := NewRules(gr,
+		`(expression "(" (expression (sum left: (expression (number) @name)))) @declaration`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	src := []byte("// TODO(bob): split\n(1 + 2) + (3 // FIXME: three\n + 4) // plain\n// Deprecated: use 5\n")
+
+	root, err := sitter.Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	act := []string{}
+
+	for _, a := range rules.Scan(root, src) {
+		owner := "-"
+		if a.Owner != nil {
+			owner = a.Owner.Name
+		}
+
+		act = append(act, fmt.Sprintf("%d:%d %s(%s) %q owned by %s", a.Position.Row, a.Position.Column,
+			a.Tag, a.Author, a.Text, owner))
+	}
+
+	exp := []string{
+		`0:3 TODO(bob) "split" owned by 1`,
+		`1:16 FIXME() "three" owned by 3`,
+		`3:3 Deprecated() "use 5" owned by -`,
+	}
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	if rules, err = NewRules(gr, ""); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := rules.Scan(root, src); len(act) != 3 || act[0].Owner != nil {
+		t.Fatalf("Expected 3 unowned annotations, got %+v", act)
+	}
+}
+
+func TestRulesCollect(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesScan()")
+}
+
+func TestExtras(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesScan()")
+}
+
+func TestOwner(t *testing.T) {
+	t.Parallel()
+
+	decls := []*Declaration{
+		{Name: "outer", Range: rng(0, 100, 0, 10)},
+		{Name: "inner", Range: rng(20, 60, 2, 6)},
+		{Name: "documented", Range: rng(70, 90, 8, 9)},
+	}
+
+	testCases := []struct {
+		comment sitter.Range
+		exp     string
+	}{
+		{rng(10, 15, 1, 1), "inner"},
+		{rng(30, 35, 3, 3), "inner"},
+		{rng(62, 68, 7, 7), "documented"},
+		{rng(65, 68, 6, 6), "outer"},
+		{rng(95, 99, 9, 9), "outer"},
+		{rng(100, 110, 10, 10), "-"},
+	}
+
+	for _, tc := range testCases {
+		act := "-"
+		if d := owner(decls, tc.comment); d != nil {
+			act = d.Name
+		}
+
+		if act != tc.exp {
+			t.Fatalf("Expected %q for %v, got %q", tc.exp, tc.comment, act)
+		}
+	}
+}
+
+func TestPosition(t *testing.T) {
+	t.Parallel()
+
+	exp := sitter.Point{Row: 3, Column: 2}
+	if act := position(sitter.Point{Row: 1, Column: 5}, []byte("ab\n\ncd")); act != exp {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+}
@@ -0,0 +1,196 @@
+// Package anonymize obfuscates source code, e.g. for sharing reproduction
+// cases: it renames the identifiers consistently and strips the comments and
+// the strings' content, leaving the code structure (and validity) intact.
+//
+// What to rename and strip is told by per-language [Rules], tree-sitter
+// queries using the capture names of the locals.scm queries (see the binding
+// package), so that the names are renamed per scope, along with:
+//
+//   - @name: any other identifier, renamed consistently across the file,
+//     regardless of scopes (e.g. when no locals queries are available);
+//   - @comment: a comment, removed;
+//   - @string: the content of a string (without its delimiters), emptied.
+//
+// The references to names not defined in the file (e.g. to builtins or to
+// other packages) are left untouched, and so are their names never given to
+// the renamed identifiers.
+package anonymize
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/binding"
+)
+
+// Option configures [Rules.Anonymize].
+type Option func(*anonymizer)
+
+// Namer returns the n-th (starting at 1) new name, for a definition of the
+// given kind (see [binding.Definition]) or for a @name capture (the kind is
+// "name" then).
+type Namer func(kind string, n int) string
+
+// Rules are the anonymization rules of a language, see [NewRules].
+type Rules struct {
+	binding *binding.Rules
+	query   *sitter.Query
+}
+
+// anonymizer holds the state of one anonymization.
+type anonymizer struct {
+	namer Namer
+	taken map[string]bool
+	edits []edit
+	n     int
+}
+
+// edit replaces the bytes [start, end) of the source with text.
+type edit struct {
+	text       string
+	start, end uint
+}
+
+// NewRules compiles the anonymization rules of a language.
+func NewRules(lang *sitter.Language, pattern string) (*Rules, error) {
+	br, err := binding.NewRules(lang, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := sitter.NewQuery(lang, []byte(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rules{binding: br, query: q}, nil
+}
+
+// WithNamer sets the namer of the renamed identifiers. By default, they are
+// named after the kind of their definition (v for none) and a counter, e.g.
+// function1, v2, etc.
+func WithNamer(f Namer) Option {
+	return func(a *anonymizer) {
+		a.namer = f
+	}
+}
+
+// Anonymize returns the anonymized source text of the file rooted at root,
+// whose source text is src.
+func (r *Rules) Anonymize(root sitter.Node, src []byte, opts ...Option) []byte {
+	a := &anonymizer{namer: defaultNamer, taken: map[string]bool{}}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	fg := r.binding.Build("", root, src)
+	ix := binding.NewIndex(fg)
+	renamed := map[binding.Definition]string{}
+
+	for _, ref := range fg.References {
+		if len(ix.Resolve("", ref)) == 0 {
+			a.taken[ref.Name] = true
+		}
+	}
+
+	// Definitions of the same name in the same scope share their new name.
+	for _, d := range fg.Definitions {
+		key := binding.Definition{Name: d.Name, Scope: d.Scope}
+		if _, ok := renamed[key]; !ok {
+			renamed[key] = a.name(d.Kind)
+		}
+
+		a.replace(d.Range, renamed[key])
+	}
+
+	for _, ref := range fg.References {
+		if locs := ix.Resolve("", ref); len(locs) > 0 {
+			d := locs[0].Definition
+			a.replace(ref.Range, renamed[binding.Definition{Name: d.Name, Scope: d.Scope}])
+		}
+	}
+
+	names := map[string]string{}
+	captures := r.query.CaptureNames()
+	qc := sitter.NewQueryCursor()
+
+	matches := qc.Matches(r.query, root, src)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		for _, c := range m.Captures {
+			switch captures[c.Index] {
+			case "name":
+				name := c.Node.Content(src)
+				if _, ok := names[name]; !ok {
+					names[name] = a.name("name")
+				}
+
+				a.replace(c.Node.Range(), names[name])
+			case "comment":
+				a.replace(c.Node.Range(), separator(src, c.Node.Range()))
+			case "string":
+				a.replace(c.Node.Range(), "")
+			}
+		}
+	}
+
+	return apply(src, a.edits)
+}
+
+// name returns a new name, for the given kind.
+func (a *anonymizer) name(kind string) (name string) {
+	for {
+		a.n++
+
+		if name = a.namer(kind, a.n); !a.taken[name] {
+			a.taken[name] = true
+			return
+		}
+	}
+}
+
+// replace records the replacement of the range with text.
+func (a *anonymizer) replace(rng sitter.Range, text string) {
+	a.edits = append(a.edits, edit{start: rng.StartByte, end: rng.EndByte, text: text})
+}
+
+// defaultNamer is the default [Namer].
+func defaultNamer(kind string, n int) string {
+	return fmt.Sprintf("%s%d", cmp.Or(kind, "v"), n)
+}
+
+// separator returns what to replace the comment spanning rng with: a space if
+// it separates two tokens (which would be glued otherwise), nothing if not.
+func separator(src []byte, rng sitter.Range) string {
+	isSpace := func(i uint) bool { return i >= uint(len(src)) || strings.ContainsRune(" \t\r\n", rune(src[i])) }
+
+	if rng.StartByte == 0 || isSpace(rng.StartByte-1) || isSpace(rng.EndByte) {
+		return ""
+	}
+
+	return " "
+}
+
+// apply applies the edits to src. The edits overlapping a previous one (by
+// start, then in recording order) are dropped, e.g. the renaming of a node
+// captured as both a reference and a @name, or of an identifier in a comment.
+func apply(src []byte, edits []edit) (out []byte) {
+	slices.SortStableFunc(edits, func(a, b edit) int {
+		return cmp.Or(cmp.Compare(a.start, b.start), cmp.Compare(b.end, a.end))
+	})
+
+	pos := uint(0)
+
+	for i, e := range edits {
+		if e.start < pos || (i > 0 && e.start == edits[i-1].start) {
+			continue
+		}
+
+		out = append(append(out, src[pos:e.start]...), e.text...)
+		pos = e.end
+	}
+
+	return append(out, src[pos:]...)
+}
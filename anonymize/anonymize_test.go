@@ -0,0 +1,129 @@
+package anonymize
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestNewRules(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesAnonymize()")
+}
+
+func TestWithNamer(t *testing.T) {
+	t.Parallel()
+
+	a := &anonymizer{}
+	WithNamer(func(string, int) string { return "x" })(a)
+
+	if act := a.namer("", 1); act != "x" {
+		t.Fatal("Expected x, got", act)
+	}
+}
+
+func TestRulesAnonymize(t *testing.T) {
+	t.Parallel()
+
+	// In these calc "programs", parenthesized expressions are scopes and the
+	// numbers on the left of a sum define the ones on the right. Numbers are
+	// renamed to numbers, so that the output is valid.
+	rules, err := NewRules(gr, `
+(expression "(") @local.scope
+(sum left: (expression (number) @local.definition))
+(sum right: (expression (number) @local.reference))
+(comment) @comment
+`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	namer := WithNamer(func(_ string, n int) string { return strconv.Itoa(n * 10) })
+
+	testCases := []struct {
+		src, exp string
+	}{
+		{"1 + 2", "10 + 2"},
+		{"5 + (5 + 5) + 5 // five\n", "10 + (20 + 20) + 10 \n"},
+		{"5 + (5 + 20) + 5", "10 + (30 + 20) + 10"},
+		{"7 + (8 + 7)", "10 + (20 + 10)"},
+	}
+
+	for _, tc := range testCases {
+		root, err := sitter.Parse(context.Background(), []byte(tc.src), gr)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if act := string(rules.Anonymize(root, []byte(tc.src), namer)); act != tc.exp {
+			t.Fatalf("Expected %q for %q, got %q", tc.exp, tc.src, act)
+		}
+	}
+}
+
+func TestAnonymizerName(t *testing.T) {
+	t.Parallel()
+
+	a := &anonymizer{namer: defaultNamer, taken: map[string]bool{"v2": true, "function3": true}}
+
+	for _, tc := range []struct{ kind, exp string }{{"", "v1"}, {"", "v3"}, {"function", "function4"}} {
+		if act := a.name(tc.kind); act != tc.exp {
+			t.Fatalf("Expected %q, got %q", tc.exp, act)
+		}
+	}
+}
+
+func TestAnonymizerReplace(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestDefaultNamer(t *testing.T) {
+	t.Parallel()
+
+	if act := defaultNamer("", 1) + " " + defaultNamer("method", 2); act != "v1 method2" {
+		t.Fatal("Expected v1 method2, got", act)
+	}
+}
+
+func TestSeparator(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		src        string
+		start, end uint
+		exp        string
+	}{
+		{"a/**/b", 1, 5, " "},
+		{"a /**/b", 2, 6, ""},
+		{"a/**/ b", 1, 5, ""},
+		{"/**/b", 0, 4, ""},
+		{"a/**/", 1, 5, ""},
+	} {
+		if act := separator([]byte(tc.src), sitter.Range{StartByte: tc.start, EndByte: tc.end}); act != tc.exp {
+			t.Fatalf("Expected %q for %q, got %q", tc.exp, tc.src, act)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	edits := []edit{
+		{start: 8, end: 9, text: "z"},
+		{start: 0, end: 3, text: "x"},
+		{start: 4, end: 7, text: ""},
+		{start: 0, end: 3, text: "y"},
+		{start: 5, end: 6, text: "w"},
+	}
+
+	if act := string(apply([]byte("foo bar baz"), edits)); act != "x  zaz" {
+		t.Fatalf("Expected %q, got %q", "x  zaz", act)
+	}
+}
@@ -0,0 +1,152 @@
+//go:build asan
+
+package sitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"testing"
+)
+
+// The stress tests below are only built by `go test -asan` (see `make test-asan`),
+// which compiles the vendored C code with the address sanitizer, so that memory
+// bugs at the cgo boundary (i.e. double frees or use after free) make them crash.
+
+const stressRounds = 200
+
+func TestStressParse(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("1 + (2 + 3) // c\n4 + x")
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	var old *Tree
+
+	for i := range stressRounds {
+		if old != nil {
+			old.Edit(InputEdit{
+				OldEndIndex: 1, NewEndIndex: 1,
+				OldEndPoint: Point{Column: 1}, NewEndPoint: Point{Column: 1},
+			})
+		}
+
+		tree, err := p.ParseString(context.Background(), old, src)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if old != nil {
+			_ = tree.GetChangedRanges(old)
+		}
+
+		_ = tree.RootNode().String()
+		_ = tree.RootNode().SexpWithFields()
+		_ = tree.IncludedRanges()
+		_ = tree.Copy().RootNode().Child(0)
+
+		if old = tree; i%50 == 0 {
+			runtime.GC()
+		}
+	}
+}
+
+func TestStressIncludedRanges(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("1 + 2\n//3 + 5\n//7")
+	ranges := []Range{
+		{StartByte: 8, EndByte: 13, StartPoint: Point{Row: 1, Column: 2}, EndPoint: Point{Row: 1, Column: 7}},
+		{StartByte: 16, EndByte: 17, StartPoint: Point{Row: 2, Column: 2}, EndPoint: Point{Row: 2, Column: 3}},
+	}
+
+	for range stressRounds {
+		p := NewParser()
+		p.SetLanguage(gr)
+		p.SetIncludedRanges(ranges)
+		_ = p.IncludedRanges()
+
+		tree, err := p.ParseString(context.Background(), nil, src)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		_ = tree.IncludedRanges()
+		_ = tree.UncoveredRanges(src)
+	}
+}
+
+func TestStressQuery(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("1 + (2 + 3) // c")
+
+	root, err := Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for i := range stressRounds {
+		q, err := NewQuery(gr, []byte(`((number) @n (#eq? @n "2")) (sum left: (_) @l) @s (comment) @c`))
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		qc := NewQueryCursor()
+
+		matches := qc.Matches(q, root, src)
+		for m := matches.Next(); m != nil; m = matches.Next() {
+			_ = m.Captures[0].Node.Content(src)
+		}
+
+		captures := qc.Captures(q, root, src)
+		for m, j := captures.Next(); m != nil; m, j = captures.Next() {
+			_ = m.Captures[j].Node.Type()
+		}
+
+		if i%50 == 0 {
+			runtime.GC()
+		}
+	}
+}
+
+func TestStressCursorsAndIterators(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + (2 + 3) // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for i := range stressRounds {
+		c := NewTreeCursor(root)
+		for c.GoToFirstChild() {
+			_ = c.CurrentFieldName()
+		}
+
+		c2 := c.Copy()
+		c.Reset(root)
+		c2.ResetTo(c)
+
+		for _, mode := range []IterMode{DFS, BFS, DFSNamed, BFSNamed} {
+			if err = NewIterator(root, mode).ForEach(func(Node) error { return nil }); !errors.Is(err, io.EOF) {
+				t.Fatal("Expected io.EOF, got", err)
+			}
+		}
+
+		it := NewLookaheadIterator(gr, root.ParseState())
+		for it.Next() {
+			_ = it.CurrentSymbolName()
+		}
+
+		it.Delete()
+
+		_ = WalkStats(root)
+
+		if i%50 == 0 {
+			runtime.GC()
+		}
+	}
+}
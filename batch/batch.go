@@ -0,0 +1,94 @@
+// Package batch collects the results of the batch operations (e.g. scanning a
+// workspace or running queries over its files), so that they can return what
+// they completed before their context was done (e.g. the user typed again),
+// along with a [Partial] error:
+//
+//	paths, err := s.FilesCtx(ctx, root)
+//	if p := (*batch.Partial)(nil); errors.As(err, &p) {
+//		// Show the paths found so far.
+//	}
+package batch
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// Partial is the error returned along with the results of a batch which did
+// not complete, its context being done. It wraps the context's error (e.g.
+// [context.Canceled]).
+type Partial struct {
+	Err error
+}
+
+func (p *Partial) Error() string {
+	return "partial results: " + p.Err.Error()
+}
+
+func (p *Partial) Unwrap() error {
+	return p.Err
+}
+
+// Results collects the results of a batch. It is safe for concurrent use.
+type Results[T any] struct {
+	items []T
+	mu    sync.Mutex
+}
+
+// Add adds the given results.
+func (r *Results[T]) Add(items ...T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.items = append(r.items, items...)
+}
+
+// Len returns the number of results collected so far.
+func (r *Results[T]) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.items)
+}
+
+// Done returns the results collected, along with err, which becomes a
+// [Partial] error if it is nil or caused by the context being done, once it
+// is. The other errors are returned as they are.
+func (r *Results[T]) Done(ctx context.Context, err error) ([]T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		switch {
+		case err == nil:
+			err = &Partial{Err: ctxErr}
+		case errors.Is(err, ctxErr):
+			err = &Partial{Err: err}
+		}
+	}
+
+	return slices.Clip(r.items), err
+}
+
+// Collect collects the results of seq, until it ends, fails, or ctx is done,
+// see [Results.Done].
+func Collect[T any](ctx context.Context, seq iter.Seq2[T, error]) ([]T, error) {
+	r := &Results[T]{}
+
+	for item, err := range seq {
+		if err != nil {
+			return r.Done(ctx, err)
+		}
+
+		r.Add(item)
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return r.Done(ctx, nil)
+}
@@ -0,0 +1,116 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"reflect"
+	"testing"
+)
+
+func TestPartialError(t *testing.T) {
+	t.Parallel()
+
+	err := error(&Partial{Err: context.Canceled})
+	if exp := "partial results: context canceled"; err.Error() != exp {
+		t.Fatalf("Expected %q, got %q", exp, err)
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected %v to wrap %v", err, context.Canceled)
+	}
+}
+
+func TestPartialUnwrap(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestResultsAdd(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestResultsLen(t *testing.T) {
+	t.Parallel()
+
+	r := &Results[int]{}
+	r.Add(1, 2)
+	r.Add(3)
+
+	if act := r.Len(); act != 3 {
+		t.Fatalf("Expected 3, got %d", act)
+	}
+}
+
+func TestResultsDone(t *testing.T) {
+	t.Parallel()
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errFailed := errors.New("failed")
+	errWrapped := errors.Join(errors.New("truncated"), context.Canceled)
+
+	testCases := []struct {
+		ctx     context.Context //nolint:containedctx // ok
+		err     error
+		exp     error
+		partial bool
+	}{
+		{context.Background(), nil, nil, false},
+		{context.Background(), errFailed, errFailed, false},
+		{cancelled, nil, context.Canceled, true},
+		{cancelled, errWrapped, errWrapped, true},
+		{cancelled, errFailed, errFailed, false},
+	}
+
+	for _, tc := range testCases {
+		r := &Results[int]{}
+		r.Add(1)
+
+		act, err := r.Done(tc.ctx, tc.err)
+		if !reflect.DeepEqual(act, []int{1}) {
+			t.Fatalf("Expected [1], got %v", act)
+		}
+
+		var partial *Partial
+		if !errors.Is(err, tc.exp) || errors.As(err, &partial) != tc.partial {
+			t.Fatalf("Expected %v (partial: %v), got %v", tc.exp, tc.partial, err)
+		}
+	}
+}
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	seq := func(yield func(int, error) bool) {
+		for i := range 5 {
+			if i == 2 {
+				cancel()
+			}
+
+			if !yield(i, nil) {
+				return
+			}
+		}
+	}
+
+	var partial *Partial
+
+	if act, err := Collect(ctx, seq); !errors.As(err, &partial) || !reflect.DeepEqual(act, []int{0, 1, 2}) {
+		t.Fatalf("Expected [0 1 2] and a partial error, got %v and %v", act, err)
+	}
+
+	var failing iter.Seq2[int, error] = func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(0, errFailed)
+	}
+
+	act, err := Collect(context.Background(), failing)
+	if !errors.Is(err, errFailed) || !reflect.DeepEqual(act, []int{1}) {
+		t.Fatalf("Expected [1] and %v, got %v and %v", errFailed, act, err)
+	}
+}
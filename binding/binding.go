@@ -0,0 +1,176 @@
+// Package binding resolves names (references to definitions) across files, in
+// the spirit of stack graphs: each file is turned into a partial graph of its
+// scopes, definitions and references by per-language [Rules], independently
+// of the other files, and the partial graphs are then combined by an [Index]
+// for resolving references. Updating a file only rebuilds its own graph.
+//
+// The rules are tree-sitter queries using the capture names of the locals.scm
+// queries (with or without the "local." prefix):
+//
+//   - @scope: a node introducing a scope (the file is the root scope);
+//   - @definition (or @definition.kind, e.g. @definition.function): a node
+//     defining a name, in the innermost scope containing it;
+//   - @reference: a node referring to a name.
+//
+// A reference resolves to the definitions of the same name in the innermost
+// scope (containing it) that has any. If none does, it resolves to the
+// definitions of that name in the root scope of the other files, which are
+// thus exported.
+package binding
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Rules are the binding rules of a language, see [NewRules].
+type Rules struct {
+	query *sitter.Query
+}
+
+// FileGraph is the partial graph of a file, as built by [Rules.Build]. It only
+// holds plain data, so it can be persisted (e.g. as JSON) and loaded back.
+type FileGraph struct {
+	Path string
+	// Hash is the hash of the source text the graph was built from, see
+	// [HashSource].
+	Hash string
+	// Scopes are sorted by their start, then by their end (descending), so
+	// that a scope comes before the scopes it contains. The first one is the
+	// root scope.
+	Scopes      []Scope
+	Definitions []Definition
+	References  []Reference
+}
+
+// Scope is a scope of a [FileGraph].
+type Scope struct {
+	Range sitter.Range
+	// Parent is the index of the parent scope (-1 for the root scope).
+	Parent int
+}
+
+// Definition is a definition of a name.
+type Definition struct {
+	Name string
+	// Kind is the suffix of the capture name (e.g. "function" for
+	// @definition.function), if any.
+	Kind  string
+	Range sitter.Range
+	// Scope is the index of the scope the name is defined in.
+	Scope int
+}
+
+// Reference is a reference to a name.
+type Reference struct {
+	Name  string
+	Range sitter.Range
+	// Scope is the index of the innermost scope containing the reference.
+	Scope int
+}
+
+// NewRules compiles the binding rules of a language.
+func NewRules(lang *sitter.Language, pattern string) (*Rules, error) {
+	q, err := sitter.NewQuery(lang, []byte(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rules{query: q}, nil
+}
+
+// Build builds the partial graph of the file at path, whose tree is rooted at
+// root and source text is src.
+func (r *Rules) Build(path string, root sitter.Node, src []byte) *FileGraph {
+	fg := &FileGraph{Path: path, Hash: HashSource(src), Scopes: []Scope{{Range: root.Range(), Parent: -1}}}
+	names := r.query.CaptureNames()
+
+	type capture struct {
+		node sitter.Node
+		name string
+	}
+
+	var defs, refs []capture
+
+	qc := sitter.NewQueryCursor()
+
+	matches := qc.Matches(r.query, root, src)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		for _, c := range m.Captures {
+			name := strings.TrimPrefix(names[c.Index], "local.")
+
+			switch kind, _ := strings.CutPrefix(name, "definition"); {
+			case name == "scope":
+				if rng := c.Node.Range(); rng != fg.Scopes[0].Range {
+					fg.Scopes = append(fg.Scopes, Scope{Range: rng})
+				}
+			case name == "reference":
+				refs = append(refs, capture{node: c.Node})
+			case kind == "" || kind[0] == '.':
+				defs = append(defs, capture{node: c.Node, name: strings.TrimPrefix(kind, ".")})
+			}
+		}
+	}
+
+	slices.SortFunc(fg.Scopes[1:], func(a, b Scope) int {
+		return cmp.Or(cmp.Compare(a.Range.StartByte, b.Range.StartByte), cmp.Compare(b.Range.EndByte, a.Range.EndByte))
+	})
+	fg.Scopes = slices.CompactFunc(fg.Scopes, func(a, b Scope) bool { return a.Range == b.Range })
+
+	for i := 1; i < len(fg.Scopes); i++ {
+		fg.Scopes[i].Parent = fg.scopeOf(fg.Scopes[i].Range, i)
+	}
+
+	for _, d := range defs {
+		rng := d.node.Range()
+		fg.Definitions = append(fg.Definitions, Definition{
+			Name: d.node.Content(src), Kind: d.name, Range: rng, Scope: fg.scopeOf(rng, len(fg.Scopes)),
+		})
+	}
+
+	for _, ref := range refs {
+		rng := ref.node.Range()
+		fg.References = append(fg.References, Reference{
+			Name: ref.node.Content(src), Range: rng, Scope: fg.scopeOf(rng, len(fg.Scopes)),
+		})
+	}
+
+	return fg
+}
+
+// ReferenceAt returns the reference spanning the given byte offset, if any.
+func (fg *FileGraph) ReferenceAt(offset uint) (ref Reference, ok bool) {
+	for _, ref = range fg.References {
+		if ref.Range.StartByte <= offset && offset < ref.Range.EndByte {
+			return ref, true
+		}
+	}
+
+	return Reference{}, false
+}
+
+// DefinitionAt returns the definition spanning the given byte offset, if any.
+func (fg *FileGraph) DefinitionAt(offset uint) (def Definition, ok bool) {
+	for _, def = range fg.Definitions {
+		if def.Range.StartByte <= offset && offset < def.Range.EndByte {
+			return def, true
+		}
+	}
+
+	return Definition{}, false
+}
+
+// scopeOf returns the index of the innermost scope (among the first n ones)
+// containing the range.
+func (fg *FileGraph) scopeOf(rng sitter.Range, n int) (scope int) {
+	for i, s := range fg.Scopes[:n] {
+		if s.Range.StartByte <= rng.StartByte && rng.EndByte <= s.Range.EndByte {
+			scope = i
+		}
+	}
+
+	return
+}
@@ -0,0 +1,146 @@
+package binding
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+// rng returns a range spanning the given bytes, on the first row.
+func rng(start, end uint) sitter.Range {
+	return sitter.Range{
+		StartByte: start, EndByte: end,
+		StartPoint: sitter.Point{Column: start}, EndPoint: sitter.Point{Column: end},
+	}
+}
+
+func TestNewRules(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesBuild()")
+}
+
+func TestRulesBuild(t *testing.T) {
+	t.Parallel()
+
+	// In these calc "programs", parenthesized expressions are scopes and the
+	// numbers on the left of a sum define the ones on the right.
+	rules, err := NewRules(gr, `
+(expression "(") @local.scope
+(sum left: (expression (number) @local.definition.number))
+(sum right: (expression (number) @local.reference))
+`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	build := func(path, src string) *FileGraph {
+		root, err := sitter.Parse(context.Background(), []byte(src), gr)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		return rules.Build(path, root, []byte(src))
+	}
+
+	a := build("a.calc", "1 + (1 + 2) + (3 + (4 + 1)) + (7 + 7)")
+	ix := NewIndex(a, build("b.calc", "2 + 5"), build("c.calc", "9 + (2 + 3)"))
+
+	resolve := func(ix *Index) (act []string) {
+		for _, ref := range a.References {
+			locs := []string{}
+			for _, loc := range ix.Resolve(a.Path, ref) {
+				locs = append(locs, fmt.Sprintf("%s:%d", loc.Path, loc.Definition.Range.StartByte))
+			}
+
+			act = append(act, fmt.Sprintf("%s@%d->%v", ref.Name, ref.Range.StartByte, locs))
+		}
+
+		return
+	}
+
+	// The 2 of c.calc is not exported, as it is not in its root scope.
+	exp := []string{"2@9->[b.calc:0]", "1@24->[a.calc:0]", "7@35->[a.calc:31]"}
+	if act := resolve(ix); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+
+	refs := ix.References(Location{Path: "a.calc", Definition: a.Definitions[0]})
+	if len(refs) != 1 || refs[0].Path != "a.calc" || refs[0].Reference.Range.StartByte != 24 {
+		t.Fatalf("Expected the reference at a.calc:24, got %v", refs)
+	}
+
+	buf := &bytes.Buffer{}
+	if err = ix.Save(buf); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	loaded, err := Load(buf)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := resolve(loaded); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v after loading, got %v", exp, act)
+	}
+
+	loaded.Update(build("b.calc", "3 + 5"))
+
+	exp[0] = "2@9->[]"
+	if act := resolve(loaded); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v after updating, got %v", exp, act)
+	}
+}
+
+func TestFileGraphReferenceAt(t *testing.T) {
+	t.Parallel()
+
+	fg := &FileGraph{References: []Reference{{Name: "a", Range: rng(2, 3)}, {Name: "bc", Range: rng(5, 7)}}}
+
+	for offset, exp := range map[uint]string{0: "", 2: "a", 3: "", 5: "bc", 6: "bc", 7: ""} {
+		if ref, ok := fg.ReferenceAt(offset); ref.Name != exp || ok != (exp != "") {
+			t.Fatalf("Expected %q at %d, got %q, %v", exp, offset, ref.Name, ok)
+		}
+	}
+}
+
+func TestFileGraphDefinitionAt(t *testing.T) {
+	t.Parallel()
+
+	fg := &FileGraph{Definitions: []Definition{{Name: "a", Range: rng(2, 3)}, {Name: "bc", Range: rng(5, 7)}}}
+
+	for offset, exp := range map[uint]string{0: "", 2: "a", 3: "", 5: "bc", 6: "bc", 7: ""} {
+		if def, ok := fg.DefinitionAt(offset); def.Name != exp || ok != (exp != "") {
+			t.Fatalf("Expected %q at %d, got %q, %v", exp, offset, def.Name, ok)
+		}
+	}
+}
+
+func TestFileGraphScopeOf(t *testing.T) {
+	t.Parallel()
+
+	fg := &FileGraph{Scopes: []Scope{{Range: rng(0, 20)}, {Range: rng(2, 10)}, {Range: rng(4, 6)}, {Range: rng(12, 18)}}}
+
+	for _, tc := range []struct {
+		rng      sitter.Range
+		n, scope int
+	}{
+		{rng(0, 1), 4, 0},
+		{rng(3, 4), 4, 1},
+		{rng(4, 5), 4, 2},
+		{rng(4, 5), 2, 1},
+		{rng(7, 14), 4, 0},
+		{rng(13, 14), 4, 3},
+	} {
+		if act := fg.scopeOf(tc.rng, tc.n); act != tc.scope {
+			t.Fatalf("Expected scope %d for %v, got %d", tc.scope, tc.rng, act)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package binding
+
+import (
+	"slices"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// CallRules are the rules for extracting the call graph of a language, see
+// [NewCallRules].
+type CallRules struct {
+	query *sitter.Query
+}
+
+// CallGraph is the call graph of a set of files, see [CallRules.CallGraph].
+type CallGraph struct {
+	Functions []Function
+	Calls     []Call
+}
+
+// Function is a function (or method, etc.) definition.
+type Function struct {
+	Name string
+	Path string
+	// Range is the range of the whole definition, NameRange the one of its name.
+	Range     sitter.Range
+	NameRange sitter.Range
+}
+
+// Call is a call site, i.e. a set of caller→callee edges of a [CallGraph].
+type Call struct {
+	Name string
+	Path string
+	// Range is the range of the call expression.
+	Range sitter.Range
+	// Caller is the index of the innermost function containing the call, or
+	// -1 for calls outside of any function.
+	Caller int
+	// Callees are the indexes of the functions the call resolves to.
+	Callees []int
+}
+
+// NewCallRules compiles the call graph rules of a language. They are tree-sitter
+// queries using the capture names of the tags.scm queries: @definition.function
+// (or @definition.method) for the definitions and @reference.call for the
+// calls, each with a @name capture for the name.
+func NewCallRules(lang *sitter.Language, pattern string) (*CallRules, error) {
+	q, err := sitter.NewQuery(lang, []byte(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	return &CallRules{query: q}, nil
+}
+
+// CallGraph extracts the call graph of the files. If an index is given, the
+// calls are resolved through it (see [Index.Resolve]), as long as the called
+// name is one of its references. Otherwise, or if the index has nothing for
+// the call, the calls are resolved by name, to all the functions having it.
+func (r *CallRules) CallGraph(files []sitter.ParsedFile, ix *Index) *CallGraph {
+	g := &CallGraph{}
+	names := r.query.CaptureNames()
+	nameOffsets := []uint{} // Of the calls.
+	qc := sitter.NewQueryCursor()
+
+	for i := range files {
+		f := &files[i]
+
+		matches := qc.Matches(r.query, f.Root, f.Content)
+		for m := matches.Next(); m != nil; m = matches.Next() {
+			var name, def, call *sitter.Node
+
+			for j, c := range m.Captures {
+				switch names[c.Index] {
+				case "name":
+					name = &m.Captures[j].Node
+				case "definition.function", "definition.method":
+					def = &m.Captures[j].Node
+				case "reference.call":
+					call = &m.Captures[j].Node
+				}
+			}
+
+			switch {
+			case name == nil:
+			case def != nil:
+				g.Functions = append(g.Functions, Function{
+					Name: name.Content(f.Content), Path: f.Path, Range: def.Range(), NameRange: name.Range(),
+				})
+			case call != nil:
+				g.Calls = append(g.Calls, Call{Name: name.Content(f.Content), Path: f.Path, Range: call.Range()})
+				nameOffsets = append(nameOffsets, name.StartByte())
+			}
+		}
+	}
+
+	for i := range g.Calls {
+		c := &g.Calls[i]
+		c.Caller = g.caller(c)
+		c.Callees = g.resolve(c, nameOffsets[i], ix)
+	}
+
+	return g
+}
+
+// Callers returns the calls to the function (by index).
+func (g *CallGraph) Callers(fn int) (calls []Call) {
+	for _, c := range g.Calls {
+		if slices.Contains(c.Callees, fn) {
+			calls = append(calls, c)
+		}
+	}
+
+	return
+}
+
+// Callees returns the calls made by the function (by index, -1 for the calls
+// made outside of any function).
+func (g *CallGraph) Callees(fn int) (calls []Call) {
+	for _, c := range g.Calls {
+		if c.Caller == fn {
+			calls = append(calls, c)
+		}
+	}
+
+	return
+}
+
+// caller returns the index of the innermost function containing the call.
+func (g *CallGraph) caller(c *Call) (caller int) {
+	caller = -1
+
+	for i, fn := range g.Functions {
+		if fn.Path != c.Path || fn.Range.StartByte > c.Range.StartByte || c.Range.EndByte > fn.Range.EndByte {
+			continue
+		}
+
+		if caller < 0 || fn.Range.StartByte >= g.Functions[caller].Range.StartByte {
+			caller = i
+		}
+	}
+
+	return
+}
+
+// resolve returns the functions the call (whose name starts at offset)
+// resolves to, through the index if possible, by name otherwise.
+func (g *CallGraph) resolve(c *Call, offset uint, ix *Index) (callees []int) {
+	if ix != nil {
+		if fg, ok := ix.File(c.Path); ok {
+			if ref, ok := fg.ReferenceAt(offset); ok {
+				for _, loc := range ix.Resolve(c.Path, ref) {
+					if i := g.function(loc.Path, loc.Definition.Range.StartByte); i >= 0 {
+						callees = append(callees, i)
+					}
+				}
+
+				return
+			}
+		}
+	}
+
+	for i, fn := range g.Functions {
+		if fn.Name == c.Name {
+			callees = append(callees, i)
+		}
+	}
+
+	return
+}
+
+// function returns the index of the function whose name starts at the given
+// offset of the file at path, -1 if none.
+func (g *CallGraph) function(path string, offset uint) int {
+	return slices.IndexFunc(g.Functions, func(fn Function) bool {
+		return fn.Path == path && fn.NameRange.StartByte == offset
+	})
+}
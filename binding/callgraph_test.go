@@ -0,0 +1,164 @@
+package binding
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+func TestNewCallRules(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestCallRulesCallGraph()")
+}
+
+func TestCallRulesCallGraph(t *testing.T) {
+	t.Parallel()
+
+	// In these calc "programs", parenthesized sums are functions, named by
+	// their left number, and the numbers on the right of sums are calls.
+	fnQuery := `(expression "(" (expression (sum left: (expression (number) @%s)))) %s`
+	callQuery := `(sum right: (expression (number) @%s)) %s`
+
+	callRules, err := NewCallRules(gr, fmt.Sprintf(fnQuery, "name", "@definition.function")+
+		fmt.Sprintf(callQuery, "name", "@reference.call"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	rules, err := NewRules(gr, fmt.Sprintf(fnQuery, "local.definition.function", "")+
+		fmt.Sprintf(callQuery, "local.reference", ""))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	files := []sitter.ParsedFile{}
+	ix := NewIndex()
+
+	for path, src := range map[string]string{"a.calc": "(1 + 2) + (2 + 1) + 3", "b.calc": "(3 + 4)", "c.calc": "9 + (1 + 5)"} {
+		root, err := sitter.Parse(context.Background(), []byte(src), gr)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		files = append(files, sitter.ParsedFile{Root: root, Path: path, Content: []byte(src)})
+		ix.Update(rules.Build(path, root, []byte(src)))
+	}
+
+	slices.SortFunc(files, func(a, b sitter.ParsedFile) int { return strings.Compare(a.Path, b.Path) })
+
+	format := func(g *CallGraph) (act []string) {
+		fn := func(i int) string {
+			if i < 0 {
+				return "-"
+			}
+
+			return fmt.Sprintf("%s:%s", g.Functions[i].Path, g.Functions[i].Name)
+		}
+
+		for _, c := range g.Calls {
+			callees := []string{}
+			for _, i := range c.Callees {
+				callees = append(callees, fn(i))
+			}
+
+			act = append(act, fmt.Sprintf("%s@%d %s->%v", c.Name, c.Range.StartByte, fn(c.Caller), callees))
+		}
+
+		return
+	}
+
+	// The calls are: 2 in a's 1, 1 in a's 2, 3 at the top of a, 4 in b's 3
+	// and 5 in c's 1.
+	exp := []string{
+		"2@1 a.calc:1->[a.calc:2]", "1@11 a.calc:2->[a.calc:1 c.calc:1]", "3@0 -->[b.calc:3]",
+		"4@1 b.calc:3->[]", "5@5 c.calc:1->[]",
+	}
+
+	g := callRules.CallGraph(files, nil)
+	if act := format(g); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected\n%v\ngot\n%v", exp, act)
+	}
+
+	exp[1] = "1@11 a.calc:2->[a.calc:1]"
+	if act := format(callRules.CallGraph(files, ix)); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected\n%v\ngot\n%v", exp, act)
+	}
+
+	if callers := g.Callers(0); len(callers) != 1 || callers[0].Name != "1" {
+		t.Fatalf("Expected one call of a.calc:1, got %v", callers)
+	}
+
+	if callees := g.Callees(-1); len(callees) != 1 || callees[0].Name != "3" {
+		t.Fatalf("Expected one top level call, got %v", callees)
+	}
+}
+
+func TestCallGraphCallers(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestCallRulesCallGraph()")
+}
+
+func TestCallGraphCallees(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestCallRulesCallGraph()")
+}
+
+func TestCallGraphCaller(t *testing.T) {
+	t.Parallel()
+
+	g := &CallGraph{Functions: []Function{
+		{Path: "a", Range: rng(0, 20)},
+		{Path: "a", Range: rng(5, 10)},
+		{Path: "b", Range: rng(0, 20)},
+	}}
+
+	for _, tc := range []struct {
+		call Call
+		exp  int
+	}{
+		{Call{Path: "a", Range: rng(1, 2)}, 0},
+		{Call{Path: "a", Range: rng(6, 7)}, 1},
+		{Call{Path: "a", Range: rng(8, 12)}, 0},
+		{Call{Path: "b", Range: rng(6, 7)}, 2},
+		{Call{Path: "c", Range: rng(6, 7)}, -1},
+	} {
+		if act := g.caller(&tc.call); act != tc.exp {
+			t.Fatalf("Expected caller %d for %+v, got %d", tc.exp, tc.call, act)
+		}
+	}
+}
+
+func TestCallGraphResolve(t *testing.T) {
+	t.Parallel()
+
+	g := &CallGraph{Functions: []Function{
+		{Name: "f", Path: "a", NameRange: rng(0, 1)},
+		{Name: "f", Path: "b", NameRange: rng(0, 1)},
+	}}
+	ix := NewIndex(&FileGraph{
+		Path:        "a",
+		Scopes:      []Scope{{Range: rng(0, 9), Parent: -1}},
+		Definitions: []Definition{{Name: "f", Range: rng(0, 1)}},
+		References:  []Reference{{Name: "f", Range: rng(4, 5)}},
+	})
+
+	call := &Call{Name: "f", Path: "a"}
+
+	if act, exp := g.resolve(call, 4, nil), []int{0, 1}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v by name, got %v", exp, act)
+	}
+
+	if act, exp := g.resolve(call, 4, ix), []int{0}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v through the index, got %v", exp, act)
+	}
+}
+
+func TestCallGraphFunction(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
@@ -0,0 +1,157 @@
+package binding
+
+import (
+	"encoding/json"
+	"io"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// Index combines the partial graphs of a set of files, for resolving the
+// references across them. It is safe for concurrent use.
+type Index struct {
+	files map[string]*FileGraph
+	mu    sync.RWMutex
+}
+
+// Location is a definition, along with the path of its file.
+type Location struct {
+	Path       string
+	Definition Definition
+}
+
+// ReferenceLocation is a reference, along with the path of its file.
+type ReferenceLocation struct {
+	Path      string
+	Reference Reference
+}
+
+// NewIndex creates an index of the given partial graphs.
+func NewIndex(graphs ...*FileGraph) *Index {
+	ix := &Index{files: map[string]*FileGraph{}}
+
+	for _, fg := range graphs {
+		ix.files[fg.Path] = fg
+	}
+
+	return ix
+}
+
+// Load loads an index saved with [Index.Save].
+func Load(r io.Reader) (*Index, error) {
+	var graphs []*FileGraph
+
+	if err := json.NewDecoder(r).Decode(&graphs); err != nil {
+		return nil, err
+	}
+
+	return NewIndex(graphs...), nil
+}
+
+// Save saves the index (i.e. the partial graphs of its files) as JSON.
+func (ix *Index) Save(w io.Writer) error {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	graphs := make([]*FileGraph, 0, len(ix.files))
+	for _, path := range slices.Sorted(maps.Keys(ix.files)) {
+		graphs = append(graphs, ix.files[path])
+	}
+
+	return json.NewEncoder(w).Encode(graphs)
+}
+
+// Update adds the partial graph of a file, replacing the previous one (if any).
+func (ix *Index) Update(fg *FileGraph) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.files[fg.Path] = fg
+}
+
+// Remove removes the file at path from the index.
+func (ix *Index) Remove(path string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	delete(ix.files, path)
+}
+
+// File returns the partial graph of the file at path, if indexed.
+func (ix *Index) File(path string) (fg *FileGraph, ok bool) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	fg, ok = ix.files[path]
+
+	return
+}
+
+// Resolve returns the definitions the reference (of the file at path)
+// resolves to: the ones in the innermost enclosing scope defining the name,
+// or else the ones exported by the other files, sorted by path.
+func (ix *Index) Resolve(path string, ref Reference) (locs []Location) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	if fg, ok := ix.files[path]; ok && ref.Scope < len(fg.Scopes) {
+		for scope := ref.Scope; scope >= 0 && len(locs) == 0; scope = fg.Scopes[scope].Parent {
+			for _, d := range fg.Definitions {
+				if d.Scope == scope && d.Name == ref.Name {
+					locs = append(locs, Location{Path: path, Definition: d})
+				}
+			}
+		}
+
+		if len(locs) > 0 {
+			return
+		}
+	}
+
+	for _, other := range slices.Sorted(maps.Keys(ix.files)) {
+		if other != path {
+			locs = append(locs, ix.exported(other, ref.Name)...)
+		}
+	}
+
+	return
+}
+
+// References returns the references (of all the files) resolving to the given
+// definition, sorted by path.
+func (ix *Index) References(loc Location) (refs []ReferenceLocation) {
+	ix.mu.RLock()
+	paths := slices.Sorted(maps.Keys(ix.files))
+	ix.mu.RUnlock()
+
+	for _, path := range paths {
+		fg, ok := ix.File(path)
+		if !ok {
+			continue
+		}
+
+		for _, ref := range fg.References {
+			if ref.Name != loc.Definition.Name {
+				continue
+			}
+
+			if slices.Contains(ix.Resolve(path, ref), loc) {
+				refs = append(refs, ReferenceLocation{Path: path, Reference: ref})
+			}
+		}
+	}
+
+	return
+}
+
+// exported returns the definitions of the name in the root scope of the file.
+func (ix *Index) exported(path, name string) (locs []Location) {
+	for _, d := range ix.files[path].Definitions {
+		if d.Scope == 0 && d.Name == name {
+			locs = append(locs, Location{Path: path, Definition: d})
+		}
+	}
+
+	return
+}
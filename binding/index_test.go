@@ -0,0 +1,115 @@
+package binding
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewIndex(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Load(strings.NewReader("nope")); err == nil {
+		t.Fatal("Expected an error")
+	}
+}
+
+func TestIndexSave(t *testing.T) {
+	t.Parallel()
+
+	fg := &FileGraph{
+		Path:        "a",
+		Scopes:      []Scope{{Range: rng(0, 9), Parent: -1}},
+		Definitions: []Definition{{Name: "x", Kind: "var", Range: rng(0, 1)}},
+		References:  []Reference{{Name: "x", Range: rng(4, 5)}},
+	}
+
+	buf := &bytes.Buffer{}
+	if err := NewIndex(fg).Save(buf); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	ix, err := Load(buf)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act, ok := ix.File("a"); !ok || !reflect.DeepEqual(act, fg) {
+		t.Fatalf("Expected %+v, got %+v", fg, act)
+	}
+}
+
+func TestIndexUpdate(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesBuild()")
+}
+
+func TestIndexRemove(t *testing.T) {
+	t.Parallel()
+
+	ix := NewIndex(&FileGraph{Path: "a"}, &FileGraph{Path: "b"})
+	ix.Remove("a")
+
+	if _, ok := ix.File("a"); ok {
+		t.Fatal("Expected a to be removed")
+	}
+
+	if _, ok := ix.File("b"); !ok {
+		t.Fatal("Expected b to be kept")
+	}
+}
+
+func TestIndexFile(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestIndexResolve(t *testing.T) {
+	t.Parallel()
+
+	// a: x = 1; { x = 2; y; x }; z
+	a := &FileGraph{
+		Path:   "a",
+		Scopes: []Scope{{Range: rng(0, 30), Parent: -1}, {Range: rng(8, 24)}},
+		Definitions: []Definition{
+			{Name: "x", Range: rng(0, 1)},
+			{Name: "x", Range: rng(10, 11), Scope: 1},
+		},
+		References: []Reference{
+			{Name: "y", Range: rng(17, 18), Scope: 1},
+			{Name: "x", Range: rng(20, 21), Scope: 1},
+			{Name: "z", Range: rng(26, 27)},
+		},
+	}
+	b := &FileGraph{Path: "b", Scopes: a.Scopes, Definitions: []Definition{
+		{Name: "y", Range: rng(0, 1)},
+		{Name: "z", Range: rng(10, 11), Scope: 1},
+	}}
+	ix := NewIndex(a, b)
+
+	for i, exp := range [][]Location{
+		{{Path: "b", Definition: b.Definitions[0]}},
+		{{Path: "a", Definition: a.Definitions[1]}},
+		nil,
+	} {
+		if act := ix.Resolve("a", a.References[i]); !reflect.DeepEqual(act, exp) {
+			t.Fatalf("Expected %v for %s, got %v", exp, a.References[i].Name, act)
+		}
+	}
+}
+
+func TestIndexReferences(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesBuild()")
+}
+
+func TestIndexExported(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
@@ -0,0 +1,84 @@
+package binding
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// FindReferences streams the references to the definition (at loc) found in
+// the files of a workspace, by running the reference patterns of the rules
+// over them (see [sitter.RunQueryOverFiles]), in the order of the files.
+//
+// The candidates (the references of the definition's name) of the files the
+// index has the graph of are resolved through it (see [Index.Resolve]). The
+// ones of the other files, or of all of them if ix is nil, are matched by name
+// alone, with their scope left to 0.
+//
+// If the search is cut short (e.g. by ctx being done), the error is yielded
+// last, along with the path of the file it happened in.
+func (r *Rules) FindReferences(ctx context.Context, files []sitter.ParsedFile, ix *Index,
+	loc Location,
+) iter.Seq2[ReferenceLocation, error] {
+	names := r.query.CaptureNames()
+
+	return func(yield func(ReferenceLocation, error) bool) {
+		var (
+			path string
+			seen map[sitter.Range]bool
+		)
+
+		for fm := range sitter.RunQueryOverFiles(ctx, r.query, files, 0) {
+			if fm.Err != nil {
+				yield(ReferenceLocation{Path: fm.File.Path}, fm.Err)
+				return
+			}
+
+			if fm.File.Path != path {
+				path, seen = fm.File.Path, map[sitter.Range]bool{}
+			}
+
+			for _, c := range fm.Captures {
+				if strings.TrimPrefix(names[c.Index], "local.") != "reference" {
+					continue
+				}
+
+				ref := Reference{Name: c.Node.Content(fm.File.Content), Range: c.Node.Range()}
+				if ref.Name != loc.Definition.Name || seen[ref.Range] {
+					continue
+				}
+
+				seen[ref.Range] = true
+
+				ref, ok := resolvesTo(ix, path, ref, loc)
+				if ok && !yield(ReferenceLocation{Path: path, Reference: ref}, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// resolvesTo tells whether the reference (of the file at path) resolves to the
+// definition at loc, through the index if it has the file's graph (returning
+// the reference from it then), by name otherwise.
+func resolvesTo(ix *Index, path string, ref Reference, loc Location) (Reference, bool) {
+	if ix == nil {
+		return ref, true
+	}
+
+	fg, ok := ix.File(path)
+	if !ok {
+		return ref, true
+	}
+
+	indexed, ok := fg.ReferenceAt(ref.Range.StartByte)
+	if !ok || indexed.Range != ref.Range {
+		return ref, false
+	}
+
+	return indexed, slices.Contains(ix.Resolve(path, indexed), loc)
+}
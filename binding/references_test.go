@@ -0,0 +1,88 @@
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+func TestRulesFindReferences(t *testing.T) {
+	t.Parallel()
+
+	// Same rules as in TestBindingResolve.
+	rules, err := NewRules(gr, `
+(expression "(") @local.scope
+(sum left: (expression (number) @local.definition.number))
+(sum right: (expression (number) @local.reference))
+`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	files := []sitter.ParsedFile{}
+	ix := NewIndex()
+
+	for _, f := range [][2]string{
+		{"a.calc", "1 + (1 + 2) + (3 + (4 + 1)) + (7 + 7)"},
+		{"b.calc", "2 + 5"},
+		{"c.calc", "9 + (2 + 2)"},
+		{"d.calc", "5 + 2"}, // Not indexed.
+	} {
+		root, err := sitter.Parse(context.Background(), []byte(f[1]), gr)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		files = append(files, sitter.ParsedFile{Root: root, Path: f[0], Content: []byte(f[1])})
+
+		if f[0] != "d.calc" {
+			ix.Update(rules.Build(f[0], root, []byte(f[1])))
+		}
+	}
+
+	b, _ := ix.File("b.calc")
+	loc := Location{Path: "b.calc", Definition: b.Definitions[0]}
+
+	find := func(ctx context.Context, ix *Index) (act []string, err error) {
+		for ref, err := range rules.FindReferences(ctx, files, ix, loc) {
+			if err != nil {
+				return act, err
+			}
+
+			act = append(act, fmt.Sprintf("%s:%d", ref.Path, ref.Reference.Range.StartByte))
+		}
+
+		return
+	}
+
+	// The 2 on the right in c.calc resolves to its own 2, on the left.
+	exp := []string{"a.calc:9", "d.calc:4"}
+	if act, err := find(context.Background(), ix); err != nil || !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v (%v)", exp, act, err)
+	}
+
+	exp = []string{"a.calc:9", "c.calc:9", "d.calc:4"}
+	if act, err := find(context.Background(), nil); err != nil || !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v without an index, got %v (%v)", exp, act, err)
+	}
+
+	for range rules.FindReferences(context.Background(), files, ix, loc) {
+		break
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := find(ctx, ix); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestResolvesTo(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesFindReferences()")
+}
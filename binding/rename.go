@@ -0,0 +1,188 @@
+package binding
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Edit is a text edit: the replacement of the text in Range by NewText.
+type Edit struct {
+	Range   sitter.Range
+	NewText string
+}
+
+// Rename errors.
+var (
+	ErrNoSymbol       = errors.New("no symbol to rename")
+	ErrInvalidName    = errors.New("invalid name")
+	ErrRenameConflict = errors.New("rename conflict")
+)
+
+// RenameSymbol returns the edits renaming the symbol at the given position of
+// the document to newName, in order: the definitions the reference there
+// resolves to (or the definition there, along with the other ones of its name
+// in its scope) and all the references resolving to them. Only the document
+// itself is renamed: for the exported definitions, see [Index.References] for
+// the references of the other files.
+//
+// The edits are validated, failing with:
+//
+//   - [ErrNoSymbol] if there is no definition (or resolved reference) there;
+//   - [ErrInvalidName] if the new name does not parse as the same definitions
+//     and references, in the edited text;
+//   - [ErrRenameConflict] if the new name is already bound in the scopes
+//     involved, i.e. if renaming would change what any reference resolves to,
+//     or merge the renamed definitions with other ones.
+//
+// Apply them in reverse order (e.g. with [sitter.Document.ApplyEdit]), for
+// their offsets to remain valid.
+func (r *Rules) RenameSymbol(ctx context.Context, doc *sitter.Document, pos sitter.Point,
+	newName string,
+) ([]Edit, error) {
+	offset, err := doc.Offset(pos)
+	if err != nil {
+		return nil, err
+	}
+
+	src := doc.Text()
+	fg := r.Build("", doc.RootNode(), src)
+	ix := NewIndex(fg)
+
+	var defs []Definition
+
+	if ref, ok := fg.ReferenceAt(offset); ok {
+		for _, loc := range ix.Resolve("", ref) {
+			defs = append(defs, loc.Definition)
+		}
+	} else if def, ok := fg.DefinitionAt(offset); ok {
+		for _, d := range fg.Definitions {
+			if d.Name == def.Name && d.Scope == def.Scope {
+				defs = append(defs, d)
+			}
+		}
+	}
+
+	if len(defs) == 0 {
+		return nil, fmt.Errorf("%w at %d:%d", ErrNoSymbol, pos.Row+1, pos.Column+1)
+	}
+
+	if newName == defs[0].Name {
+		return nil, nil
+	}
+
+	renamed := map[sitter.Range]bool{}
+
+	for _, d := range defs {
+		renamed[d.Range] = true
+
+		for _, ref := range ix.References(Location{Definition: d}) {
+			renamed[ref.Reference.Range] = true
+		}
+	}
+
+	if err = checkConflicts(fg, defs, renamed, newName); err != nil {
+		return nil, err
+	}
+
+	edits := make([]Edit, 0, len(renamed))
+	for rng := range renamed {
+		edits = append(edits, Edit{Range: rng, NewText: newName})
+	}
+
+	slices.SortFunc(edits, func(a, b Edit) int { return cmp.Compare(a.Range.StartByte, b.Range.StartByte) })
+
+	if err = r.checkName(ctx, doc.RootNode().Language(), src, edits, len(defs[0].Name)); err != nil {
+		return nil, err
+	}
+
+	return edits, nil
+}
+
+// checkConflicts checks that renaming the definitions (defs) and references
+// at the given ranges to newName neither merges the definitions with others,
+// nor changes what any reference resolves to.
+func checkConflicts(fg *FileGraph, defs []Definition, renamed map[sitter.Range]bool, newName string) error {
+	for _, d := range fg.Definitions {
+		if d.Name == newName && slices.ContainsFunc(defs, func(r Definition) bool { return r.Scope == d.Scope }) {
+			p := d.Range.StartPoint
+			return fmt.Errorf("%w: %q is already defined at %d:%d", ErrRenameConflict, newName, p.Row+1, p.Column+1)
+		}
+	}
+
+	after := &FileGraph{
+		Scopes:      fg.Scopes,
+		Definitions: slices.Clone(fg.Definitions),
+		References:  slices.Clone(fg.References),
+	}
+
+	for i, d := range after.Definitions {
+		if renamed[d.Range] {
+			after.Definitions[i].Name = newName
+		}
+	}
+
+	for i, ref := range after.References {
+		if renamed[ref.Range] {
+			after.References[i].Name = newName
+		}
+	}
+
+	ixBefore, ixAfter := NewIndex(fg), NewIndex(after)
+
+	for i, ref := range fg.References {
+		if !sameDefinitions(ixBefore.Resolve("", ref), ixAfter.Resolve("", after.References[i])) {
+			p := ref.Range.StartPoint
+			return fmt.Errorf("%w: the reference at %d:%d would resolve differently", ErrRenameConflict, p.Row+1, p.Column+1)
+		}
+	}
+
+	return nil
+}
+
+// sameDefinitions tells whether the locations are of the same definitions
+// (i.e. at the same ranges), their names aside.
+func sameDefinitions(a, b []Location) bool {
+	return slices.EqualFunc(a, b, func(a, b Location) bool { return a.Definition.Range == b.Definition.Range })
+}
+
+// checkName checks that the text edited parses as the same definitions and
+// references, renamed (from a name of oldLen bytes).
+func (r *Rules) checkName(ctx context.Context, lang *sitter.Language, src []byte, edits []Edit, oldLen int) error {
+	text := make([]byte, 0, len(src))
+	last := uint(0)
+
+	for _, e := range edits {
+		text = append(append(text, src[last:e.Range.StartByte]...), e.NewText...)
+		last = e.Range.EndByte
+	}
+
+	text = append(text, src[last:]...)
+
+	root, err := sitter.Parse(ctx, text, lang)
+	if err != nil {
+		return err
+	}
+
+	fg := r.Build("", root, text)
+	delta := len(edits[0].NewText) - oldLen
+
+	for i, e := range edits {
+		start := uint(int(e.Range.StartByte) + i*delta)
+
+		def, isDef := fg.DefinitionAt(start)
+		ref, isRef := fg.ReferenceAt(start)
+
+		if !(isDef && def.Name == e.NewText && def.Range.StartByte == start) &&
+			!(isRef && ref.Name == e.NewText && ref.Range.StartByte == start) {
+			p := e.Range.StartPoint
+			return fmt.Errorf("%w: %q does not parse as a name at %d:%d", ErrInvalidName, e.NewText, p.Row+1, p.Column+1)
+		}
+	}
+
+	return nil
+}
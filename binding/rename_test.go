@@ -0,0 +1,100 @@
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+func TestRulesRenameSymbol(t *testing.T) {
+	t.Parallel()
+
+	// Same rules as in TestBindingResolve.
+	rules, err := NewRules(gr, `
+(expression "(") @local.scope
+(sum left: (expression (number) @local.definition.number))
+(sum right: (expression (number) @local.reference))
+`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	src := "1 + (1 + 2) + (3 + (4 + 1)) + (7 + 7)"
+
+	testCases := []struct {
+		column  uint
+		newName string
+		exp     []uint
+		expText string
+		expErr  error
+	}{
+		{24, "8", []uint{0, 24}, "8 + (1 + 2) + (3 + (4 + 8)) + (7 + 7)", nil},
+		{0, "12", []uint{0, 24}, "12 + (1 + 2) + (3 + (4 + 12)) + (7 + 7)", nil},
+		{31, "5", []uint{31, 35}, "1 + (1 + 2) + (3 + (4 + 1)) + (5 + 5)", nil},
+		{24, "1", nil, src, nil},
+		{24, "4", nil, "", ErrRenameConflict},
+		{24, "3", nil, "", ErrRenameConflict},
+		{5, "2", nil, "", ErrRenameConflict},
+		{24, "x", nil, "", ErrInvalidName},
+		{1, "8", nil, "", ErrNoSymbol},
+		{9, "8", nil, "", ErrNoSymbol},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%d %s", tc.column, tc.newName), func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			doc, err := sitter.NewDocument(ctx, gr, []byte(src))
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			edits, err := rules.RenameSymbol(ctx, doc, sitter.Point{Column: tc.column}, tc.newName)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("Expected error %v, got %v", tc.expErr, err)
+			} else if err != nil {
+				return
+			}
+
+			act := []uint{}
+			for _, e := range edits {
+				act = append(act, e.Range.StartByte)
+			}
+
+			if len(act) != len(tc.exp) || !slices.Equal(act, tc.exp) {
+				t.Fatalf("Expected edits at %v, got %v", tc.exp, act)
+			}
+
+			for _, e := range slices.Backward(edits) {
+				if _, err = doc.ApplyEdit(ctx, e.Range.StartByte, e.Range.EndByte, []byte(e.NewText)); err != nil {
+					t.Fatal("Expected no error, got", err)
+				}
+			}
+
+			if act := string(doc.Text()); act != tc.expText {
+				t.Fatalf("Expected %q, got %q", tc.expText, act)
+			}
+		})
+	}
+}
+
+func TestCheckConflicts(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesRenameSymbol()")
+}
+
+func TestSameDefinitions(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRulesCheckName(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesRenameSymbol()")
+}
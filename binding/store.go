@@ -0,0 +1,175 @@
+package binding
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// IndexFormat is the version of the on-disk index format, see [Index.SaveFile].
+const IndexFormat = 1
+
+// On-disk index errors.
+var (
+	ErrStaleIndex   = errors.New("stale index")
+	ErrCorruptIndex = errors.New("corrupt index")
+)
+
+// Stamp identifies what an on-disk index was built with, for invalidating it
+// when any of that changes, see [NewStamp].
+type Stamp struct {
+	// Format is the version of the index format, see [IndexFormat].
+	Format int
+	// Runtime is the language ABI version of the tree-sitter runtime.
+	Runtime int
+	// Grammars is the fingerprint of the grammars the graphs were built with.
+	Grammars string
+}
+
+// indexHeader is the first line of an on-disk index, the rest of it being
+// the index itself, as saved by [Index.Save].
+type indexHeader struct {
+	Stamp
+
+	Checksum string // Of the rest of the file.
+}
+
+// NewStamp returns the stamp of an index built (now) with the given languages.
+// The fingerprint of a grammar covers its ABI version, states, symbols and
+// fields, so regenerating it with any change (of its rules or of the
+// tree-sitter CLI) changes it.
+func NewStamp(langs ...*sitter.Language) Stamp {
+	h := sha256.New()
+
+	for _, lang := range langs {
+		fmt.Fprintln(h, lang.Version(), lang.StateCount(), lang.SymbolCount(), lang.FieldCount())
+
+		for _, s := range lang.Symbols() {
+			fmt.Fprintln(h, s.Name, s.Type)
+		}
+
+		for _, name := range lang.FieldNames() {
+			fmt.Fprintln(h, name)
+		}
+	}
+
+	return Stamp{
+		Format:   IndexFormat,
+		Runtime:  sitter.TREE_SITTER_LANGUAGE_VERSION,
+		Grammars: hex.EncodeToString(h.Sum(nil)),
+	}
+}
+
+// HashSource returns the hash of a source text, as recorded in the partial
+// graphs built from it (see [FileGraph.Hash]).
+func HashSource(src []byte) string {
+	sum := sha256.Sum256(src)
+	return hex.EncodeToString(sum[:])
+}
+
+// SaveFile saves the index to the file at path, along with its stamp and a
+// checksum, for [LoadFile] to warm start from. The file is replaced at once
+// (through a temporary file in the same directory), so that it is never left
+// half written.
+func (ix *Index) SaveFile(path string, stamp Stamp) (err error) {
+	body := &bytes.Buffer{}
+	if err = ix.Save(body); err != nil {
+		return
+	}
+
+	sum := sha256.Sum256(body.Bytes())
+
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	if err = json.NewEncoder(f).Encode(indexHeader{Stamp: stamp, Checksum: hex.EncodeToString(sum[:])}); err != nil {
+		return
+	}
+
+	if _, err = body.WriteTo(f); err != nil {
+		return
+	}
+
+	if err = f.Close(); err != nil {
+		return
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// LoadFile loads an index saved with [Index.SaveFile], failing with
+// [ErrStaleIndex] if it was saved with a different stamp (in which case it
+// should be rebuilt from scratch), or with [ErrCorruptIndex] if it does not
+// pass the integrity checks.
+//
+// The graphs of the files changed since are still to be updated, see
+// [Index.Stale].
+func LoadFile(path string, stamp Stamp) (*Index, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	line, body, ok := bytes.Cut(b, []byte("\n"))
+	if !ok {
+		return nil, fmt.Errorf("%w: %s: no header", ErrCorruptIndex, path)
+	}
+
+	var h indexHeader
+
+	if err = json.Unmarshal(line, &h); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrCorruptIndex, path, err)
+	}
+
+	if h.Stamp != stamp {
+		return nil, fmt.Errorf("%w: %s: saved with %+v, not %+v", ErrStaleIndex, path, h.Stamp, stamp)
+	}
+
+	if sum := sha256.Sum256(body); h.Checksum != hex.EncodeToString(sum[:]) {
+		return nil, fmt.Errorf("%w: %s: checksum mismatch", ErrCorruptIndex, path)
+	}
+
+	ix, err := Load(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrCorruptIndex, path, err)
+	}
+
+	return ix, nil
+}
+
+// Stale returns the paths (sorted) of the indexed files whose source text
+// changed, i.e. whose current text (as returned by src) does not hash to the
+// one recorded in their graph, or which no longer exist (src failing).
+func (ix *Index) Stale(src func(path string) ([]byte, error)) (paths []string) {
+	ix.mu.RLock()
+	graphs := slices.Collect(maps.Values(ix.files))
+	ix.mu.RUnlock()
+
+	for _, fg := range graphs {
+		if b, err := src(fg.Path); err != nil || HashSource(b) != fg.Hash {
+			paths = append(paths, fg.Path)
+		}
+	}
+
+	slices.Sort(paths)
+
+	return
+}
@@ -0,0 +1,131 @@
+package binding
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+func TestNewStamp(t *testing.T) {
+	t.Parallel()
+
+	stamp := NewStamp(gr)
+	if stamp.Format != IndexFormat || stamp.Runtime != sitter.TREE_SITTER_LANGUAGE_VERSION {
+		t.Fatalf("Unexpected stamp %+v", stamp)
+	}
+
+	if act := NewStamp(gr); act != stamp {
+		t.Fatalf("Expected %+v again, got %+v", stamp, act)
+	}
+
+	if act := NewStamp(); act.Grammars == stamp.Grammars {
+		t.Fatal("Expected the grammars fingerprint to differ without the grammar")
+	}
+}
+
+func TestHashSource(t *testing.T) {
+	t.Parallel()
+
+	if a, b := HashSource([]byte("a")), HashSource([]byte("b")); a == b || len(a) != 64 {
+		t.Fatalf("Expected distinct hex hashes, got %q and %q", a, b)
+	}
+}
+
+func TestIndexSaveFile(t *testing.T) {
+	t.Parallel()
+
+	fg := &FileGraph{
+		Path:        "a",
+		Hash:        HashSource([]byte("x = x")),
+		Scopes:      []Scope{{Range: rng(0, 5), Parent: -1}},
+		Definitions: []Definition{{Name: "x", Range: rng(0, 1)}},
+		References:  []Reference{{Name: "x", Range: rng(4, 5)}},
+	}
+	stamp := Stamp{Format: IndexFormat, Runtime: 14, Grammars: "g"}
+	path := filepath.Join(t.TempDir(), "index")
+
+	if err := NewIndex(fg).SaveFile(path, stamp); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	ix, err := LoadFile(path, stamp)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act, ok := ix.File("a"); !ok || !reflect.DeepEqual(act, fg) {
+		t.Fatalf("Expected %+v, got %+v", fg, act)
+	}
+
+	for _, s := range []Stamp{
+		{Format: IndexFormat + 1, Runtime: 14, Grammars: "g"},
+		{Format: IndexFormat, Runtime: 15, Grammars: "g"},
+		{Format: IndexFormat, Runtime: 14, Grammars: "h"},
+	} {
+		if _, err = LoadFile(path, s); !errors.Is(err, ErrStaleIndex) {
+			t.Fatalf("Expected %v for %+v, got %v", ErrStaleIndex, s, err)
+		}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	b[len(b)-3] ^= 1
+
+	if err = os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if _, err = LoadFile(path, stamp); !errors.Is(err, ErrCorruptIndex) {
+		t.Fatalf("Expected %v, got %v", ErrCorruptIndex, err)
+	}
+
+	if err = os.WriteFile(path, []byte("nope"), 0o600); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if _, err = LoadFile(path, stamp); !errors.Is(err, ErrCorruptIndex) {
+		t.Fatalf("Expected %v, got %v", ErrCorruptIndex, err)
+	}
+
+	if err = NewIndex().SaveFile(filepath.Join(path, "nope"), stamp); err == nil {
+		t.Fatal("Expected an error")
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	if _, err := LoadFile(filepath.Join(t.TempDir(), "nope"), Stamp{}); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected %v, got %v", os.ErrNotExist, err)
+	}
+}
+
+func TestIndexStale(t *testing.T) {
+	t.Parallel()
+
+	src := map[string]string{"a": "x", "b": "y"}
+	ix := NewIndex(
+		&FileGraph{Path: "a", Hash: HashSource([]byte("x"))},
+		&FileGraph{Path: "b", Hash: HashSource([]byte("x"))},
+		&FileGraph{Path: "c", Hash: HashSource([]byte("z"))},
+	)
+
+	act := ix.Stale(func(path string) ([]byte, error) {
+		if s, ok := src[path]; ok {
+			return []byte(s), nil
+		}
+
+		return nil, os.ErrNotExist
+	})
+
+	if exp := []string{"b", "c"}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+}
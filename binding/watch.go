@@ -0,0 +1,395 @@
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/scan"
+)
+
+// FileEvent is a change of a file of a workspace, see [Indexer.Watch].
+type FileEvent struct {
+	Path string
+	// Removed tells whether the file was removed, rather than created or
+	// modified.
+	Removed bool
+}
+
+// IndexUpdate is a batch of changes applied to an index, as streamed to the
+// subscribers of an [Indexer].
+type IndexUpdate struct {
+	// Updated and Removed are the paths (sorted) of the files whose graphs
+	// were updated (added included) and removed, respectively.
+	Updated []string
+	Removed []string
+	// Err holds the errors reading or building the files of the batch, whose
+	// graphs were left as they were.
+	Err error
+}
+
+// The counters an [Indexer] reports to its metrics (see [Indexer.SetMetrics]),
+// along with [sitter.MetricCacheHits], which counts the files whose graphs
+// were kept, as their text did not change.
+const (
+	// MetricFilesIndexed counts the files whose graphs were (re)built.
+	MetricFilesIndexed = "files_indexed"
+	// MetricFilesRemoved counts the files removed from the index.
+	MetricFilesRemoved = "files_removed"
+	// MetricIndexErrors counts the files that failed to be read or built.
+	MetricIndexErrors = "index_errors"
+)
+
+// BuildFunc builds the partial graph of the file at path, whose source text
+// is src, e.g. by parsing it and passing its tree to [Rules.Build].
+type BuildFunc func(path string, src []byte) (*FileGraph, error)
+
+// Indexer keeps an index up to date with the files of a workspace, applying
+// their changes (see [Indexer.Watch]) in batches, and streaming the resulting
+// updates to its subscribers (see [Indexer.Subscribe]).
+type Indexer struct {
+	ix    *Index
+	build BuildFunc
+	delay time.Duration
+
+	subs    map[*subscriber]bool
+	visible map[string]bool
+	metrics sitter.Metrics
+	mu      sync.Mutex
+	// pub is held while publishing an update, rather than mu, so that the
+	// subscribers slow to receive it do not block the others (e.g. SetVisible).
+	pub sync.Mutex
+}
+
+// subscriber is a subscriber of an [Indexer].
+type subscriber struct {
+	updates chan IndexUpdate
+	done    chan struct{}
+	once    sync.Once
+}
+
+// NewIndexer creates an indexer of the given index, building the graphs of
+// the files with build. The changes are debounced: a batch is applied once no
+// other change came in for the given delay.
+func NewIndexer(ix *Index, build BuildFunc, delay time.Duration) *Indexer {
+	return &Indexer{ix: ix, build: build, delay: delay, subs: map[*subscriber]bool{}, visible: map[string]bool{}}
+}
+
+// SetVisible sets the paths of the visible files (e.g. those open in an
+// editor), replacing the previous ones. Their changes are applied first, and
+// are never preempted, see [Indexer.Watch].
+func (in *Indexer) SetVisible(paths ...string) {
+	visible := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		visible[path] = true
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	in.visible = visible
+}
+
+// SetMetrics sets where the indexer reports its counters (see the Metric*
+// constants) to, after each batch of changes. Passing nil disables reporting.
+func (in *Indexer) SetMetrics(m sitter.Metrics) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	in.metrics = m
+}
+
+// Subscribe returns a stream of the updates of the index, along with the
+// function to call to unsubscribe (which closes the stream). Subscribers must
+// keep receiving the updates until they unsubscribe, as the next batch of
+// changes is only applied once they all received the current update.
+func (in *Indexer) Subscribe() (<-chan IndexUpdate, func()) {
+	sub := &subscriber{updates: make(chan IndexUpdate), done: make(chan struct{})}
+
+	in.mu.Lock()
+	in.subs[sub] = true
+	in.mu.Unlock()
+
+	return sub.updates, func() {
+		sub.once.Do(func() {
+			close(sub.done) // First, for publish to let go of the lock.
+
+			in.pub.Lock()
+			defer in.pub.Unlock()
+
+			in.mu.Lock()
+			delete(in.subs, sub)
+			in.mu.Unlock()
+
+			close(sub.updates)
+		})
+	}
+}
+
+// Watch applies the file events (e.g. of [PollEvents], or of an fsnotify
+// watcher) to the index, until the events channel is closed (the last batch
+// being applied then) or ctx is done (returning its error).
+//
+// The files created or modified are read and their graphs (re)built, unless
+// their text is the same as the one the index has the graph of (see
+// [FileGraph.Hash]), e.g. on the initial scan of a workspace whose index was
+// loaded from disk (see [LoadFile]).
+//
+// The changes of the visible files (see [Indexer.SetVisible]) are applied
+// first. Those of the other (background) files are preempted by new events,
+// so that large changes (e.g. refactorings touching many files) do not delay
+// the following ones: the update tells the changes applied so far, the rest
+// being carried over to the next batch.
+func (in *Indexer) Watch(ctx context.Context, events <-chan FileEvent) error {
+	pending := map[string]bool{} // Whether each file was removed.
+
+	var debounce <-chan time.Time
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				in.apply(ctx, pending, nil)
+				return nil
+			}
+
+			pending[ev.Path] = ev.Removed
+			debounce = time.After(in.delay)
+		case <-debounce:
+			if pending, debounce = in.apply(ctx, pending, events), nil; len(pending) > 0 {
+				debounce = time.After(in.delay)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// apply applies a batch of changes to the index, the visible files first, then
+// publishes the update (if there is anything to tell). The changes of the
+// background files are preempted by the events received meanwhile (if events
+// is not nil), returning the changes left to apply (if any), the new ones
+// included.
+func (in *Indexer) apply(ctx context.Context, batch map[string]bool, events <-chan FileEvent) (rest map[string]bool) {
+	var (
+		u      IndexUpdate
+		errs   []error
+		cached int64
+	)
+
+	in.mu.Lock()
+	visible, m := in.visible, in.metrics
+	in.mu.Unlock()
+
+	rest = map[string]bool{}
+
+	var paths, background []string
+
+	for _, path := range slices.Sorted(maps.Keys(batch)) {
+		if visible[path] {
+			paths = append(paths, path)
+		} else {
+			background = append(background, path)
+		}
+	}
+
+	paths = append(paths, background...)
+
+	for i, path := range paths {
+		if ev, ok := preempt(events, visible[path]); ok {
+			rest[ev.Path] = ev.Removed
+			for _, p := range paths[i:] {
+				if _, ok := rest[p]; !ok {
+					rest[p] = batch[p]
+				}
+			}
+
+			break
+		}
+
+		if hit, err := in.applyFile(path, batch[path], &u); err != nil {
+			errs = append(errs, err)
+		} else if hit {
+			cached++
+		}
+	}
+
+	slices.Sort(u.Updated)
+	slices.Sort(u.Removed)
+
+	if m != nil {
+		m.Add(MetricFilesIndexed, int64(len(u.Updated)))
+		m.Add(MetricFilesRemoved, int64(len(u.Removed)))
+		m.Add(MetricIndexErrors, int64(len(errs)))
+		m.Add(sitter.MetricCacheHits, cached)
+	}
+
+	if u.Err = errors.Join(errs...); u.Updated != nil || u.Removed != nil || u.Err != nil {
+		in.publish(ctx, u)
+	}
+
+	return
+}
+
+// applyFile applies the change of the file at path (removed or not) to the
+// index, recording it in the update. It tells whether the file's graph was
+// kept, as its text did not change.
+func (in *Indexer) applyFile(path string, removed bool, u *IndexUpdate) (cached bool, err error) {
+	old, indexed := in.ix.File(path)
+
+	src, err := os.ReadFile(path)
+	if removed || errors.Is(err, fs.ErrNotExist) {
+		if indexed {
+			in.ix.Remove(path)
+			u.Removed = append(u.Removed, path)
+		}
+
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if indexed && old.Hash == HashSource(src) {
+		return true, nil
+	}
+
+	fg, err := in.build(path, src)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", path, err)
+	}
+
+	in.ix.Update(fg)
+	u.Updated = append(u.Updated, path)
+
+	return false, nil
+}
+
+// preempt tells whether to preempt the change of a file (visible or not), by
+// an event received meanwhile, if any.
+func preempt(events <-chan FileEvent, visible bool) (ev FileEvent, ok bool) {
+	if events == nil || visible {
+		return
+	}
+
+	select {
+	case ev, ok = <-events:
+	default:
+	}
+
+	return
+}
+
+// publish sends the update to all the subscribers, holding the publishing
+// lock so that none of them is closed meanwhile, but not the indexer's one.
+func (in *Indexer) publish(ctx context.Context, u IndexUpdate) {
+	in.pub.Lock()
+	defer in.pub.Unlock()
+
+	in.mu.Lock()
+	subs := slices.Collect(maps.Keys(in.subs))
+	in.mu.Unlock()
+
+	for _, sub := range subs {
+		select {
+		case sub.updates <- u:
+		case <-sub.done:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// PollEvents polls the files under root (those the scanner finds, the default
+// one if nil) for changes, at the given interval, streaming them as file
+// events, until ctx is done. The first scan reports all the files, as created.
+//
+// It is a portable (but coarser) alternative to the native file system
+// notifications: it tells which files changed by comparing their sizes and
+// modification times.
+func PollEvents(ctx context.Context, root string, interval time.Duration, s *scan.Scanner) <-chan FileEvent {
+	events := make(chan FileEvent)
+
+	go func() {
+		defer close(events)
+
+		var seen map[string]fileState
+
+		for {
+			current, err := scanFiles(root, s)
+			if err != nil {
+				current = seen // Try again next time.
+			}
+
+			for _, path := range slices.Sorted(maps.Keys(current)) {
+				if st, ok := seen[path]; ok && st == current[path] {
+					continue
+				}
+
+				select {
+				case events <- FileEvent{Path: path}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			for _, path := range slices.Sorted(maps.Keys(seen)) {
+				if _, ok := current[path]; ok {
+					continue
+				}
+
+				select {
+				case events <- FileEvent{Path: path, Removed: true}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			seen = current
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// fileState is what tells whether a file changed, see [PollEvents].
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// scanFiles returns the state of the files under root the scanner finds.
+func scanFiles(root string, s *scan.Scanner) (map[string]fileState, error) {
+	if s == nil {
+		s, _ = scan.New()
+	}
+
+	paths, err := s.Files(root)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]fileState{}
+
+	for _, path := range paths {
+		if info, err := os.Stat(path); err == nil {
+			files[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,277 @@
+package binding
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexaandru/go-tree-sitter-bare/scan"
+)
+
+func TestNewIndexer(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestIndexerSubscribe(t *testing.T) {
+	t.Parallel()
+
+	in := NewIndexer(NewIndex(), nil, 0)
+	updates, unsubscribe := in.Subscribe()
+
+	unsubscribe()
+	unsubscribe()
+
+	if _, ok := <-updates; ok {
+		t.Fatal("Expected the stream to be closed")
+	}
+
+	// With no one receiving it, publishing does not block.
+	in.publish(context.Background(), IndexUpdate{Updated: []string{"a"}})
+
+	// Nor does a slow subscriber block the indexer meanwhile.
+	_, unsubscribe = in.Subscribe()
+	published := make(chan struct{})
+
+	go func() {
+		defer close(published)
+		in.publish(context.Background(), IndexUpdate{Updated: []string{"a"}})
+	}()
+
+	for in.pub.TryLock() { // Until publishing.
+		in.pub.Unlock()
+		runtime.Gosched()
+	}
+
+	in.SetVisible("a")
+	unsubscribe()
+	<-published
+}
+
+func TestIndexerWatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	a, b, c := filepath.Join(dir, "a"), filepath.Join(dir, "b"), filepath.Join(dir, "c")
+	errBuild := errors.New("build failed")
+	builds := 0
+
+	build := func(path string, src []byte) (*FileGraph, error) {
+		if strings.HasPrefix(string(src), "bad") {
+			return nil, errBuild
+		}
+
+		builds++
+
+		return &FileGraph{Path: path, Hash: HashSource(src)}, nil
+	}
+
+	for path, src := range map[string]string{a: "x", b: "y", c: "bad"} {
+		if err := os.WriteFile(path, []byte(src), 0o600); err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+	}
+
+	ix := NewIndex(&FileGraph{Path: b, Hash: HashSource([]byte("y"))}, &FileGraph{Path: "gone"})
+	in := NewIndexer(ix, build, 10*time.Millisecond)
+	m := new(expvar.Map).Init()
+	in.SetMetrics(m)
+
+	updates, unsubscribe := in.Subscribe()
+
+	defer unsubscribe()
+
+	events := make(chan FileEvent)
+	done := make(chan error)
+
+	go func() { done <- in.Watch(context.Background(), events) }()
+
+	for _, ev := range []FileEvent{{Path: a}, {Path: b}, {Path: c}, {Path: "gone"}, {Path: a}} {
+		events <- ev
+	}
+
+	u := <-updates
+	if exp := []string{a}; !reflect.DeepEqual(u.Updated, exp) {
+		t.Fatalf("Expected %v updated, got %v", exp, u.Updated)
+	}
+
+	if exp := []string{"gone"}; !reflect.DeepEqual(u.Removed, exp) {
+		t.Fatalf("Expected %v removed, got %v", exp, u.Removed)
+	}
+
+	if !errors.Is(u.Err, errBuild) || builds != 1 {
+		t.Fatalf("Expected %v and 1 build, got %v and %d", errBuild, u.Err, builds)
+	}
+
+	events <- FileEvent{Path: a, Removed: true}
+	close(events)
+
+	if u = <-updates; !reflect.DeepEqual(u.Removed, []string{a}) || u.Updated != nil || u.Err != nil {
+		t.Fatalf("Expected %s removed, got %+v", a, u)
+	}
+
+	if err := <-done; err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if exp := `{"cache_hits": 1, "files_indexed": 1, "files_removed": 2, "index_errors": 1}`; m.String() != exp {
+		t.Fatalf("Expected the metrics %s, got %s", exp, m)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := in.Watch(ctx, make(chan FileEvent)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestIndexerSetMetrics(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested in TestIndexerWatch")
+}
+
+func TestIndexerSetVisible(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested in TestIndexerApply")
+}
+
+func TestIndexerApply(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	a, b, c := filepath.Join(dir, "a"), filepath.Join(dir, "b"), filepath.Join(dir, "c")
+
+	for _, path := range []string{a, b, c} {
+		if err := os.WriteFile(path, []byte(path), 0o600); err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+	}
+
+	var built []string
+
+	in := NewIndexer(NewIndex(), func(path string, src []byte) (*FileGraph, error) {
+		built = append(built, path)
+		return &FileGraph{Path: path, Hash: HashSource(src)}, nil
+	}, 0)
+	in.SetVisible(c)
+
+	events := make(chan FileEvent, 1)
+	events <- FileEvent{Path: b, Removed: true}
+
+	// The visible file comes first, then the others are preempted.
+	rest := in.apply(context.Background(), map[string]bool{a: false, b: false, c: false}, events)
+	if exp := map[string]bool{a: false, b: true}; !reflect.DeepEqual(rest, exp) || !reflect.DeepEqual(built, []string{c}) {
+		t.Fatalf("Expected %v left and %s built, got %v and %v", exp, c, rest, built)
+	}
+
+	in.SetVisible()
+
+	if rest = in.apply(context.Background(), map[string]bool{a: false, b: false}, events); len(rest) != 0 {
+		t.Fatal("Expected nothing left, got", rest)
+	}
+
+	if exp := []string{c, a, b}; !reflect.DeepEqual(built, exp) {
+		t.Fatalf("Expected %v built, got %v", exp, built)
+	}
+}
+
+func TestIndexerApplyFile(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestPreempt(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan FileEvent, 1)
+	events <- FileEvent{Path: "a"}
+
+	if _, ok := preempt(nil, false); ok {
+		t.Fatal("Expected no preemption without events")
+	}
+
+	if _, ok := preempt(events, true); ok {
+		t.Fatal("Expected no preemption of the visible files")
+	}
+
+	if ev, ok := preempt(events, false); !ok || ev.Path != "a" {
+		t.Fatalf("Expected preemption by a, got %v (%v)", ev, ok)
+	}
+
+	if _, ok := preempt(events, false); ok {
+		t.Fatal("Expected no preemption without pending events")
+	}
+
+	close(events)
+
+	if _, ok := preempt(events, false); ok {
+		t.Fatal("Expected no preemption once the events are closed")
+	}
+}
+
+func TestIndexerPublish(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestPollEvents(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	a, b := filepath.Join(dir, "a.calc"), filepath.Join(dir, "b.calc")
+
+	for _, path := range []string{a, b, filepath.Join(dir, "c.txt")} {
+		if err := os.WriteFile(path, []byte("1"), 0o600); err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+	}
+
+	s, err := scan.New(scan.Include("*.calc"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := PollEvents(ctx, dir, time.Millisecond, s)
+
+	for _, exp := range []FileEvent{{Path: a}, {Path: b}} {
+		if ev := <-events; ev != exp {
+			t.Fatalf("Expected %+v, got %+v", exp, ev)
+		}
+	}
+
+	if err := os.Remove(a); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if err := os.WriteFile(b, []byte("1 + 2"), 0o600); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	act := map[FileEvent]bool{}
+	for len(act) < 2 {
+		act[<-events] = true
+	}
+
+	if exp := map[FileEvent]bool{{Path: a, Removed: true}: true, {Path: b}: true}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+
+	cancel()
+
+	for range events { //nolint:revive // ok, draining
+	}
+}
+
+func TestScanFiles(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
@@ -0,0 +1,114 @@
+package sitter
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CaptureMatchMode indicates how capture names are checked against an allow list.
+type CaptureMatchMode int
+
+// UnknownCapture describes one use of a capture name that is not allowed,
+// in one of the query's patterns.
+type UnknownCapture struct {
+	Name         string
+	PatternIndex uint
+	Offset       uint
+	Point
+}
+
+// CaptureNamesError is returned by [ValidateCaptureNames] and lists all the
+// unknown capture names, along with the patterns that use them.
+type CaptureNamesError struct {
+	Unknown []UnknownCapture
+}
+
+// The possible capture match modes.
+const (
+	// CaptureMatchExact only accepts capture names present verbatim in the allow list.
+	CaptureMatchExact CaptureMatchMode = iota
+	// CaptureMatchHierarchical also accepts the dot separated sub-names of
+	// allowed names (i.e. `function.builtin` is accepted if `function` is allowed),
+	// which is the convention used by highlights and tags queries.
+	CaptureMatchHierarchical
+)
+
+// ErrUnknownCapture is the error class for [CaptureNamesError].
+var ErrUnknownCapture = errors.New("unknown capture name")
+
+// ValidateCaptureNames checks that all the capture names used by the query are
+// in the allowed list, so that queries written for a particular consumer (i.e.
+// a theme) fail fast rather than silently producing captures nobody handles.
+//
+// Capture names starting with an underscore are considered private to the
+// query (used only by predicates) and are always accepted. If the optional
+// mode is passed, it will be used for matching, otherwise [CaptureMatchExact]
+// is used.
+func ValidateCaptureNames(q *Query, allowed []string, opts ...CaptureMatchMode) error {
+	mode := CaptureMatchExact
+	if len(opts) > 0 {
+		mode = opts[0]
+	}
+
+	var unknown []UnknownCapture
+
+	for i := range q.PatternCount() {
+		for j, name := range q.captureNames {
+			if q.captureQuantifiers[i][j] == CaptureQuantifierZero || isAllowedCapture(name, allowed, mode) {
+				continue
+			}
+
+			unknown = append(unknown, UnknownCapture{
+				Name:         name,
+				PatternIndex: uint(i),
+				Offset:       uint(q.StartByteForPattern(int(i))),
+				Point:        q.patternStarts[i],
+			})
+		}
+	}
+
+	if len(unknown) > 0 {
+		return &CaptureNamesError{Unknown: unknown}
+	}
+
+	return nil
+}
+
+func (e *CaptureNamesError) Error() string {
+	msgs := make([]string, 0, len(e.Unknown))
+
+	for _, u := range e.Unknown {
+		msgs = append(msgs, fmt.Sprintf("@%s in pattern #%d at %d:%d", u.Name, u.PatternIndex, u.Row+1, u.Column+1))
+	}
+
+	return fmt.Sprintf("%v: %s", ErrUnknownCapture, strings.Join(msgs, ", "))
+}
+
+func (e *CaptureNamesError) Unwrap() error {
+	return ErrUnknownCapture
+}
+
+func isAllowedCapture(name string, allowed []string, mode CaptureMatchMode) bool {
+	if strings.HasPrefix(name, "_") {
+		return true
+	}
+
+	if mode != CaptureMatchHierarchical {
+		return slices.Contains(allowed, name)
+	}
+
+	for {
+		if slices.Contains(allowed, name) {
+			return true
+		}
+
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			return false
+		}
+
+		name = name[:i]
+	}
+}
@@ -0,0 +1,66 @@
+package sitter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateCaptureNames(t *testing.T) {
+	t.Parallel()
+
+	query := "(sum) @sum\n\n  (number) @number.int\n(comment) @_skip @comment"
+	testCases := []struct {
+		allowed []string
+		mode    CaptureMatchMode
+		exp     string
+	}{
+		{[]string{"sum", "number.int", "comment"}, CaptureMatchExact, ""},
+		{[]string{"sum", "number", "comment"}, CaptureMatchHierarchical, ""},
+		{
+			[]string{"sum", "number", "comment"}, CaptureMatchExact,
+			"unknown capture name: @number.int in pattern #1 at 3:3",
+		},
+		{
+			[]string{"number"}, CaptureMatchHierarchical,
+			"unknown capture name: @sum in pattern #0 at 1:1, @comment in pattern #2 at 4:1",
+		},
+	}
+
+	q, err := NewQuery(gr, []byte(query))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.exp, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateCaptureNames(q, tc.allowed, tc.mode)
+			if tc.exp == "" {
+				if err != nil {
+					t.Fatal("Expected no error, got", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrUnknownCapture) {
+				t.Fatalf("Expected %v, got %v", ErrUnknownCapture, err)
+			}
+
+			if act := err.Error(); act != tc.exp {
+				t.Fatalf("Expected\n%s; got\n%s", tc.exp, act)
+			}
+		})
+	}
+}
+
+func TestCaptureNamesErrorError(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestIsAllowedCapture(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
@@ -0,0 +1,107 @@
+package sitter
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+)
+
+// CgoCategory is a category of cgo calls, as recorded when built with the
+// cgoprof tag (see [CgoProfiling]).
+type CgoCategory int
+
+// CgoStat holds the calls recorded for a [CgoCategory].
+type CgoStat struct {
+	Category CgoCategory
+	Calls    uint64
+	// Duration is the cumulative duration of the calls.
+	Duration time.Duration
+}
+
+// The categories of cgo calls recorded.
+const (
+	CgoNode       CgoCategory = iota // Node accessors and navigation.
+	CgoTreeCursor                    // TreeCursor accessors and moves.
+	CgoQueryNext                     // Fetching the next query match or capture.
+	cgoCategories
+)
+
+//nolint:gochecknoglobals // ok
+var (
+	cgoCategoryNames   = [cgoCategories]string{"node", "tree_cursor", "query_next"}
+	cgoCalls, cgoNanos [cgoCategories]atomic.Uint64
+)
+
+func (c CgoCategory) String() string {
+	if c < 0 || c >= cgoCategories {
+		return fmt.Sprintf("CgoCategory(%d)", int(c))
+	}
+
+	return cgoCategoryNames[c]
+}
+
+// CgoProfile returns the calls recorded so far, for each category. Without
+// the cgoprof build tag nothing is recorded, so all the stats are zero.
+func CgoProfile() (stats []CgoStat) {
+	for c := range cgoCategories {
+		stats = append(stats, CgoStat{
+			Category: c,
+			Calls:    cgoCalls[c].Load(),
+			Duration: time.Duration(cgoNanos[c].Load()), //nolint:gosec // durations are positive
+		})
+	}
+
+	return
+}
+
+// ResetCgoProfile discards the calls recorded so far.
+func ResetCgoProfile() {
+	for c := range cgoCategories {
+		cgoCalls[c].Store(0)
+		cgoNanos[c].Store(0)
+	}
+}
+
+// WriteCgoProfile writes the [CgoProfile] as a table, with the number of
+// calls, the cumulative and the average duration of each category.
+func WriteCgoProfile(w io.Writer) error {
+	if !CgoProfiling {
+		if _, err := fmt.Fprintln(w, "cgo profiling is disabled, build with -tags cgoprof"); err != nil {
+			return err
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight) //nolint:mnd // ok
+	fmt.Fprintln(tw, "category\tcalls\ttotal\tavg\t")
+
+	for _, s := range CgoProfile() {
+		avg := time.Duration(0)
+		if s.Calls > 0 {
+			avg = s.Duration / time.Duration(s.Calls) //nolint:gosec // ok
+		}
+
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t\n", s.Category, s.Calls, s.Duration, avg)
+	}
+
+	return tw.Flush()
+}
+
+// cgoCall records a call of the given category, returning the func to be
+// deferred for recording its duration. The accessors wrap their cgo calls with
+// profiled (or profiledDo) instead, which calls it (with the cgoprof tag only),
+// while the choke points (e.g. fetching the next query match) guard it by
+// CgoProfiling, so that it is compiled out when profiling is disabled:
+//
+//	if CgoProfiling {
+//		defer cgoCall(CgoQueryNext)()
+//	}
+func cgoCall(c CgoCategory) func() {
+	start := time.Now()
+
+	return func() {
+		cgoCalls[c].Add(1)
+		cgoNanos[c].Add(uint64(time.Since(start))) //nolint:gosec // durations are positive
+	}
+}
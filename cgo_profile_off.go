@@ -0,0 +1,18 @@
+//go:build !cgoprof
+
+package sitter
+
+// CgoProfiling reports whether the cgo calls are recorded (see [CgoProfile]),
+// which is only the case when built with the cgoprof tag.
+const CgoProfiling = false
+
+// profiled calls fn, a wrapper of a cgo call of the given category. Profiling
+// being disabled, it is inlined into a plain call (as is fn), at no cost.
+func profiled[T any](_ CgoCategory, fn func() T) T {
+	return fn()
+}
+
+// profiledDo is like profiled, for the calls without results.
+func profiledDo(_ CgoCategory, fn func()) {
+	fn()
+}
@@ -0,0 +1,20 @@
+//go:build cgoprof
+
+package sitter
+
+// CgoProfiling reports whether the cgo calls are recorded (see [CgoProfile]),
+// which is only the case when built with the cgoprof tag.
+const CgoProfiling = true
+
+// profiled calls fn, a wrapper of a cgo call of the given category, recording
+// the call.
+func profiled[T any](c CgoCategory, fn func() T) T {
+	defer cgoCall(c)()
+	return fn()
+}
+
+// profiledDo is like profiled, for the calls without results.
+func profiledDo(c CgoCategory, fn func()) {
+	defer cgoCall(c)()
+	fn()
+}
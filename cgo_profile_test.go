@@ -0,0 +1,73 @@
+package sitter
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCgoCategoryString(t *testing.T) {
+	t.Parallel()
+
+	if act, exp := CgoQueryNext.String(), "query_next"; act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	if act, exp := CgoCategory(42).String(), "CgoCategory(42)"; act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+//nolint:paralleltest // the profile is global
+func TestCgoProfile(t *testing.T) {
+	input := []byte("1 + 2")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	NewTreeCursor(root).GoToFirstChild()
+
+	q, err := NewQuery(gr, []byte("(number) @n"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	matches := NewQueryCursor().Matches(q, root, input)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		_ = m.Captures[0].Node.Type()
+	}
+
+	for _, s := range CgoProfile() {
+		if recorded := s.Calls > 0; recorded != CgoProfiling {
+			t.Fatalf("Expected %s calls to be recorded: %v, got %d", s.Category, CgoProfiling, s.Calls)
+		}
+	}
+}
+
+func TestResetCgoProfile(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestWriteCgoProfile(t *testing.T) {
+	t.Parallel()
+
+	var sb strings.Builder
+
+	if err := WriteCgoProfile(&sb); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for _, exp := range []string{"category", "node", "tree_cursor", "query_next"} {
+		if !strings.Contains(sb.String(), exp) {
+			t.Fatalf("Expected %q in\n%s", exp, sb.String())
+		}
+	}
+}
+
+func TestCgoCall(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
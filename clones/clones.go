@@ -0,0 +1,269 @@
+// Package clones detects duplicated code, by hashing the subtrees of syntax
+// trees (Merkle style, over the node types and the leaves' text) and grouping
+// the subtrees having the same hash, across files.
+//
+// By default only exact clones are found. Normalizing the leaves' text (see
+// [IgnoreText] and [WithNormalizer]) finds clones differing in identifiers,
+// literals, etc. as well:
+//
+//	d := clones.NewDetector(clones.IgnoreText("identifier"), clones.SkipExtras())
+//	d.Add("a.go", rootA, srcA)
+//	d.Add("b.go", rootB, srcB)
+//
+//	for _, c := range d.Classes() {
+//		// ...
+//	}
+package clones
+
+import (
+	"cmp"
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"slices"
+	"sync"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Option configures a [Detector].
+type Option func(*Detector)
+
+// Normalizer returns the text to hash for the leaf n, given its text.
+type Normalizer func(n sitter.Node, text []byte) []byte
+
+// Detector collects the subtrees of the files added to it, see [NewDetector].
+type Detector struct {
+	normalize Normalizer
+	hashes    map[uint64][]fragment
+	minNodes  uint
+	skipExtra bool
+	mu        sync.Mutex
+}
+
+// Fragment is a cloned piece of code.
+type Fragment struct {
+	Path  string
+	Range sitter.Range
+}
+
+// Class is a clone class: fragments of code having the same structure and
+// (normalized) text.
+type Class struct {
+	Fragments []Fragment
+	// Nodes is the number of nodes of (each of) the fragments.
+	Nodes uint
+	Hash  uint64
+}
+
+// Pair is a pair of clones, see [Detector.Pairs].
+type Pair struct {
+	A, B  Fragment
+	Nodes uint
+}
+
+// fragment is a subtree, along with its hash, size and the hash of its parent
+// (if any).
+type fragment struct {
+	Fragment
+	hash, parent uint64
+	nodes        uint
+	hasParent    bool
+}
+
+// frame is a node being hashed, along with the fragments of its children.
+type frame struct {
+	h        hash.Hash64
+	children []int
+	rng      sitter.Range
+	size     uint
+}
+
+const defaultMinNodes = 10
+
+// NewDetector returns a new clone detector.
+func NewDetector(opts ...Option) (d *Detector) {
+	d = &Detector{hashes: map[uint64][]fragment{}, minNodes: defaultMinNodes}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return
+}
+
+// WithMinNodes sets the minimum number of nodes of the reported clones
+// (10 by default), as small subtrees (e.g. single tokens) are duplicated
+// everywhere.
+func WithMinNodes(n uint) Option {
+	return func(d *Detector) {
+		d.minNodes = max(n, 1)
+	}
+}
+
+// WithNormalizer sets the normalizer of the leaves' text, replacing any
+// previously set one (including by [IgnoreText]).
+func WithNormalizer(f Normalizer) Option {
+	return func(d *Detector) {
+		d.normalize = f
+	}
+}
+
+// IgnoreText ignores the text of the leaves of the given types (e.g.
+// identifiers, number literals, etc.), so that only their type is hashed.
+func IgnoreText(types ...string) Option {
+	return WithNormalizer(func(n sitter.Node, text []byte) []byte {
+		if slices.Contains(types, n.Type()) {
+			return nil
+		}
+
+		return text
+	})
+}
+
+// SkipExtras ignores the extra nodes (e.g. comments).
+func SkipExtras() Option {
+	return func(d *Detector) {
+		d.skipExtra = true
+	}
+}
+
+// Add adds the subtrees of the file at path (whose syntax tree is rooted at
+// root, and whose source text is src) to the detector. It is safe to call
+// concurrently.
+func (d *Detector) Add(path string, root sitter.Node, src []byte) {
+	var frags []fragment
+
+	c := sitter.NewTreeCursor(root)
+	stack := []*frame{}
+
+	enter := func(n sitter.Node) {
+		f := &frame{h: fnv.New64a(), rng: n.Range(), size: 1}
+		f.h.Write(append([]byte(n.Type()), 0))
+
+		if n.ChildCount() == 0 {
+			text := []byte(n.Content(src))
+			if d.normalize != nil {
+				text = d.normalize(n, text)
+			}
+
+			f.h.Write(text)
+		}
+
+		stack = append(stack, f)
+	}
+
+	leave := func() {
+		f := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		sum := f.h.Sum64()
+
+		for _, i := range f.children {
+			frags[i].parent, frags[i].hasParent = sum, true
+		}
+
+		var parent *frame
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+			parent.h.Write(binary.LittleEndian.AppendUint64(nil, sum))
+			parent.size += f.size
+		}
+
+		if f.size >= d.minNodes {
+			frags = append(frags, fragment{Fragment: Fragment{Path: path, Range: f.rng}, hash: sum, nodes: f.size})
+
+			if parent != nil {
+				parent.children = append(parent.children, len(frags)-1)
+			}
+		}
+	}
+
+	for {
+		if n := c.CurrentNode(); !d.skipExtra || !n.IsExtra() {
+			if enter(n); c.GoToFirstChild() {
+				continue
+			}
+
+			leave()
+		}
+
+		for !c.GoToNextSibling() {
+			if !c.GoToParent() {
+				d.add(frags)
+				return
+			}
+
+			leave()
+		}
+	}
+}
+
+// Classes returns the clone classes: the groups of (two or more) fragments
+// having the same hash. The classes whose fragments are all parts of larger
+// clones are omitted. The classes are sorted by size, largest first, and the
+// fragments by position.
+func (d *Detector) Classes() (classes []Class) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for h, frags := range d.hashes {
+		if len(frags) < 2 || d.subsumed(frags) { //nolint:mnd // ok
+			continue
+		}
+
+		class := Class{Hash: h, Nodes: frags[0].nodes}
+		for _, f := range frags {
+			class.Fragments = append(class.Fragments, f.Fragment)
+		}
+
+		slices.SortFunc(class.Fragments, compare)
+		classes = append(classes, class)
+	}
+
+	slices.SortFunc(classes, func(a, b Class) int {
+		return cmp.Or(cmp.Compare(b.Nodes, a.Nodes), compare(a.Fragments[0], b.Fragments[0]))
+	})
+
+	return
+}
+
+// Pairs returns the clone pairs, i.e. all the pairs of fragments of each of
+// the [Detector.Classes].
+func (d *Detector) Pairs() (pairs []Pair) {
+	for _, c := range d.Classes() {
+		for i, a := range c.Fragments {
+			for _, b := range c.Fragments[i+1:] {
+				pairs = append(pairs, Pair{A: a, B: b, Nodes: c.Nodes})
+			}
+		}
+	}
+
+	return
+}
+
+// add records the fragments of a file.
+func (d *Detector) add(frags []fragment) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, f := range frags {
+		d.hashes[f.hash] = append(d.hashes[f.hash], f)
+	}
+}
+
+// subsumed reports whether all the fragments are parts of larger clones,
+// i.e. their parents are clones as well.
+func (d *Detector) subsumed(frags []fragment) bool {
+	for _, f := range frags {
+		if !f.hasParent || len(d.hashes[f.parent]) < 2 { //nolint:mnd // ok
+			return false
+		}
+	}
+
+	return true
+}
+
+// compare orders the fragments by position.
+func compare(a, b Fragment) int {
+	return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Range.StartByte, b.Range.StartByte),
+		cmp.Compare(b.Range.EndByte, a.Range.EndByte))
+}
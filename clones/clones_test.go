@@ -0,0 +1,208 @@
+package clones
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+// frag returns a fragment of the file at path, spanning the given bytes.
+func frag(path string, start, end uint) Fragment {
+	return Fragment{Path: path, Range: sitter.Range{StartByte: start, EndByte: end}}
+}
+
+// testDetector returns a detector holding: a class (1) of two fragments, each
+// having a child of class 2, the only other fragment of class 2 being part of
+// another class (3) of clones; and a lone fragment (4) holding a fragment of
+// class 5, the other one of class 5 being top level.
+func testDetector() *Detector {
+	d := NewDetector()
+	d.hashes = map[uint64][]fragment{
+		1: {{Fragment: frag("a", 0, 20), hash: 1, nodes: 20}, {Fragment: frag("b", 5, 25), hash: 1, nodes: 20}},
+		2: {
+			{Fragment: frag("a", 2, 12), hash: 2, nodes: 10, parent: 1, hasParent: true},
+			{Fragment: frag("b", 7, 17), hash: 2, nodes: 10, parent: 1, hasParent: true},
+			{Fragment: frag("a", 30, 40), hash: 2, nodes: 10, parent: 3, hasParent: true},
+		},
+		3: {{Fragment: frag("b", 30, 42), hash: 3, nodes: 12}, {Fragment: frag("a", 28, 40), hash: 3, nodes: 12}},
+		4: {{Fragment: frag("c", 0, 30), hash: 4, nodes: 30}},
+		5: {
+			{Fragment: frag("c", 0, 10), hash: 5, nodes: 10, parent: 4, hasParent: true},
+			{Fragment: frag("d", 0, 10), hash: 5, nodes: 10},
+		},
+	}
+
+	return d
+}
+
+func TestNewDetector(t *testing.T) {
+	t.Parallel()
+
+	if d := NewDetector(); d.minNodes != defaultMinNodes || d.normalize != nil || d.skipExtra {
+		t.Fatalf("Expected the defaults, got %+v", d)
+	}
+}
+
+func TestWithMinNodes(t *testing.T) {
+	t.Parallel()
+
+	for n, exp := range map[uint]uint{0: 1, 1: 1, 5: 5} {
+		if act := NewDetector(WithMinNodes(n)).minNodes; act != exp {
+			t.Fatalf("Expected %d for %d, got %d", exp, n, act)
+		}
+	}
+}
+
+func TestWithNormalizer(t *testing.T) {
+	t.Parallel()
+
+	d := NewDetector(WithNormalizer(func(sitter.Node, []byte) []byte { return []byte("x") }))
+	if act := string(d.normalize(sitter.Node{}, []byte("abc"))); act != "x" {
+		t.Fatal("Expected x, got", act)
+	}
+}
+
+func TestIgnoreText(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestDetectorAdd()")
+}
+
+func TestSkipExtras(t *testing.T) {
+	t.Parallel()
+
+	if d := NewDetector(SkipExtras()); !d.skipExtra {
+		t.Fatal("Expected extras to be skipped")
+	}
+}
+
+func TestDetectorAdd(t *testing.T) {
+	t.Parallel()
+
+	// The parenthesized sums are clones, once the comment is skipped, and
+	// so is (7 + 8 + 9), once the numbers are ignored.
+	files := map[string]string{
+		"a.calc": "1 + (2 + 3 + 4) + 5",
+		"b.calc": "(2 + 3 // three\n + 4) + (7 + 8 + 9)",
+	}
+
+	detect := func(opts ...Option) (act []string) {
+		d := NewDetector(append([]Option{WithMinNodes(10)}, opts...)...)
+
+		for path, src := range files {
+			root, err := sitter.Parse(context.Background(), []byte(src), gr)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			d.Add(path, root, []byte(src))
+		}
+
+		for _, c := range d.Classes() {
+			s := fmt.Sprint(c.Nodes)
+			for _, f := range c.Fragments {
+				s += fmt.Sprintf(" %s:%d-%d", f.Path, f.Range.StartByte, f.Range.EndByte)
+			}
+
+			act = append(act, s)
+		}
+
+		return
+	}
+
+	for _, tc := range []struct {
+		opts []Option
+		exp  []string
+	}{
+		{nil, nil},
+		{[]Option{SkipExtras()}, []string{"15 a.calc:4-15 b.calc:0-21"}},
+		{
+			[]Option{SkipExtras(), IgnoreText("number")},
+			[]string{"15 a.calc:4-15 b.calc:0-21 b.calc:24-35"},
+		},
+	} {
+		if act := detect(tc.opts...); !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %q, got %q", tc.exp, act)
+		}
+	}
+}
+
+func TestDetectorClasses(t *testing.T) {
+	t.Parallel()
+
+	exp := []Class{
+		{Fragments: []Fragment{frag("a", 0, 20), frag("b", 5, 25)}, Nodes: 20, Hash: 1},
+		{Fragments: []Fragment{frag("a", 28, 40), frag("b", 30, 42)}, Nodes: 12, Hash: 3},
+		{Fragments: []Fragment{frag("c", 0, 10), frag("d", 0, 10)}, Nodes: 10, Hash: 5},
+	}
+	if act := testDetector().Classes(); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+}
+
+func TestDetectorPairs(t *testing.T) {
+	t.Parallel()
+
+	d := NewDetector()
+	d.hashes = map[uint64][]fragment{7: {
+		{Fragment: frag("a", 0, 10), hash: 7, nodes: 10},
+		{Fragment: frag("b", 0, 10), hash: 7, nodes: 10},
+		{Fragment: frag("c", 0, 10), hash: 7, nodes: 10},
+	}}
+
+	exp := []Pair{
+		{A: frag("a", 0, 10), B: frag("b", 0, 10), Nodes: 10},
+		{A: frag("a", 0, 10), B: frag("c", 0, 10), Nodes: 10},
+		{A: frag("b", 0, 10), B: frag("c", 0, 10), Nodes: 10},
+	}
+	if act := d.Pairs(); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+}
+
+func TestDetectorAddFragments(t *testing.T) {
+	t.Parallel()
+
+	d := NewDetector()
+	d.add([]fragment{{hash: 1}, {hash: 2}, {hash: 1}})
+
+	if len(d.hashes[1]) != 2 || len(d.hashes[2]) != 1 {
+		t.Fatalf("Expected 2 and 1 fragments, got %v", d.hashes)
+	}
+}
+
+func TestDetectorSubsumed(t *testing.T) {
+	t.Parallel()
+
+	d := testDetector()
+
+	for h, exp := range map[uint64]bool{1: false, 2: true, 3: false, 5: false} {
+		if act := d.subsumed(d.hashes[h]); act != exp {
+			t.Fatalf("Expected %v for %d, got %v", exp, h, act)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		a, b Fragment
+		exp  int
+	}{
+		{frag("a", 0, 5), frag("a", 0, 5), 0},
+		{frag("a", 9, 10), frag("b", 0, 5), -1},
+		{frag("a", 1, 5), frag("a", 0, 5), 1},
+		{frag("a", 0, 9), frag("a", 0, 5), -1},
+	} {
+		if act := compare(tc.a, tc.b); act != tc.exp {
+			t.Fatalf("Expected %d for %v vs %v, got %d", tc.exp, tc.a, tc.b, act)
+		}
+	}
+}
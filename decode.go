@@ -0,0 +1,51 @@
+package sitter
+
+// #include "sitter.h"
+import "C"
+
+import (
+	"runtime/cgo"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// DecodeFunction decodes the first character of b, which is not empty, for
+// parsing text in a custom encoding (see [Input.Decode]). It returns the code
+// point of the character and its size in bytes, or -1 as the code point if b
+// does not start with a valid (and complete) character, along with the number
+// of bytes to skip (at least 1).
+//
+// It is called for each character the parser reads, so it should be fast.
+type DecodeFunction func(b []byte) (r rune, size int)
+
+// DecodeUTF8 decodes UTF-8 text, as the parser does by itself, for reference.
+func DecodeUTF8(b []byte) (rune, int) {
+	r, size := utf8.DecodeRune(b)
+	if r == utf8.RuneError && size <= 1 {
+		return -1, 1
+	}
+
+	return r, size
+}
+
+// DecodeLatin1 decodes Latin-1 (ISO-8859-1) text.
+func DecodeLatin1(b []byte) (rune, int) {
+	return rune(b[0]), 1
+}
+
+//export callDecodeFunc
+func callDecodeFunc(h C.uintptr_t, str *C.uint8_t, length C.uint32_t, codePoint *C.int32_t) (size C.uint32_t) {
+	read := cgo.Handle(h).Value().(*readState) //nolint:errcheck,forcetypeassert // we only ever store readStates
+	*codePoint, size = -1, 1
+
+	if read.err != nil {
+		return
+	}
+
+	defer read.recover()
+
+	r, n := read.decode(unsafe.Slice((*byte)(str), length))
+	*codePoint, size = C.int32_t(r), C.uint32_t(min(max(n, 1), int(length)))
+
+	return
+}
@@ -0,0 +1,103 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestDecodeUTF8(t *testing.T) {
+	t.Parallel()
+
+	for s, exp := range map[string][2]int{"a": {'a', 1}, "é!": {'é', 2}, "\xff": {-1, 1}, "\xc3": {-1, 1}} {
+		if r, size := DecodeUTF8([]byte(s)); r != rune(exp[0]) || size != exp[1] {
+			t.Fatalf("Expected %v for %q, got %d, %d", exp, s, r, size)
+		}
+	}
+}
+
+func TestDecodeLatin1(t *testing.T) {
+	t.Parallel()
+
+	// In Latin-1, "\xe9" is "é", which the calc grammar takes in comments.
+	src := []byte("1 + 2 // caf\xe9")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.Parse2(context.Background(), nil, Input2{Read: readAll(src), Decode: DecodeLatin1})
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	root := tree.RootNode()
+	exp := "(expression (sum left: (expression (number)) right: (expression (number))) (comment))"
+
+	if act := root.String(); act != exp {
+		t.Fatalf("Expected %s, got %s", exp, act)
+	}
+
+	if comment := root.NamedChild(1); comment.StartByte() != 6 || comment.EndByte() != uint(len(src)) {
+		t.Fatalf("Expected the comment at 6-%d, got %v", len(src), comment.Range())
+	}
+}
+
+func TestCallDecodeFunc(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	// A custom encoding, where "\x80" is "7".
+	decode := func(b []byte) (rune, int) {
+		if b[0] == 0x80 {
+			return '7', 1
+		}
+
+		return DecodeUTF8(b)
+	}
+
+	tree, err := p.Parse(context.Background(), nil, Input{
+		Read: func(offset uint32, _ Point) []byte {
+			b, _ := readAll([]byte("1 + \x80"))(offset, Point{})
+			return b
+		},
+		Decode: decode,
+	})
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := "(expression (sum left: (expression (number)) right: (expression (number))))"
+	if act := tree.RootNode().String(); act != exp {
+		t.Fatalf("Expected %s, got %s", exp, act)
+	}
+
+	_, err = p.Parse2(context.Background(), nil, Input2{Read: readAll([]byte("1 + 2")), Decode: func([]byte) (rune, int) {
+		panic("boom")
+	}})
+	if !errors.Is(err, ErrCallbackPanic) {
+		t.Fatalf("Expected %v, got %v", ErrCallbackPanic, err)
+	}
+
+	_, err = p.Parse2(context.Background(), nil, Input2{Read: readAll(nil), Encoding: InputEncodingCustom})
+	if !errors.Is(err, ErrNoDecoder) {
+		t.Fatalf("Expected %v, got %v", ErrNoDecoder, err)
+	}
+
+	if _, err = p.ParseString(context.Background(), nil, nil, InputEncodingCustom); !errors.Is(err, ErrNoDecoder) {
+		t.Fatalf("Expected %v, got %v", ErrNoDecoder, err)
+	}
+}
+
+// readAll returns a read function reading src, in one chunk.
+func readAll(src []byte) ReadFunc2 {
+	return func(offset uint32, _ Point) ([]byte, error) {
+		if int(offset) >= len(src) {
+			return nil, io.EOF
+		}
+
+		return src[offset:], nil
+	}
+}
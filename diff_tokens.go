@@ -0,0 +1,180 @@
+package sitter
+
+import "slices"
+
+// TokenHunk is a change between two versions of a source file, as found by
+// [DiffTokens]: a run of tokens removed from the old version and/or a run of
+// tokens added to the new one.
+type TokenHunk struct {
+	// Old are the removed tokens and New the added ones (either may be empty).
+	Old, New []Node
+	// OldRange spans the removed tokens and NewRange the added ones. When there
+	// are none, they are empty, at the position of the change.
+	OldRange, NewRange Range
+}
+
+// token is a leaf node, along with its type and text.
+type token struct {
+	typ, text string
+	node      Node
+}
+
+// DiffTokens compares two versions of a source file token by token (i.e. leaf
+// node by leaf node, comments included), and returns the changes, in order.
+// As the whitespace between the tokens is not compared, pure whitespace
+// changes are ignored, except where the grammar makes them significant (e.g.
+// by emitting indentation tokens).
+func DiffTokens(oldTree *Tree, oldSrc []byte, newTree *Tree, newSrc []byte) (hunks []TokenHunk) {
+	a, b := tokens(oldTree.RootNode(), oldSrc), tokens(newTree.RootNode(), newSrc)
+	i, j := 0, 0
+
+	for _, p := range append(lcs(a, b), [2]int{len(a), len(b)}) {
+		if i < p[0] || j < p[1] {
+			hunks = append(hunks, TokenHunk{
+				Old: nodes(a[i:p[0]]), New: nodes(b[j:p[1]]),
+				OldRange: span(a, i, p[0]), NewRange: span(b, j, p[1]),
+			})
+		}
+
+		i, j = p[0]+1, p[1]+1
+	}
+
+	return
+}
+
+// tokens returns the leaves of the tree rooted at n, in order.
+func tokens(n Node, src []byte) (toks []token) {
+	c := NewTreeCursor(n)
+
+	for {
+		if c.GoToFirstChild() {
+			continue
+		}
+
+		leaf := c.CurrentNode()
+		toks = append(toks, token{typ: leaf.Type(), text: leaf.Content(src), node: leaf})
+
+		for !c.GoToNextSibling() {
+			if !c.GoToParent() {
+				return
+			}
+		}
+	}
+}
+
+// lcs returns the index pairs of the matching tokens of a longest common
+// subsequence of a and b, in order, using Myers' algorithm (after trimming
+// the common prefix and suffix).
+func lcs(a, b []token) (pairs [][2]int) {
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre].equal(b[pre]) {
+		pairs = append(pairs, [2]int{pre, pre})
+		pre++
+	}
+
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf].equal(b[len(b)-1-suf]) {
+		suf++
+	}
+
+	for _, p := range myers(a[pre:len(a)-suf], b[pre:len(b)-suf]) {
+		pairs = append(pairs, [2]int{p[0] + pre, p[1] + pre})
+	}
+
+	for k := suf; k > 0; k-- {
+		pairs = append(pairs, [2]int{len(a) - k, len(b) - k})
+	}
+
+	return
+}
+
+// myers returns the index pairs of the matching tokens of a longest common
+// subsequence of a and b, in order.
+func myers(a, b []token) (pairs [][2]int) {
+	n, m := len(a), len(b)
+	off := n + m + 1
+	v := make([]int, 2*off+1)
+	trace := [][]int{}
+
+	down := func(v []int, k, d int) bool { return k == -d || (k != d && v[off+k-1] < v[off+k+1]) }
+
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v))
+
+		for k := -d; k <= d; k += 2 {
+			x := v[off+k-1] + 1
+			if down(v, k, d) {
+				x = v[off+k+1]
+			}
+
+			for y := x - k; x < n && y < m && a[x].equal(b[y]); y++ {
+				x++
+			}
+
+			if v[off+k] = x; x >= n && x-k >= m {
+				return backtrack(trace, down, off, n, m)
+			}
+		}
+	}
+
+	return
+}
+
+// backtrack walks back the trace of [myers], collecting the matching pairs.
+func backtrack(trace [][]int, down func([]int, int, int) bool, off, x, y int) (pairs [][2]int) {
+	for d := len(trace) - 1; d >= 0; d-- {
+		v, k := trace[d], x-y
+
+		prevK := k - 1
+		if down(v, k, d) {
+			prevK = k + 1
+		}
+
+		prevX := v[off+prevK]
+		prevY := prevX - prevK
+
+		for ; x > prevX && y > prevY; x, y = x-1, y-1 {
+			pairs = append(pairs, [2]int{x - 1, y - 1})
+		}
+
+		x, y = prevX, prevY
+	}
+
+	slices.Reverse(pairs)
+
+	return
+}
+
+// equal reports whether the tokens have the same type and text.
+func (t token) equal(other token) bool {
+	return t.typ == other.typ && t.text == other.text
+}
+
+// nodes returns the nodes of the tokens.
+func nodes(toks []token) (out []Node) {
+	for _, t := range toks {
+		out = append(out, t.node)
+	}
+
+	return
+}
+
+// span returns the range spanned by toks[from:to]. If empty, it is the empty
+// range at the start of toks[to] (or at the end of the last token, if none).
+func span(toks []token, from, to int) Range {
+	switch {
+	case from < to:
+		return Range{
+			StartPoint: toks[from].node.StartPoint(), EndPoint: toks[to-1].node.EndPoint(),
+			StartByte: toks[from].node.StartByte(), EndByte: toks[to-1].node.EndByte(),
+		}
+	case to < len(toks):
+		p, b := toks[to].node.StartPoint(), toks[to].node.StartByte()
+		return Range{StartPoint: p, EndPoint: p, StartByte: b, EndByte: b}
+	case to > 0:
+		p, b := toks[to-1].node.EndPoint(), toks[to-1].node.EndByte()
+		return Range{StartPoint: p, EndPoint: p, StartByte: b, EndByte: b}
+	default:
+		return Range{}
+	}
+}
@@ -0,0 +1,153 @@
+package sitter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestDiffTokens(t *testing.T) {
+	t.Parallel()
+
+	parse := func(src string) *Tree {
+		p := NewParser()
+		p.SetLanguage(gr)
+
+		tree, err := p.ParseString(context.Background(), nil, []byte(src))
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		return tree
+	}
+
+	texts := func(nodes []Node, src string) (out []string) {
+		for _, n := range nodes {
+			out = append(out, n.Content([]byte(src)))
+		}
+
+		return
+	}
+
+	testCases := []struct {
+		old, new string
+		exp      [][2][]string
+		ranges   [][2][2]uint
+	}{
+		{"1 + 2", "1  +\n2", nil, nil},
+		{"1 + 2", "1 + 3", [][2][]string{{{"2"}, {"3"}}}, [][2][2]uint{{{4, 5}, {4, 5}}}},
+		{"1 + 2", "1 + 2 + 3", [][2][]string{{nil, {"+", "3"}}}, [][2][2]uint{{{5, 5}, {6, 9}}}},
+		{"7 + 1 + 2", "1 + 2", [][2][]string{{{"7", "+"}, nil}}, [][2][2]uint{{{0, 3}, {0, 0}}}},
+		{
+			"1 + 2 + 3 // x", "4 + 2 + 3 // y",
+			[][2][]string{{{"1"}, {"4"}}, {{"// x"}, {"// y"}}},
+			[][2][2]uint{{{0, 1}, {0, 1}}, {{10, 14}, {10, 14}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.old+" vs "+tc.new, func(t *testing.T) {
+			t.Parallel()
+
+			var act [][2][]string
+
+			var ranges [][2][2]uint
+
+			for _, h := range DiffTokens(parse(tc.old), []byte(tc.old), parse(tc.new), []byte(tc.new)) {
+				act = append(act, [2][]string{texts(h.Old, tc.old), texts(h.New, tc.new)})
+				ranges = append(ranges, [2][2]uint{
+					{h.OldRange.StartByte, h.OldRange.EndByte}, {h.NewRange.StartByte, h.NewRange.EndByte},
+				})
+			}
+
+			if !reflect.DeepEqual(act, tc.exp) {
+				t.Fatalf("Expected %q, got %q", tc.exp, act)
+			}
+
+			if !reflect.DeepEqual(ranges, tc.ranges) {
+				t.Fatalf("Expected %v, got %v", tc.ranges, ranges)
+			}
+		})
+	}
+}
+
+func TestTokens(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("1 + (2)")
+
+	root, err := Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	var act []string
+	for _, tok := range tokens(root, src) {
+		act = append(act, tok.typ+":"+tok.text)
+	}
+
+	exp := []string{"number:1", "+:+", "(:(", "number:2", "):)"}
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestLcs(t *testing.T) {
+	t.Parallel()
+
+	toks := func(s string) (out []token) {
+		for _, r := range s {
+			out = append(out, token{text: string(r)})
+		}
+
+		return
+	}
+
+	testCases := []struct {
+		a, b string
+		exp  [][2]int
+	}{
+		{"", "", nil},
+		{"abc", "", nil},
+		{"", "abc", nil},
+		{"abc", "abc", [][2]int{{0, 0}, {1, 1}, {2, 2}}},
+		{"abcabba", "cbabac", [][2]int{{2, 0}, {3, 2}, {4, 3}, {6, 4}}},
+		{"xaby", "xcby", [][2]int{{0, 0}, {2, 2}, {3, 3}}},
+	}
+
+	for _, tc := range testCases {
+		if act := lcs(toks(tc.a), toks(tc.b)); !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %v for %q vs %q, got %v", tc.exp, tc.a, tc.b, act)
+		}
+	}
+}
+
+func TestMyers(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestBacktrack(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestTokenEqual(t *testing.T) {
+	t.Parallel()
+
+	a := token{typ: "number", text: "1"}
+	if !a.equal(token{typ: "number", text: "1", node: Node{}}) || a.equal(token{typ: "number", text: "2"}) ||
+		a.equal(token{typ: "+", text: "1"}) {
+		t.Fatal("Expected tokens to be compared by type and text")
+	}
+}
+
+func TestNodes(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestSpan(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
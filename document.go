@@ -0,0 +1,172 @@
+package sitter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Document is a source text kept in sync with its syntax tree, as it is
+// edited: the edits are described by their byte offsets (see
+// [Document.ApplyEdit]) or by their row/column positions (see
+// [Document.ApplyPointEdit]), the document computing the rest of the
+// [InputEdit], editing the tree and parsing the text again, incrementally.
+//
+// Documents are not safe for concurrent use.
+type Document struct {
+	parser *Parser
+	tree   *Tree
+	src    []byte
+	lines  []uint // The offset each line starts at.
+}
+
+// ErrInvalidEdit is returned for the edits out of the document's bounds.
+var ErrInvalidEdit = errors.New("invalid edit")
+
+// NewDocument parses the source text (which it copies) with the given
+// language, returning the resulting document.
+func NewDocument(ctx context.Context, lang *Language, src []byte) (d *Document, err error) {
+	p, err := NewParserWith(WithLanguage(lang))
+	if err != nil {
+		return
+	}
+
+	d = &Document{parser: p, src: bytes.Clone(src)}
+	d.index()
+
+	if err = d.Parse(ctx); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// Text returns the document's source text, which must not be modified.
+func (d *Document) Text() []byte {
+	return d.src
+}
+
+// Tree returns the document's syntax tree.
+func (d *Document) Tree() *Tree {
+	return d.tree
+}
+
+// RootNode returns the root node of the document's syntax tree.
+func (d *Document) RootNode() Node {
+	return d.tree.RootNode()
+}
+
+// Parse parses the document's source text again, incrementally. It is only
+// needed after an edit failed to parse it (e.g. on timeout or cancellation).
+func (d *Document) Parse(ctx context.Context) error {
+	tree, err := d.parser.ParseString(ctx, d.tree, d.src)
+	if err != nil {
+		return err
+	}
+
+	d.tree = tree
+
+	return nil
+}
+
+// ApplyEdit replaces the text from the start to the (old) end byte offsets
+// with the new text, then parses the document again, returning the ranges
+// whose syntactic structure changed (see [Tree.GetChangedRanges]).
+//
+// Should the parsing fail, the text and the tree are still edited, the tree
+// being out of date until parsed again (see [Document.Parse]).
+func (d *Document) ApplyEdit(ctx context.Context, start, oldEnd uint, newText []byte) ([]Range, error) {
+	if start > oldEnd || oldEnd > uint(len(d.src)) {
+		return nil, fmt.Errorf("%w: %d-%d out of 0-%d", ErrInvalidEdit, start, oldEnd, len(d.src))
+	}
+
+	startPoint := d.point(start)
+	edit := InputEdit{
+		StartIndex: start, OldEndIndex: oldEnd, NewEndIndex: start + uint(len(newText)),
+		StartPoint: startPoint, OldEndPoint: d.point(oldEnd), NewEndPoint: advancePoint(startPoint, newText),
+	}
+
+	src := make([]byte, 0, len(d.src)-int(oldEnd-start)+len(newText))
+	src = append(append(append(src, d.src[:start]...), newText...), d.src[oldEnd:]...)
+	d.src = src
+	d.index()
+
+	old := d.tree
+	old.Edit(edit)
+
+	if err := d.Parse(ctx); err != nil {
+		return nil, err
+	}
+
+	return old.GetChangedRanges(d.tree), nil
+}
+
+// ApplyPointEdit is like [Document.ApplyEdit], for the edits described by
+// their start and (old) end positions instead (e.g. the LSP ones, once their
+// columns converted to bytes), the columns being byte offsets in the rows.
+func (d *Document) ApplyPointEdit(ctx context.Context, start, oldEnd Point, newText []byte) ([]Range, error) {
+	startByte, err := d.Offset(start)
+	if err != nil {
+		return nil, err
+	}
+
+	oldEndByte, err := d.Offset(oldEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.ApplyEdit(ctx, startByte, oldEndByte, newText)
+}
+
+// index computes the offsets the lines start at.
+func (d *Document) index() {
+	d.lines = append(d.lines[:0], 0)
+
+	for i, c := range d.src {
+		if c == '\n' {
+			d.lines = append(d.lines, uint(i+1))
+		}
+	}
+}
+
+// point returns the position of the given byte offset.
+func (d *Document) point(offset uint) Point {
+	row := sort.Search(len(d.lines), func(i int) bool { return d.lines[i] > offset }) - 1
+	return Point{Row: uint(row), Column: offset - d.lines[row]}
+}
+
+// byteRange returns the range from the start to the end byte offsets.
+func (d *Document) byteRange(start, end uint) Range {
+	return Range{StartByte: start, EndByte: end, StartPoint: d.point(start), EndPoint: d.point(end)}
+}
+
+// Offset returns the byte offset of the given position, which must be within
+// the document (up to the end of its row), or fails with [ErrInvalidEdit].
+func (d *Document) Offset(p Point) (uint, error) {
+	if _, err := d.line(p); err != nil {
+		return 0, err
+	}
+
+	return d.lines[p.Row] + p.Column, nil
+}
+
+// line returns the text of the row of the given position (without its
+// newline), checking that the position is within it.
+func (d *Document) line(p Point) ([]byte, error) {
+	if p.Row >= uint(len(d.lines)) {
+		return nil, fmt.Errorf("%w: row %d out of 0-%d", ErrInvalidEdit, p.Row, len(d.lines)-1)
+	}
+
+	end := uint(len(d.src))
+	if p.Row+1 < uint(len(d.lines)) {
+		end = d.lines[p.Row+1] - 1 // Before the newline.
+	}
+
+	if line := d.src[d.lines[p.Row]:end]; p.Column <= uint(len(line)) {
+		return line, nil
+	}
+
+	return nil, fmt.Errorf("%w: column %d out of row %d", ErrInvalidEdit, p.Column, p.Row)
+}
@@ -0,0 +1,183 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewDocument(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("1 + 2")
+
+	d, err := NewDocument(context.Background(), gr, src)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	src[0] = '3'
+
+	if act := string(d.Text()); act != "1 + 2" {
+		t.Fatal("Expected the text to be copied, got", act)
+	}
+
+	if _, err = NewDocument(context.Background(), nil, src); !errors.Is(err, ErrNoLanguage) {
+		t.Fatalf("Expected %v, got %v", ErrNoLanguage, err)
+	}
+}
+
+func TestDocumentText(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestDocumentTree(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestDocumentRootNode(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestDocumentParse(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestDocumentApplyEdit(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewDocument(context.Background(), gr, []byte("1 + 2\n// c"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	edits := []struct {
+		start, oldEnd uint
+		text, exp     string
+	}{
+		{4, 5, "(3 +\n4)", "1 + (3 +\n4)\n// c"},
+		{0, 0, "// a\n", "// a\n1 + (3 +\n4)\n// c"},
+		{10, 14, "", "// a\n1 + (4)\n// c"},
+		{16, 17, "\n5 + 6", "// a\n1 + (4)\n// \n5 + 6"},
+	}
+
+	for _, e := range edits {
+		if _, err = d.ApplyEdit(context.Background(), e.start, e.oldEnd, []byte(e.text)); err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if act := string(d.Text()); act != e.exp {
+			t.Fatalf("Expected %q, got %q", e.exp, act)
+		}
+
+		testDocumentTree(t, d)
+	}
+
+	if _, err = d.ApplyEdit(context.Background(), 3, 2, nil); !errors.Is(err, ErrInvalidEdit) {
+		t.Fatalf("Expected %v, got %v", ErrInvalidEdit, err)
+	}
+
+	if _, err = d.ApplyEdit(context.Background(), 0, 99, nil); !errors.Is(err, ErrInvalidEdit) {
+		t.Fatalf("Expected %v, got %v", ErrInvalidEdit, err)
+	}
+}
+
+func TestDocumentApplyPointEdit(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewDocument(context.Background(), gr, []byte("1 +\n2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	changed, err := d.ApplyPointEdit(context.Background(), Point{Row: 1}, Point{Row: 1, Column: 1}, []byte("(3 + 4)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act, exp := string(d.Text()), "1 +\n(3 + 4)"; act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	if len(changed) == 0 {
+		t.Fatal("Expected changed ranges")
+	}
+
+	testDocumentTree(t, d)
+
+	for _, p := range []Point{{Row: 2}, {Column: 4}, {Row: 1, Column: 8}} {
+		if _, err = d.ApplyPointEdit(context.Background(), p, p, nil); !errors.Is(err, ErrInvalidEdit) {
+			t.Fatalf("Expected %v for %v, got %v", ErrInvalidEdit, p, err)
+		}
+	}
+}
+
+func TestDocumentIndex(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestDocumentPoint(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestDocumentByteRange(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestDocumentOffset(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestDocumentLine(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+// testDocumentTree checks that the document's tree is the same as the one of
+// its text, parsed from scratch, positions included.
+func testDocumentTree(t *testing.T, d *Document) {
+	t.Helper()
+
+	root, err := Parse(context.Background(), d.Text(), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp, act := NewTreeCursor(root), NewTreeCursor(d.RootNode())
+
+	for {
+		en, an := exp.CurrentNode(), act.CurrentNode()
+		if en.String() != an.String() || en.Range() != an.Range() {
+			t.Fatalf("Expected %s at %+v, got %s at %+v", en, en.Range(), an, an.Range())
+		}
+
+		if ok := exp.GoToFirstChild(); ok != act.GoToFirstChild() {
+			t.Fatalf("Expected %s to have children: %v", an, ok)
+		} else if ok {
+			continue
+		}
+
+		for {
+			ok := exp.GoToNextSibling()
+			if ok != act.GoToNextSibling() {
+				t.Fatalf("Expected %s to have a next sibling: %v", an, ok)
+			} else if ok {
+				break
+			}
+
+			if !exp.GoToParent() {
+				return
+			}
+
+			act.GoToParent()
+		}
+	}
+}
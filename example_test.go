@@ -0,0 +1,114 @@
+package sitter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+// Trees are not released by the garbage collector, so a node can be safely
+// used after the tree (and the parser) that produced it went out of scope.
+func Example_nodeOutlivesTree() {
+	root := func() sitter.Node {
+		p := sitter.NewParser()
+		p.SetLanguage(sittertest.Grammar())
+
+		tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+		if err != nil {
+			panic(err)
+		}
+
+		return tree.RootNode()
+	}()
+
+	runtime.GC()
+
+	fmt.Println(root.Child(0))
+	// Output:
+	// (sum left: (expression (number)) right: (expression (number)))
+}
+
+// A parse halted via the cancellation flag leaves the parser in a state where
+// the next call resumes the halted parse. To reuse the parser for a different
+// input, clear the flag and reset the parser.
+func ExampleParser_Reset() {
+	p := sitter.NewParser()
+	p.SetLanguage(sittertest.Grammar())
+
+	cancel := uint64(1)
+	p.SetCancellationFlag(&cancel)
+
+	_, err := p.ParseString(context.Background(), nil, []byte(strings.Repeat("1 + ", 1000)+"1"))
+	fmt.Println(errors.Is(err, sitter.ErrOperationLimit))
+
+	cancel = 0
+	p.Reset()
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(tree.RootNode())
+	// Output:
+	// true
+	// (expression (sum left: (expression (number)) right: (expression (number))))
+}
+
+// A single tree cursor can be reused to walk any number of trees, which
+// avoids allocating a new cursor for each one.
+func ExampleTreeCursor_Reset() {
+	var c *sitter.TreeCursor
+
+	for _, input := range []string{"1", "1 + 2", "1 + 2 + 3"} {
+		root, err := sitter.Parse(context.Background(), []byte(input), sittertest.Grammar())
+		if err != nil {
+			panic(err)
+		}
+
+		if c == nil {
+			c = sitter.NewTreeCursor(root)
+		} else {
+			c.Reset(root)
+		}
+
+		c.GoToFirstChild()
+		fmt.Println(input, "=>", c.CurrentNode().Type())
+	}
+	// Output:
+	// 1 => number
+	// 1 + 2 => sum
+	// 1 + 2 + 3 => sum
+}
+
+// The match returned by Next is overwritten by the following call, so any
+// data that must outlive the iteration has to be copied out of it.
+func ExampleQueryMatches_Next() {
+	input := []byte("1 + 22 + 333")
+
+	root, err := sitter.Parse(context.Background(), input, sittertest.Grammar())
+	if err != nil {
+		panic(err)
+	}
+
+	q, err := sitter.NewQuery(sittertest.Grammar(), []byte("(number) @number"))
+	if err != nil {
+		panic(err)
+	}
+
+	var numbers []string
+
+	matches := sitter.NewQueryCursor().Matches(q, root, input)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		numbers = append(numbers, m.Captures[0].Node.Content(input))
+	}
+
+	fmt.Println(numbers)
+	// Output:
+	// [1 22 333]
+}
@@ -1,6 +1,6 @@
 module github.com/alexaandru/go-tree-sitter-bare
 
-go 1.22.1
+go 1.23.0
 
 retract (
 	v1.4.1 // Bugfix was not good enough...
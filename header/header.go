@@ -0,0 +1,268 @@
+// Package header detects the file header comments (e.g. license or copyright
+// notices) matching a template, and builds the edits inserting them into the
+// files missing them, after any shebang or build tags:
+//
+//	c, err := header.NewChecker("Copyright {year} The Authors.\nSPDX-License-Identifier: MIT")
+//	// ...
+//	if e, missing := c.Insertion(root, src); missing {
+//		src = e.Apply(src)
+//	}
+//
+// The headers are looked for among the comments at the start of the syntax
+// tree, regardless of their comment syntax (the //, #, --, ; and /* */ markers
+// are ignored) and of the amount of whitespace.
+package header
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Option configures a [Checker].
+type Option func(*Checker) error
+
+// Checker checks the headers of files against a template, see [NewChecker].
+type Checker struct {
+	re         *regexp.Regexp
+	template   string
+	prefix     string
+	directives []*regexp.Regexp
+	year       int
+}
+
+// Edit is the insertion of a header.
+type Edit struct {
+	Text   string
+	Offset uint
+	Point  sitter.Point
+}
+
+//nolint:gochecknoglobals // ok
+var (
+	// DefaultDirectives match the lines kept before the header: shebangs,
+	// build tags and encoding declarations.
+	DefaultDirectives = []string{`^#!`, `^//go:build `, `^// \+build `, `^#.*-\*-.*-\*-`}
+
+	markersRe = regexp.MustCompile(`(?m)^[ \t]*(?:/\*+|\*+/|//+|#+|--+|;+|\*)?|\*+/[ \t]*$`)
+	spacesRe  = regexp.MustCompile(`\s+`)
+)
+
+const yearPlaceholder = "{year}"
+
+// NewChecker returns a checker of the headers against the template, i.e. the
+// header text, without the comment markers. The {year} placeholder stands for
+// any year (or years range, e.g. 2020-2024) when checking, and for the current
+// year when inserting. An error is returned if any of the directives (see
+// [WithDirectives]) is not a valid regular expression.
+func NewChecker(template string, opts ...Option) (c *Checker, err error) {
+	c = &Checker{template: template, prefix: "// ", year: time.Now().Year()}
+	if err = WithDirectives(DefaultDirectives...)(c); err != nil {
+		return nil, err
+	}
+
+	for _, opt := range opts {
+		if err = opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	pattern := regexp.QuoteMeta(normalize(template))
+	pattern = strings.ReplaceAll(pattern, regexp.QuoteMeta(yearPlaceholder), `\d{4}(?:\s*-\s*\d{4})?`)
+	pattern = strings.ReplaceAll(pattern, " ", `\s+`)
+	c.re = regexp.MustCompile("^" + pattern + "$")
+
+	return
+}
+
+// WithDirectives sets the regular expressions matching the lines to keep
+// before the header, at the start of the files (instead of the
+// [DefaultDirectives]).
+func WithDirectives(directives ...string) Option {
+	return func(c *Checker) error {
+		c.directives = nil
+
+		for _, d := range directives {
+			re, err := regexp.Compile(d)
+			if err != nil {
+				return err
+			}
+
+			c.directives = append(c.directives, re)
+		}
+
+		return nil
+	}
+}
+
+// WithLinePrefix sets the prefix of the lines of the inserted headers, i.e.
+// the line comment marker ("// " by default).
+func WithLinePrefix(prefix string) Option {
+	return func(c *Checker) error {
+		c.prefix = prefix
+		return nil
+	}
+}
+
+// WithYear sets the year of the inserted headers (the current one by default).
+func WithYear(year int) Option {
+	return func(c *Checker) error {
+		c.year = year
+		return nil
+	}
+}
+
+// Find returns the range of the header of the file rooted at root (whose
+// source text is src), if it has one: a block of comments (on consecutive
+// rows) among the comments at the start of the file, matching the template.
+func (c *Checker) Find(root sitter.Node, src []byte) (rng sitter.Range, ok bool) {
+	var block []sitter.Node
+
+	check := func() bool {
+		if len(block) == 0 {
+			return false
+		}
+
+		var text strings.Builder
+		for _, n := range block {
+			text.WriteString(n.Content(src) + "\n")
+		}
+
+		rng = sitter.Range{
+			StartPoint: block[0].StartPoint(), EndPoint: block[len(block)-1].EndPoint(),
+			StartByte: block[0].StartByte(), EndByte: block[len(block)-1].EndByte(),
+		}
+
+		return c.re.MatchString(normalize(text.String()))
+	}
+
+	for _, n := range leadingComments(root) {
+		if c.isDirective(n.Content(src)) {
+			continue
+		}
+
+		if k := len(block); k > 0 && n.StartPoint().Row > block[k-1].EndPoint().Row+1 {
+			if check() {
+				return rng, true
+			}
+
+			block = block[:0]
+		}
+
+		block = append(block, n)
+	}
+
+	if check() {
+		return rng, true
+	}
+
+	return sitter.Range{}, false
+}
+
+// Insertion returns the edit inserting the header into the file rooted at
+// root (whose source text is src), if it has none: the header goes at the
+// start of the file, after the directives (shebangs, build tags, etc.), if
+// any, and is separated by blank lines from what surrounds it.
+func (c *Checker) Insertion(root sitter.Node, src []byte) (e Edit, missing bool) {
+	if _, ok := c.Find(root, src); ok {
+		return
+	}
+
+	var text strings.Builder
+
+	for _, d := range bytes.SplitAfter(src, []byte("\n")) {
+		if !c.isDirective(string(d)) || !bytes.HasSuffix(d, []byte("\n")) {
+			break
+		}
+
+		e.Offset += uint(len(d))
+		e.Point.Row++
+	}
+
+	if e.Offset > 0 {
+		text.WriteString("\n")
+	}
+
+	year := strconv.Itoa(c.year)
+	for _, line := range strings.Split(strings.ReplaceAll(c.template, yearPlaceholder, year), "\n") {
+		text.WriteString(strings.TrimRight(c.prefix+line, " \t") + "\n")
+	}
+
+	if rest := src[e.Offset:]; len(rest) > 0 && rest[0] != '\n' {
+		text.WriteString("\n")
+	}
+
+	e.Text = text.String()
+
+	return e, true
+}
+
+// Apply applies the edit to src.
+func (e Edit) Apply(src []byte) []byte {
+	return append(append(append([]byte{}, src[:e.Offset]...), e.Text...), src[e.Offset:]...)
+}
+
+// InputEdit returns the [sitter.InputEdit] describing the edit, for
+// reparsing the file incrementally after applying it.
+func (e Edit) InputEdit() sitter.InputEdit {
+	end := e.Point
+	for _, b := range []byte(e.Text) {
+		if b == '\n' {
+			end.Row, end.Column = end.Row+1, 0
+		} else {
+			end.Column++
+		}
+	}
+
+	return sitter.InputEdit{
+		StartIndex: e.Offset, OldEndIndex: e.Offset, NewEndIndex: e.Offset + uint(len(e.Text)),
+		StartPoint: e.Point, OldEndPoint: e.Point, NewEndPoint: end,
+	}
+}
+
+// isDirective reports whether the line is a directive.
+func (c *Checker) isDirective(line string) bool {
+	for _, re := range c.directives {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// leadingComments returns the comments (i.e. extra nodes) at the start of the
+// tree rooted at root, before its first other node.
+func leadingComments(root sitter.Node) (comments []sitter.Node) {
+	for n := root; n.ChildCount() > 0; {
+		var first sitter.Node
+
+		for i := range n.ChildCount() {
+			child := n.Child(i)
+			if !child.IsExtra() {
+				first = child
+				break
+			}
+
+			comments = append(comments, child)
+		}
+
+		if first.IsNull() {
+			break
+		}
+
+		n = first
+	}
+
+	return
+}
+
+// normalize strips the comment markers from the text and collapses its
+// whitespace.
+func normalize(text string) string {
+	return strings.TrimSpace(spacesRe.ReplaceAllString(markersRe.ReplaceAllString(text, ""), " "))
+}
@@ -0,0 +1,185 @@
+package header
+
+import (
+	"context"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestNewChecker(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewChecker("Copyright {year} ACME.\n\nAll rights reserved.")
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for text, exp := range map[string]bool{
+		"Copyright 2024 ACME. All rights reserved.":          true,
+		"Copyright 2020 - 2024  ACME.\nAll rights reserved.": true,
+		"Copyright 2024 ACME.":                               false,
+		"Copyright 24 ACME. All rights reserved.":            false,
+		"Copyright 2024 ACME! All rights reserved.":          false,
+	} {
+		if act := c.re.MatchString(normalize(text)); act != exp {
+			t.Fatalf("Expected %v for %q, got %v", exp, text, act)
+		}
+	}
+
+	if _, err = NewChecker("x", WithDirectives("(")); err == nil {
+		t.Fatal("Expected an error")
+	}
+}
+
+func TestWithDirectives(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewChecker("x", WithDirectives("^%!"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if !c.isDirective("%!PS") || c.isDirective("#!/bin/sh") {
+		t.Fatal("Expected only the given directives")
+	}
+}
+
+func TestWithLinePrefix(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestWithYear(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestCheckerFind()")
+}
+
+func TestCheckerFind(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewChecker("Copyright {year} ACME.\nMIT licensed.", WithYear(2024))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	parse := func(src string) sitter.Node {
+		root, err := sitter.Parse(context.Background(), []byte(src), gr)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		return root
+	}
+
+	testCases := []struct {
+		src, exp string
+	}{
+		{"//go:build linux\n\n// Copyright 2021-2023 ACME.\n//   MIT\n// licensed.\n1 + 2\n", ""},
+		{"// other\n\n//Copyright 2021 ACME.\n//MIT licensed.\n\n1 + 2\n", ""},
+		{
+			"//go:build linux\n1 + 2\n",
+			"//go:build linux\n\n// Copyright 2024 ACME.\n// MIT licensed.\n\n1 + 2\n",
+		},
+		{"// other\n1 + 2", "// Copyright 2024 ACME.\n// MIT licensed.\n\n// other\n1 + 2"},
+		{
+			"1 + 2 // Copyright 2024 ACME. MIT licensed.\n",
+			"// Copyright 2024 ACME.\n// MIT licensed.\n\n1 + 2 // Copyright 2024 ACME. MIT licensed.\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		e, missing := c.Insertion(parse(tc.src), []byte(tc.src))
+		if missing != (tc.exp != "") {
+			t.Fatalf("Expected missing to be %v for %q", tc.exp != "", tc.src)
+		}
+
+		if !missing {
+			continue
+		}
+
+		src := e.Apply([]byte(tc.src))
+		if string(src) != tc.exp {
+			t.Fatalf("Expected %q, got %q", tc.exp, src)
+		}
+
+		if _, ok := c.Find(parse(string(src)), src); !ok {
+			t.Fatalf("Expected the header to be found in %q", src)
+		}
+	}
+}
+
+func TestCheckerInsertion(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestCheckerFind()")
+}
+
+func TestEditApply(t *testing.T) {
+	t.Parallel()
+
+	if act := string(Edit{Text: "# x\n", Offset: 3}.Apply([]byte("#!\nfoo"))); act != "#!\n# x\nfoo" {
+		t.Fatalf("Expected %q, got %q", "#!\n# x\nfoo", act)
+	}
+}
+
+func TestEditInputEdit(t *testing.T) {
+	t.Parallel()
+
+	e := Edit{Text: "\n# x\n# yz", Offset: 3, Point: sitter.Point{Row: 1}}
+	exp := sitter.InputEdit{
+		StartIndex: 3, OldEndIndex: 3, NewEndIndex: 12,
+		StartPoint: sitter.Point{Row: 1}, OldEndPoint: sitter.Point{Row: 1}, NewEndPoint: sitter.Point{Row: 3, Column: 4},
+	}
+
+	if act := e.InputEdit(); act != exp {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+}
+
+func TestCheckerIsDirective(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewChecker("x", WithLinePrefix("# "))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for line, exp := range map[string]bool{
+		"#!/usr/bin/env python3\n":  true,
+		"//go:build linux":          true,
+		"// +build linux":           true,
+		"# -*- coding: utf-8 -*-\n": true,
+		"# hello":                   false,
+		"package main":              false,
+	} {
+		if act := c.isDirective(line); act != exp {
+			t.Fatalf("Expected %v for %q, got %v", exp, line, act)
+		}
+	}
+}
+
+func TestLeadingComments(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestCheckerFind()")
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	for text, exp := range map[string]string{
+		"// a\n//  b\n":         "a b",
+		"# a\n## b":             "a b",
+		"/*\n * a\n * b\n */":   "a b",
+		"/* a */":               "a",
+		"-- a\n; b":             "a b",
+		"// see https://x.y/z.": "see https://x.y/z.",
+	} {
+		if act := normalize(text); act != exp {
+			t.Fatalf("Expected %q for %q, got %q", exp, text, act)
+		}
+	}
+}
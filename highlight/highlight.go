@@ -0,0 +1,235 @@
+// Package highlight splits source files into highlighted spans of text, as
+// told by highlights queries, i.e. tree-sitter queries whose capture names are
+// the highlight names (e.g. @keyword or @function.builtin).
+//
+// The spans cover the whole text, in order, without overlapping: where the
+// captured nodes nest, the innermost capture wins, and where a node is
+// captured more than once, the first pattern wins. The captures whose names
+// start with an underscore are private to the query (e.g. for predicates),
+// so they are not highlighted.
+//
+// The captures can be concealed, e.g. for hiding the markup characters when
+// rendering Markdown, by setting the "conceal" property to the replacement
+// text (empty for hiding them), for all the captures of the pattern or just
+// for one:
+//
+//	((emphasis_delimiter) @punctuation (#set! conceal ""))
+//	((list_marker) @marker (#set! @marker conceal "•"))
+package highlight
+
+import (
+	"cmp"
+	"slices"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Rules are the highlights rules of a language, see [NewRules].
+type Rules struct {
+	query *sitter.Query
+}
+
+// Span is a span of highlighted (or not) text.
+type Span struct {
+	// Capture is the highlight name, empty for the text not highlighted.
+	Capture string
+	// Text is the text to display: the source text of the span, or its
+	// replacement, if concealed.
+	Text  string
+	Range sitter.Range
+	// Concealed tells whether the span is concealed, in which case its
+	// nested captures are ignored.
+	Concealed bool
+}
+
+// capture is a captured node to highlight.
+type capture struct {
+	conceal *string
+	name    string
+	rng     sitter.Range
+	pattern uint
+}
+
+const concealKey = "conceal"
+
+// NewRules compiles the highlights rules of a language.
+func NewRules(lang *sitter.Language, pattern string) (*Rules, error) {
+	q, err := sitter.NewQuery(lang, []byte(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rules{query: q}, nil
+}
+
+// Highlight splits the source text src, whose syntax tree is rooted at root,
+// into spans.
+func (r *Rules) Highlight(root sitter.Node, src []byte) []Span {
+	return r.HighlightRange(root, src, 0, uint(len(src)))
+}
+
+// HighlightRange splits the source text src, whose syntax tree is rooted at
+// root, into spans, like [Rules.Highlight], but only from the start to the
+// end byte offsets (e.g. the lines visible in a viewport), running the query
+// over that range only. The constructs starting before the range (e.g. a
+// multi-line comment) are still highlighted, their spans being clipped to it.
+func (r *Rules) HighlightRange(root sitter.Node, src []byte, start, end uint) []Span {
+	end = min(end, uint(len(src)))
+	start = min(start, end)
+
+	// The query runs over the smallest node spanning the range, then over each
+	// of its ancestors, for the patterns starting at them, as the matches
+	// starting before the range may still capture nodes within it.
+	n := root.DescendantForByteRange(uint32(start), uint32(end)) //nolint:gosec // ok
+
+	qc := sitter.NewQueryCursor()
+	qc.SetByteRange(uint32(start), uint32(end)) //nolint:gosec // ok
+
+	caps := r.captures(nil, qc, n, src)
+
+	qc.SetMaxStartDepth(0)
+
+	for a := n; !a.Equal(root); {
+		a = a.Parent()
+		caps = r.captures(caps, qc, a, src)
+	}
+
+	slices.SortStableFunc(caps, func(a, b capture) int {
+		return cmp.Or(cmp.Compare(a.rng.StartByte, b.rng.StartByte), cmp.Compare(b.rng.EndByte, a.rng.EndByte),
+			cmp.Compare(a.pattern, b.pattern))
+	})
+
+	caps = slices.CompactFunc(caps, func(a, b capture) bool { return a.rng == b.rng })
+
+	var point sitter.Point
+	if off := n.StartByte(); off <= start {
+		point = advance(n.StartPoint(), src[off:start])
+	} else {
+		point = advance(point, src[:start])
+	}
+
+	return flatten(caps, src, start, end, point)
+}
+
+// captures appends the captures to highlight, of the matches of the query run
+// by the cursor over the node, to caps.
+func (r *Rules) captures(caps []capture, qc *sitter.QueryCursor, n sitter.Node, src []byte) []capture {
+	names := r.query.CaptureNames()
+
+	matches := qc.Matches(r.query, n, src)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		props := r.query.PropertySettings(m.PatternIndex)
+
+		for _, c := range m.CaptureSummaries() {
+			cp := capture{name: names[c.Index], rng: c.Range, pattern: m.PatternIndex}
+			cp.conceal = conceal(props, uint(c.Index))
+
+			if cp.name[0] != '_' || cp.conceal != nil {
+				caps = append(caps, cp)
+			}
+		}
+	}
+
+	return caps
+}
+
+// conceal returns the replacement text of the capture, if it is concealed
+// (the properties of the capture taking precedence over the pattern's).
+func conceal(props []sitter.QueryProperty, captureID uint) (text *string) {
+	empty := ""
+
+	for _, p := range props {
+		if p.Key != concealKey || p.CaptureID != nil && *p.CaptureID != captureID {
+			continue
+		}
+
+		if text = p.Value; text == nil {
+			text = &empty
+		}
+
+		if p.CaptureID != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// flatten turns the (nested) captures, sorted, into spans covering the text
+// from the start to the end offsets, the start being at the given point.
+func flatten(caps []capture, src []byte, start, end uint, point sitter.Point) (spans []Span) {
+	var stack []capture
+
+	pos := start
+
+	// emit adds the span from pos to the given offset (up to the end),
+	// highlighted as the innermost capture on the stack.
+	emit := func(to uint) {
+		if to = min(to, end); to <= pos {
+			return
+		}
+
+		span := Span{Text: string(src[pos:to]), Range: sitter.Range{StartByte: pos, EndByte: to, StartPoint: point}}
+		point = advance(point, src[pos:to])
+		span.Range.EndPoint, pos = point, to
+
+		if len(stack) > 0 {
+			top := stack[len(stack)-1]
+			span.Capture = top.name
+
+			if top.conceal != nil {
+				span.Text, span.Concealed = "", true
+				if span.Range.StartByte == top.rng.StartByte {
+					span.Text = *top.conceal // Not repeated if clipped.
+				}
+			}
+		}
+
+		spans = append(spans, span)
+	}
+
+	pop := func() {
+		emit(stack[len(stack)-1].rng.EndByte)
+		stack = stack[:len(stack)-1]
+	}
+
+	for _, c := range caps {
+		for len(stack) > 0 && stack[len(stack)-1].rng.EndByte <= c.rng.StartByte {
+			pop()
+		}
+
+		if len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.conceal != nil {
+				continue
+			}
+
+			c.rng.EndByte = min(c.rng.EndByte, top.rng.EndByte)
+		}
+
+		emit(c.rng.StartByte)
+
+		stack = append(stack, c)
+	}
+
+	for len(stack) > 0 {
+		pop()
+	}
+
+	emit(end)
+
+	return
+}
+
+// advance returns the position past the given text, starting at p.
+func advance(p sitter.Point, text []byte) sitter.Point {
+	for _, c := range text {
+		if c == '\n' {
+			p.Row, p.Column = p.Row+1, 0
+		} else {
+			p.Column++
+		}
+	}
+
+	return p
+}
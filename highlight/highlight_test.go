@@ -0,0 +1,162 @@
+package highlight
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestNewRules(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesHighlight()")
+}
+
+func TestRulesHighlight(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + (2)\n// c")
+
+	root, err := sitter.Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		pattern string
+		exp     []string
+	}{
+		{`(number) @number "+" @operator`, []string{"number:1", ": ", "operator:+", ": (", "number:2", ":)\n// c"}},
+		{`(sum) @sum (number) @number`, []string{"number:1", "sum: + (", "number:2", "sum:)", ":\n// c"}},
+		{`(number) @a (number) @b (number) @_private`, []string{"a:1", ": + (", "a:2", ":)\n// c"}},
+		{`((comment) @comment (#set! conceal ""))`, []string{":1 + (2)\n", "comment!"}},
+		{`("(" @punct (#set! conceal "[")) ((comment) @_c (#set! conceal))`, []string{":1 + ", "punct![", ":2)\n", "_c!"}},
+		{`((sum (expression "(" @p)) @s (#set! @p conceal "<")) (number) @n`, []string{
+			"n:1", "s: + ", "p!<", "n:2", "s:)", ":\n// c",
+		}},
+		{`((sum) @s (#set! conceal "…")) (number) @n`, []string{"s!…", ":\n// c"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := NewRules(gr, tc.pattern)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			var act []string
+
+			for _, s := range rules.Highlight(root, input) {
+				sep := ":"
+				if s.Concealed {
+					sep = "!"
+				}
+
+				act = append(act, s.Capture+sep+s.Text)
+			}
+
+			if !reflect.DeepEqual(act, tc.exp) {
+				t.Fatalf("Expected %q, got %q", tc.exp, act)
+			}
+		})
+	}
+
+	t.Run("ranges", func(t *testing.T) {
+		t.Parallel()
+
+		rules, err := NewRules(gr, `((comment) @c (#set! conceal "x"))`)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		act := fmt.Sprint(rules.Highlight(root, input))
+		exp := "[{ 1 + (2)\n {{0 0} {1 0} 0 8} false} {c x {{1 0} {1 4} 8 12} true}]"
+
+		if act != exp {
+			t.Fatalf("Expected %q, got %q", exp, act)
+		}
+	})
+}
+
+func TestRulesHighlightRange(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 +\n(2 +\n3) // c")
+
+	root, err := sitter.Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		pattern    string
+		start, end uint
+		exp        []string
+	}{
+		{`(sum) @sum (number) @number`, 0, 99, []string{
+			"number:1@0:0", "sum: +\n(@0:1", "number:2@1:1", "sum: +\n@1:2", "number:3@2:0", "sum:)@2:1", ": // c@2:2",
+		}},
+		{`(sum) @sum (number) @number`, 9, 11, []string{"number:3@2:0", "sum:)@2:1"}},
+		{`(sum) @sum (number) @number`, 7, 11, []string{"sum:+\n@1:3", "number:3@2:0", "sum:)@2:1"}},
+		{`(sum) @sum`, 5, 6, []string{"sum:2@1:1"}},
+		{`((comment) @c (#set! conceal "#"))`, 14, 99, []string{"c!@2:5"}},
+		{`((comment) @c (#set! conceal "#"))`, 11, 14, []string{": @2:2", "c!#@2:3"}},
+		{`((sum) @s (#set! conceal "…"))`, 4, 8, []string{"s!@1:0"}},
+		{`(number) @n`, 5, 5, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s %d-%d", tc.pattern, tc.start, tc.end), func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := NewRules(gr, tc.pattern)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			var act []string
+
+			for _, s := range rules.HighlightRange(root, input, tc.start, tc.end) {
+				sep := ":"
+				if s.Concealed {
+					sep = "!"
+				}
+
+				act = append(act, fmt.Sprintf("%s%s%s@%d:%d", s.Capture, sep, s.Text, s.Range.StartPoint.Row,
+					s.Range.StartPoint.Column))
+			}
+
+			if !reflect.DeepEqual(act, tc.exp) {
+				t.Fatalf("Expected %q, got %q", tc.exp, act)
+			}
+		})
+	}
+}
+
+func TestRulesCaptures(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesHighlight()")
+}
+
+func TestConceal(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesHighlight()")
+}
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesHighlight()")
+}
+
+func TestAdvance(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesHighlight()")
+}
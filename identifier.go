@@ -0,0 +1,102 @@
+package sitter
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Identifier is an identifier found at a position, see [IdentifierAt].
+type Identifier struct {
+	Text  string
+	Range Range
+	// Node is the identifier's node or, if found by scanning the text (e.g. in
+	// a comment, a string or an error), the smallest node containing it.
+	Node Node
+}
+
+// IdentifierAt returns the identifier at (or right before, for a cursor placed
+// after it) the given byte offset of the tree, whose source text is src, e.g.
+// for the go-to-definition and rename features.
+//
+// It is the identifier-like named leaf covering the offset, the grammar telling
+// the exact bounds of the token (e.g. `$x` or `foo-bar`, in the languages where
+// these are identifiers): one with no whitespace, not starting with a digit
+// and having at least one letter (or underscore), that is not an extra (e.g.
+// a comment) or an error. Failing that, the text is scanned for a word (made
+// of letters, digits and underscores, not starting with a digit) instead.
+func IdentifierAt(t *Tree, src []byte, offset uint) (id Identifier, ok bool) {
+	if offset > uint(len(src)) {
+		return
+	}
+
+	root := t.RootNode()
+
+	for _, o := range []uint{offset, offset - 1} {
+		if o >= uint(len(src)) { // Including offset - 1, wrapped around for 0.
+			continue
+		}
+
+		n := root.DescendantForByteRange(uint32(o), uint32(o+1)) //nolint:gosec // ok
+		if n.ChildCount() == 0 && n.IsNamed() && !n.IsExtra() && !n.IsError() {
+			if text := n.Content(src); isIdentifier(text, isIdentifierRune) {
+				return Identifier{Text: text, Range: n.Range(), Node: n}, true
+			}
+		}
+	}
+
+	start, end := offset, offset
+
+	for start > 0 {
+		r, size := utf8.DecodeLastRune(src[:start])
+		if !isWordRune(r) {
+			break
+		}
+
+		start -= uint(size)
+	}
+
+	for end < uint(len(src)) {
+		r, size := utf8.DecodeRune(src[end:])
+		if !isWordRune(r) {
+			break
+		}
+
+		end += uint(size)
+	}
+
+	text := string(src[start:end])
+	if !isIdentifier(text, isWordRune) {
+		return
+	}
+
+	n := root.DescendantForByteRange(uint32(start), uint32(end)) //nolint:gosec // ok
+	startPoint := advancePoint(n.StartPoint(), src[n.StartByte():start])
+	rng := Range{
+		StartPoint: startPoint, EndPoint: advancePoint(startPoint, src[start:end]),
+		StartByte: start, EndByte: end,
+	}
+
+	return Identifier{Text: text, Range: rng, Node: n}, true
+}
+
+// isIdentifier tells whether the text is made of valid runes, not starting
+// with a digit and having at least one letter (or underscore).
+func isIdentifier(text string, valid func(rune) bool) (ok bool) {
+	for i, r := range text {
+		if !valid(r) || i == 0 && unicode.IsDigit(r) {
+			return false
+		}
+
+		ok = ok || r == '_' || unicode.IsLetter(r)
+	}
+
+	return
+}
+
+func isIdentifierRune(r rune) bool {
+	return r != utf8.RuneError && !unicode.IsSpace(r)
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,72 @@
+package sitter
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIdentifierAt(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("a\\w + 12 // foo\n// é_1 9x")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, src)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		offset           uint
+		exp, expType     string
+		expStart, expEnd Point
+	}{
+		{0, `a\w`, "variable", Point{}, Point{Column: 3}},
+		{1, `a\w`, "variable", Point{}, Point{Column: 3}},
+		{3, `a\w`, "variable", Point{}, Point{Column: 3}},
+		{4, "", "", Point{}, Point{}},
+		{6, "", "", Point{}, Point{}},
+		{13, "foo", "comment", Point{Column: 12}, Point{Column: 15}},
+		{15, "foo", "comment", Point{Column: 12}, Point{Column: 15}},
+		{19, "é_1", "comment", Point{Row: 1, Column: 3}, Point{Row: 1, Column: 7}},
+		{24, "", "", Point{}, Point{}},
+		{99, "", "", Point{}, Point{}},
+	}
+
+	for _, tc := range testCases {
+		id, ok := IdentifierAt(tree, src, tc.offset)
+		if ok != (tc.exp != "") || id.Text != tc.exp {
+			t.Fatalf("Expected %q at %d, got %q (%v)", tc.exp, tc.offset, id.Text, ok)
+		}
+
+		if !ok {
+			continue
+		}
+
+		if act := id.Node.Type(); act != tc.expType {
+			t.Fatalf("Expected a %s node at %d, got %s", tc.expType, tc.offset, act)
+		}
+
+		if id.Range.StartPoint != tc.expStart || id.Range.EndPoint != tc.expEnd ||
+			string(src[id.Range.StartByte:id.Range.EndByte]) != tc.exp {
+			t.Fatalf("Expected %q at %v-%v, got %+v", tc.exp, tc.expStart, tc.expEnd, id.Range)
+		}
+	}
+}
+
+func TestIsIdentifier(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestIsIdentifierRune(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestIsWordRune(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
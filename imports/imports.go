@@ -0,0 +1,159 @@
+// Package imports extracts the imports (includes, requires, etc.) of source
+// files, in a normalized form, e.g. for dependency graph tooling. What an
+// import is, is told by per-language [Rules], tree-sitter queries using the
+// following capture names:
+//
+//   - @import: the import (statement, clause, spec, etc.);
+//   - @path: the imported module path; string literals are decoded (see the
+//     literal package) and C system headers (<...>) unquoted, other nodes
+//     (e.g. dotted names) are taken as is;
+//   - @alias: the name the module is imported as, if any;
+//   - @name: an imported name (e.g. in "from m import a, b"), if any.
+//
+// For instance, for Go:
+//
+//	(import_spec name: (_)? @alias path: (_) @path) @import
+//
+// The rules can be registered by language name, for the tools supporting many
+// languages, see [Register].
+package imports
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/literal"
+)
+
+// Rules are the imports rules of a language, see [NewRules].
+type Rules struct {
+	query *sitter.Query
+}
+
+// Import is an import of a file.
+type Import struct {
+	Path  string
+	Alias string
+	Names []string
+	// Range is the range of the @import node.
+	Range sitter.Range
+}
+
+var (
+	registry   = map[string]*Rules{} //nolint:gochecknoglobals // ok
+	registryMu sync.RWMutex          //nolint:gochecknoglobals // ok
+
+	decodeString = literal.NewDecoder(literal.Syntax{}) //nolint:gochecknoglobals // ok
+)
+
+// NewRules compiles the imports rules of a language.
+func NewRules(lang *sitter.Language, pattern string) (*Rules, error) {
+	q, err := sitter.NewQuery(lang, []byte(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rules{query: q}, nil
+}
+
+// Register registers the rules under the given (language) name. Registering
+// a name again replaces the rules.
+func Register(name string, r *Rules) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry[name] = r
+}
+
+// Lookup returns the rules registered under the given name, if any.
+func Lookup(name string) (r *Rules, ok bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	r, ok = registry[name]
+
+	return
+}
+
+// Languages returns the names the rules are registered under, sorted.
+func Languages() (names []string) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	for name := range registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return
+}
+
+// Extract returns the imports of the file rooted at root, whose source text
+// is src, in order. The matches of the same @import node are merged (e.g. when
+// the imported names are matched one by one).
+func (r *Rules) Extract(root sitter.Node, src []byte) (imports []Import) {
+	names := r.query.CaptureNames()
+	index := map[sitter.Range]int{}
+	qc := sitter.NewQueryCursor()
+
+	matches := qc.Matches(r.query, root, src)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		var imp Import
+
+		for _, c := range m.Captures {
+			switch names[c.Index] {
+			case "import":
+				imp.Range = c.Node.Range()
+			case "path":
+				imp.Path = text(c.Node, src)
+			case "alias":
+				imp.Alias = c.Node.Content(src)
+			case "name":
+				imp.Names = append(imp.Names, c.Node.Content(src))
+			}
+		}
+
+		i, ok := index[imp.Range]
+		if !ok {
+			index[imp.Range] = len(imports)
+			imports = append(imports, imp)
+
+			continue
+		}
+
+		prev := &imports[i]
+		prev.Path, prev.Alias = cmp.Or(prev.Path, imp.Path), cmp.Or(prev.Alias, imp.Alias)
+
+		for _, name := range imp.Names {
+			if !slices.Contains(prev.Names, name) {
+				prev.Names = append(prev.Names, name)
+			}
+		}
+	}
+
+	slices.SortStableFunc(imports, func(a, b Import) int { return cmp.Compare(a.Range.StartByte, b.Range.StartByte) })
+
+	return
+}
+
+// text returns the text of the path node, decoded if a string literal, or
+// unquoted if a C system header (<...>).
+func text(n sitter.Node, src []byte) string {
+	content := n.Content(src)
+
+	switch {
+	case len(content) > 1 && content[0] == '<' && content[len(content)-1] == '>':
+		return content[1 : len(content)-1]
+	case content != "" && strings.ContainsRune("\"'`", rune(content[0])):
+		if v, err := decodeString(n, src); err == nil && len(v.Interpolations) == 0 {
+			return v.Text
+		}
+	}
+
+	return content
+}
@@ -0,0 +1,91 @@
+package imports
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestNewRules(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesExtract()")
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	a, b := &Rules{}, &Rules{}
+	Register("a", a)
+	Register("b", b)
+	Register("a", b)
+
+	if r, ok := Lookup("a"); !ok || r != b {
+		t.Fatal("Expected the rules to be replaced")
+	}
+
+	if _, ok := Lookup("c"); ok {
+		t.Fatal("Expected no rules for c")
+	}
+
+	if act := Languages(); !slices.Equal(act, []string{"a", "b"}) {
+		t.Fatal("Expected [a b], got", act)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestLanguages(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRulesExtract(t *testing.T) {
+	t.Parallel()
+
+	// In these calc "programs", parenthesized sums are imports of their left
+	// number, as their right number, which is also imported by name.
+	rules, err := NewRules(gr, `
+(expression "(" (expression (sum left: (expression (number) @path) right: (expression (number) @alias)?))) @import
+(expression "(" (expression (sum right: (expression (number) @name)))) @import
+`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	src := []byte("(1 + 2) + (3 + (4 + 5))")
+
+	root, err := sitter.Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	act := []Import{}
+	for _, imp := range rules.Extract(root, src) {
+		imp.Range = sitter.Range{StartByte: imp.Range.StartByte, EndByte: imp.Range.EndByte}
+		act = append(act, imp)
+	}
+
+	exp := []Import{
+		{Path: "1", Alias: "2", Names: []string{"2"}, Range: sitter.Range{StartByte: 0, EndByte: 7}},
+		{Path: "3", Range: sitter.Range{StartByte: 10, EndByte: 23}},
+		{Path: "4", Alias: "5", Names: []string{"5"}, Range: sitter.Range{StartByte: 15, EndByte: 22}},
+	}
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+}
+
+func TestText(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesExtract()")
+}
@@ -0,0 +1,183 @@
+// Package injection parses the languages embedded into documents (e.g. the
+// code blocks of Markdown, or the scripts and styles of HTML), as told by
+// injections queries, following the conventions of the injections.scm files:
+//
+//   - @injection.content: the node holding the embedded text;
+//   - @injection.language: the node whose text is the name of the embedded
+//     language (e.g. the info string of a Markdown code block);
+//   - #set! injection.language "name": the name of the embedded language, for
+//     the patterns where it is known upfront;
+//   - #set! injection.combined: the contents of all the matches of the pattern
+//     are parsed together, as one document (e.g. the PHP blocks of a page);
+//   - #set! injection.include-children: the text of the children of the
+//     content nodes is included, which it is not, by default.
+//
+// For instance, for Markdown:
+//
+//	(fenced_code_block (info_string (language) @injection.language) (code_fence_content) @injection.content)
+//	((html_block) @injection.content (#set! injection.language "html") (#set! injection.combined))
+//
+// The injected trees can have injections of their own, found by parsing them
+// with the rules of their language, in turn.
+package injection
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Rules are the injections rules of a language, see [NewRules].
+type Rules struct {
+	query *sitter.Query
+}
+
+// Languages returns the language with the given name (as found by the rules,
+// e.g. "js" or "javascript"), or nil if it is unknown, in which case its
+// injections are skipped.
+type Languages func(name string) *sitter.Language
+
+// Injection is a language injected into a document, parsed.
+type Injection struct {
+	Language *sitter.Language
+	Tree     *sitter.Tree
+	// Name is the name of the language, as found by the rules.
+	Name string
+	// Ranges are the ranges of the document the tree was parsed from.
+	Ranges []sitter.Range
+}
+
+// combinedKey identifies the injections combined: the ones of the same
+// pattern and language.
+type combinedKey struct {
+	name    string
+	pattern uint
+}
+
+const (
+	captureContent  = "injection.content"
+	captureLanguage = "injection.language"
+
+	propLanguage        = "injection.language"
+	propCombined        = "injection.combined"
+	propIncludeChildren = "injection.include-children"
+)
+
+// NewRules compiles the injections rules of a language.
+func NewRules(lang *sitter.Language, pattern string) (*Rules, error) {
+	q, err := sitter.NewQuery(lang, []byte(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rules{query: q}, nil
+}
+
+// Parse parses the languages injected into the document rooted at root, whose
+// source text is src, returning them in order (of their first range). The
+// injections of unknown languages (see [Languages]), or without any text, are
+// skipped. It fails if the parsing of any of the injections fails.
+func (r *Rules) Parse(ctx context.Context, root sitter.Node, src []byte, langs Languages) ([]Injection, error) {
+	injections := r.find(root, src, langs)
+
+	for i := range injections {
+		inj := &injections[i]
+
+		p, err := sitter.NewParserWith(sitter.WithLanguage(inj.Language), sitter.WithIncludedRanges(inj.Ranges))
+		if err != nil {
+			return nil, err
+		}
+
+		if inj.Tree, err = p.ParseString(ctx, nil, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return injections, nil
+}
+
+// find returns the injections of the known languages, not parsed yet, with
+// their ranges sorted.
+func (r *Rules) find(root sitter.Node, src []byte, langs Languages) (injections []Injection) {
+	names := r.query.CaptureNames()
+	combined := map[combinedKey]int{}
+
+	matches := sitter.NewQueryCursor().Matches(r.query, root, src)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		var inj Injection
+
+		if p, ok := m.Property(r.query, propLanguage); ok && p.Value != nil {
+			inj.Name = *p.Value
+		}
+
+		_, withChildren := m.Property(r.query, propIncludeChildren)
+
+		for _, c := range m.Captures {
+			switch names[c.Index] {
+			case captureLanguage:
+				inj.Name = strings.TrimSpace(c.Node.Content(src))
+			case captureContent:
+				inj.Ranges = append(inj.Ranges, ranges(c.Node, withChildren)...)
+			}
+		}
+
+		if inj.Name == "" || len(inj.Ranges) == 0 {
+			continue
+		}
+
+		if inj.Language = langs(inj.Name); inj.Language == nil {
+			continue
+		}
+
+		if _, ok := m.Property(r.query, propCombined); ok {
+			key := combinedKey{inj.Name, m.PatternIndex}
+			if i, ok := combined[key]; ok {
+				injections[i].Ranges = append(injections[i].Ranges, inj.Ranges...)
+				continue
+			}
+
+			combined[key] = len(injections)
+		}
+
+		injections = append(injections, inj)
+	}
+
+	for _, inj := range injections {
+		slices.SortFunc(inj.Ranges, func(a, b sitter.Range) int { return cmp.Compare(a.StartByte, b.StartByte) })
+	}
+
+	slices.SortStableFunc(injections, func(a, b Injection) int {
+		return cmp.Compare(a.Ranges[0].StartByte, b.Ranges[0].StartByte)
+	})
+
+	return
+}
+
+// ranges returns the ranges of the content node, with or without the ones of
+// its children, skipping the empty ones.
+func ranges(n sitter.Node, withChildren bool) (out []sitter.Range) {
+	rng := n.Range()
+	if withChildren {
+		return append(out, rng)
+	}
+
+	for c := range n.Children() {
+		if c.StartByte() > rng.StartByte {
+			out = append(out, sitter.Range{
+				StartPoint: rng.StartPoint, EndPoint: c.StartPoint(),
+				StartByte: rng.StartByte, EndByte: c.StartByte(),
+			})
+		}
+
+		rng.StartPoint, rng.StartByte = c.EndPoint(), c.EndByte()
+	}
+
+	if rng.EndByte > rng.StartByte {
+		out = append(out, rng)
+	}
+
+	return
+}
@@ -0,0 +1,102 @@
+package injection
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestNewRules(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesParse()")
+}
+
+func TestRulesParse(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("(1 + 2) + (3) + 4 // x")
+
+	root, err := sitter.Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// The injected language's name is "calc", or the text of a number, for
+	// which only "4" is known.
+	langs := func(name string) *sitter.Language {
+		if name == "calc" || name == "4" {
+			return gr
+		}
+
+		return nil
+	}
+
+	testCases := []struct {
+		pattern string
+		exp     []string
+	}{
+		{`((expression "(" (expression) @injection.content) (#set! injection.language "calc") (#set! injection.include-children))`, []string{ //nolint:lll // ok
+			"calc [1-6]: (expression (sum left: (expression (number)) right: (expression (number))))",
+			"calc [11-12]: (expression (number))",
+		}},
+		{`((expression "(" (expression) @injection.content) (#set! injection.language "calc") (#set! injection.include-children) (#set! injection.combined))`, []string{ //nolint:lll // ok
+			"calc [1-6 11-12]: (expression (sum left: (expression (number)) right: (expression (number))))",
+		}},
+		{`(sum (expression (number) @injection.language) (expression "(") @injection.content)`, nil},
+		{`(sum (expression (number) @injection.language) "+" @injection.content)`, nil},
+		{`((sum) @injection.content (#set! injection.language "calc"))`, []string{
+			"calc [2-3 4-5]: (ERROR)", "calc [7-8 9-10]: (ERROR)", "calc [13-14 15-16]: (ERROR)",
+		}},
+		{`(sum right: (expression (number) @injection.language) @injection.content (#set! injection.include-children))`, []string{ //nolint:lll // ok
+			"4 [16-17]: (expression (number))",
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := NewRules(gr, tc.pattern)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			injections, err := rules.Parse(context.Background(), root, src, langs)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			var act []string
+
+			for _, inj := range injections {
+				var rngs []string
+				for _, r := range inj.Ranges {
+					rngs = append(rngs, fmt.Sprintf("%d-%d", r.StartByte, r.EndByte))
+				}
+
+				act = append(act, fmt.Sprintf("%s %v: %s", inj.Name, rngs, inj.Tree.RootNode()))
+			}
+
+			if !reflect.DeepEqual(act, tc.exp) {
+				t.Fatalf("Expected %q, got %q", tc.exp, act)
+			}
+		})
+	}
+}
+
+func TestRulesFind(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesParse()")
+}
+
+func TestRanges(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesParse()")
+}
@@ -0,0 +1,108 @@
+package sitter
+
+// IntervalTree is an interval tree over the named nodes of a [RangeIndex],
+// answering the stabbing ("which nodes contain this offset") and overlap
+// ("which nodes does this changed range affect") queries in logarithmic time
+// (plus the number of nodes found), e.g. for invalidating the diagnostics or
+// highlights of an edited range. The nodes are returned as their indexes in
+// the range index, resolved to nodes only when needed (see [RangeIndex.Node]).
+//
+// As the range index, the tree is a snapshot: it is not updated on edits.
+type IntervalTree struct {
+	ri *RangeIndex
+	// The indexes of the named nodes, in preorder (thus by start byte), forming
+	// an implicit balanced binary search tree: the middle one of each slice is
+	// the root of its subtree.
+	nodes []int
+	// The maximum end byte of the nodes in the subtree rooted at each position.
+	maxEnds []uint32
+}
+
+// BuildIntervalTree builds the [IntervalTree] of the named nodes of the index.
+func (ri *RangeIndex) BuildIntervalTree() *IntervalTree {
+	lang := ri.root.Language()
+	it := &IntervalTree{ri: ri}
+
+	for i, sym := range ri.symbols {
+		if lang.SymbolType(sym) == SymbolTypeRegular {
+			it.nodes = append(it.nodes, i)
+		}
+	}
+
+	it.maxEnds = make([]uint32, len(it.nodes))
+	it.build(0, len(it.nodes))
+
+	return it
+}
+
+// Len returns the number of nodes in the tree.
+func (it *IntervalTree) Len() int {
+	return len(it.nodes)
+}
+
+// Node returns the node with the given (range index) index.
+func (it *IntervalTree) Node(i int) Node {
+	return it.ri.Node(i)
+}
+
+// Stab returns the indexes of the named nodes containing the byte at the given
+// offset, in preorder (i.e. outermost first).
+func (it *IntervalTree) Stab(offset uint) []int {
+	return it.Overlapping(offset, offset+1)
+}
+
+// Overlapping returns the indexes of the named nodes overlapping the given
+// byte range, in preorder.
+func (it *IntervalTree) Overlapping(start, end uint) (out []int) {
+	it.overlapping(0, len(it.nodes), start, end, &out)
+	return
+}
+
+// build computes the maximum end bytes of the subtree of nodes[lo:hi],
+// returning the one of its root.
+func (it *IntervalTree) build(lo, hi int) (max uint32) { //nolint:predeclared // ok
+	if lo >= hi {
+		return
+	}
+
+	mid := int(uint(lo+hi) >> 1)
+	max = it.ri.ends[it.nodes[mid]]
+
+	if l := it.build(lo, mid); l > max {
+		max = l
+	}
+
+	if r := it.build(mid+1, hi); r > max {
+		max = r
+	}
+
+	it.maxEnds[mid] = max
+
+	return
+}
+
+// overlapping appends the nodes of the subtree of nodes[lo:hi] overlapping the
+// byte range to out, in order.
+func (it *IntervalTree) overlapping(lo, hi int, start, end uint, out *[]int) {
+	if lo >= hi {
+		return
+	}
+
+	mid := int(uint(lo+hi) >> 1)
+	if uint(it.maxEnds[mid]) <= start {
+		return // All the nodes end before the range.
+	}
+
+	it.overlapping(lo, mid, start, end, out)
+
+	i := it.nodes[mid]
+	if uint(it.ri.starts[i]) >= end {
+		return // This node, and the ones after it, start after the range.
+	}
+
+	if uint(it.ri.ends[i]) > start {
+		*out = append(*out, i)
+	}
+
+	it.overlapping(mid+1, hi, start, end, out)
+}
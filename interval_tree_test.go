@@ -0,0 +1,84 @@
+package sitter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRangeIndexBuildIntervalTree(t *testing.T) {
+	t.Parallel()
+
+	ri := testRangeIndex(t)
+	it := ri.BuildIntervalTree()
+
+	var exp []int
+
+	for i := range ri.Len() {
+		if ri.Node(i).IsNamed() {
+			exp = append(exp, i)
+		}
+	}
+
+	if !reflect.DeepEqual(it.nodes, exp) {
+		t.Fatalf("Expected the named nodes %v, got %v", exp, it.nodes)
+	}
+
+	if act := it.Len(); act != len(exp) {
+		t.Fatalf("Expected %d nodes, got %d", len(exp), act)
+	}
+}
+
+func TestIntervalTreeLen(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestIntervalTreeNode(t *testing.T) {
+	t.Parallel()
+
+	it := testRangeIndex(t).BuildIntervalTree()
+
+	if act := it.Node(it.nodes[0]); !act.Equal(it.ri.root) {
+		t.Fatalf("Expected %s, got %s", it.ri.root, act)
+	}
+}
+
+func TestIntervalTreeStab(t *testing.T) {
+	t.Parallel()
+
+	it := testRangeIndex(t).BuildIntervalTree()
+
+	for offset := range uint(len(testRangeIndexSrc)) + 1 {
+		var exp []int
+
+		for _, i := range it.nodes {
+			if it.ri.StartByte(i) <= offset && offset < it.ri.EndByte(i) {
+				exp = append(exp, i)
+			}
+		}
+
+		if act := it.Stab(offset); !reflect.DeepEqual(act, exp) {
+			t.Fatalf("Expected %v at %d, got %v", exp, offset, act)
+		}
+	}
+}
+
+func TestIntervalTreeOverlapping(t *testing.T) {
+	t.Parallel()
+
+	it := testRangeIndex(t).BuildIntervalTree()
+
+	for _, rng := range [][2]uint{{0, 1}, {2, 5}, {5, 6}, {6, 12}, {8, 99}, {3, 3}, {13, 17}} {
+		var exp []int
+
+		for _, i := range it.nodes {
+			if it.ri.StartByte(i) < rng[1] && it.ri.EndByte(i) > rng[0] {
+				exp = append(exp, i)
+			}
+		}
+
+		if act := it.Overlapping(rng[0], rng[1]); !reflect.DeepEqual(act, exp) {
+			t.Fatalf("Expected %v for %v, got %v", exp, rng, act)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package sitter
 
-import "io"
+import (
+	"io"
+	"slices"
+)
 
 // IterMode indicates the iteration mode.
 type IterMode int
@@ -8,8 +11,10 @@ type IterMode int
 // Iterator for a tree of nodes.
 type Iterator struct {
 	toVisit []Node
+	depths  []uint // The depth of each node to visit.
 	mode    IterMode
 	named   bool
+	limits  walkLimits
 }
 
 // The possible iteration modes.
@@ -28,7 +33,16 @@ func NewIterator(n Node, opts ...IterMode) *Iterator {
 		mode = opts[0]
 	}
 
-	return &Iterator{toVisit: []Node{n}, mode: mode, named: mode > BFS}
+	return &Iterator{toVisit: []Node{n}, depths: []uint{0}, mode: mode, named: mode > BFS}
+}
+
+// NewIteratorWith is like [NewIterator], with the given mode and walk limits
+// (see [WithMaxDepth]), [Iterator.Next] failing once past them.
+func NewIteratorWith(n Node, mode IterMode, opts ...WalkOption) *Iterator {
+	iter := NewIterator(n, mode)
+	iter.limits = newWalkLimits(opts)
+
+	return iter
 }
 
 // Next returns the next node in the current iteration.
@@ -37,9 +51,18 @@ func (iter *Iterator) Next() (n Node, err error) {
 		return n, io.EOF
 	}
 
-	var children []Node
+	var (
+		children []Node
+		depth    uint
+	)
 
 	n, iter.toVisit = iter.toVisit[0], iter.toVisit[1:]
+	depth, iter.depths = iter.depths[0], iter.depths[1:]
+
+	if err = iter.limits.check(depth); err != nil {
+		iter.toVisit, iter.depths = nil, nil
+		return Node{}, err
+	}
 
 	if iter.named {
 		for i := range n.NamedChildCount() {
@@ -51,11 +74,15 @@ func (iter *Iterator) Next() (n Node, err error) {
 		}
 	}
 
+	depths := slices.Repeat([]uint{depth + 1}, len(children))
+
 	switch iter.mode {
 	case DFS, DFSNamed:
 		iter.toVisit = append(children, iter.toVisit...)
+		iter.depths = append(depths, iter.depths...)
 	case BFS, BFSNamed:
 		iter.toVisit = append(iter.toVisit, children...)
+		iter.depths = append(iter.depths, depths...)
 	default:
 		panic("not implemented")
 	}
@@ -63,6 +90,16 @@ func (iter *Iterator) Next() (n Node, err error) {
 	return
 }
 
+// NextOfType returns the next node (in the current iteration) having one of
+// the given types. Names can be converted to symbols via [Language.MustSymbol].
+func (iter *Iterator) NextOfType(syms ...Symbol) (n Node, err error) {
+	for {
+		if n, err = iter.Next(); err != nil || slices.Contains(syms, n.Symbol()) {
+			return
+		}
+	}
+}
+
 // ForEach iterates over all nodes, until an error is enconuntered
 // (or there are no more nodes).
 func (iter *Iterator) ForEach(fn func(Node) error) (err error) {
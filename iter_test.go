@@ -34,6 +34,51 @@ func TestNewIterator(t *testing.T) {
 	t.Skip("tested implicitly")
 }
 
+func TestNewIteratorWith(t *testing.T) {
+	t.Parallel()
+
+	input := []byte(src1)
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		mode     IterMode
+		maxDepth uint
+		exp      []string
+		expErr   error
+	}{
+		{DFS, 0, []string{"expression", "sum", "expression", "number", "+", "expression", "number"}, io.EOF},
+		{DFS, 3, []string{"expression", "sum", "expression", "number", "+", "expression", "number"}, io.EOF},
+		{DFS, 2, []string{"expression", "sum", "expression"}, ErrMaxDepthExceeded},
+		{BFS, 2, []string{"expression", "sum", "expression", "+", "expression"}, ErrMaxDepthExceeded},
+		{BFSNamed, 1, []string{"expression", "sum"}, ErrMaxDepthExceeded},
+	}
+
+	for _, tc := range testCases {
+		act, iter := []string{}, NewIteratorWith(root, tc.mode, WithMaxDepth(tc.maxDepth))
+
+		err = iter.ForEach(func(n Node) error {
+			act = append(act, n.Type())
+			return nil
+		})
+
+		if !errors.Is(err, tc.expErr) {
+			t.Fatalf("Expected %v for %s/%d, got %v", tc.expErr, tc.mode, tc.maxDepth, err)
+		}
+
+		if !slices.Equal(act, tc.exp) {
+			t.Fatalf("Expected %q for %s/%d, got %q", tc.exp, tc.mode, tc.maxDepth, act)
+		}
+
+		if _, err = iter.Next(); !errors.Is(err, io.EOF) {
+			t.Fatalf("Expected %v once stopped, got %v", io.EOF, err)
+		}
+	}
+}
+
 func TestIteratorNext(t *testing.T) {
 	t.Parallel()
 
@@ -104,3 +149,25 @@ func TestIteratorForEach(t *testing.T) {
 		})
 	}
 }
+
+func TestIteratorNextOfType(t *testing.T) {
+	t.Parallel()
+
+	input := []byte(src2)
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	act, iter := []string{}, NewIterator(root)
+	syms := []Symbol{gr.MustSymbol("number"), gr.MustSymbol("(")}
+
+	for n, err := iter.NextOfType(syms...); err == nil; n, err = iter.NextOfType(syms...) {
+		act = append(act, n.Content(input))
+	}
+
+	if exp := []string{"(", "(", "1", "2", "(", "3", "4"}; !slices.Equal(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
@@ -5,6 +5,8 @@ import "C"
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"sync"
 	"unsafe"
 )
@@ -15,6 +17,29 @@ type Language struct {
 	once sync.Once
 }
 
+// languageTables holds the field and symbol tables of a language, fetched
+// once and shared by all its [Language] values (i.e. the ones returned by
+// [Node.Language]), as keyed by their pointer in [tablesByLanguage].
+type languageTables struct {
+	fieldIDs    map[string]FieldID
+	fieldNames  []string
+	symbols     []SymbolInfo
+	fieldsOnce  sync.Once
+	symbolsOnce sync.Once
+}
+
+var tablesByLanguage sync.Map // map[unsafe.Pointer]*languageTables
+
+// languageVersionWithSupertypes is the first language ABI listing the
+// supertypes and their subtypes.
+const languageVersionWithSupertypes = 15
+
+// SymbolInfo holds the metadata of a language's symbol.
+type SymbolInfo struct {
+	Name string
+	Type SymbolType
+}
+
 // LanguageError represents an error  that occurred when trying to assign
 // an incompatible [TSLanguage] to a [TSParser].
 type LanguageError int
@@ -34,6 +59,8 @@ func (l *Language) Copy() *Language {
 // this is the last reference.
 func (l *Language) Delete() {
 	l.once.Do(func() {
+		// The language may be freed, and another one allocated in its place.
+		tablesByLanguage.Delete(l.ptr)
 		C.ts_language_delete(l.c())
 		l.ptr = nil
 		l = nil
@@ -52,6 +79,10 @@ func (l *Language) StateCount() uint32 {
 
 // SymbolName returns a node type string for the given Symbol.
 func (l *Language) SymbolName(s Symbol) string {
+	if symbols := l.Symbols(); int(s) < len(symbols) {
+		return symbols[s].Name
+	}
+
 	return C.GoString(C.ts_language_symbol_name(l.c(), s))
 }
 
@@ -70,26 +101,34 @@ func (l *Language) FieldCount() uint32 {
 
 // FieldName returns the field name string for the given numerical id.
 func (l *Language) FieldName(idx int) string {
-	return C.GoString(C.ts_language_field_name_for_id(l.c(), C.ushort(idx)))
+	if names := l.FieldNames(); idx >= 0 && idx < len(names) {
+		return names[idx]
+	}
+
+	return ""
 }
 
 // FieldID returns the numerical id for the given field name string.
 func (l *Language) FieldID(name string) FieldID {
-	cName := C.CString(name)
-	defer C.free(unsafe.Pointer(cName))
-
-	return C.ts_language_field_id_for_name(l.c(), cName, C.uint(len(name)))
+	return l.loadFields().fieldIDs[name]
 }
 
 // SymbolType returns named, anonymous, or a hidden type for a Symbol.
+//
+// Unknown symbols are reported as auxiliary (tree-sitter does not check the
+// bounds of the symbol, except for the builtin error ones).
 func (l *Language) SymbolType(s Symbol) SymbolType {
+	if uint32(s) >= l.SymbolCount() && uint16(s) < maxUint16-1 {
+		return SymbolTypeAuxiliary
+	}
+
 	return SymbolType(C.ts_language_symbol_type(l.c(), s)) //nolint:unconvert // ok
 }
 
 // Version returns the ABI version number for this language. This version number is used
 // to ensure that languages were generated by a compatible version of Tree-sitter.
 func (l *Language) Version() int {
-	return int(C.ts_language_version(l.c()))
+	return int(C.ts_language_abi_version(l.c()))
 }
 
 // NextState returns the next parse state. Combine this with lookahead iterators to generate
@@ -99,6 +138,156 @@ func (l *Language) NextState(curr StateID, sym Symbol) StateID {
 	return C.ts_language_next_state(l.c(), curr, sym)
 }
 
+// Non API.
+
+// FieldNames returns all the field names of the language, indexed by their
+// field id (the name at index zero, which is not a valid field id, is empty).
+// The names are fetched once per language and cached, so the returned slice
+// must not be modified.
+func (l *Language) FieldNames() []string {
+	return l.loadFields().fieldNames
+}
+
+// Symbols returns the metadata of all the language's symbols (including
+// aliases), indexed by [Symbol], so that i.e. node types can be looked up
+// without a cgo call and a string conversion per node:
+//
+//	name := lang.Symbols()[n.Symbol()].Name
+//
+// The symbols are fetched once per language and cached, so the returned slice
+// must not be modified.
+func (l *Language) Symbols() []SymbolInfo {
+	t := l.tables()
+	t.symbolsOnce.Do(func() {
+		count := l.SymbolCount()
+		t.symbols = make([]SymbolInfo, count)
+
+		for i := range count {
+			s := Symbol(i)
+			t.symbols[i] = SymbolInfo{
+				Name: C.GoString(C.ts_language_symbol_name(l.c(), s)),
+				Type: l.SymbolType(s),
+			}
+		}
+	})
+
+	return t.symbols
+}
+
+// Supertypes returns the supertype symbols of the language, i.e. those of the
+// hidden rules grouping other ones (e.g. _expression or _statement), as listed
+// by the grammar's supertypes.
+func (l *Language) Supertypes() (syms []Symbol) {
+	if l.Version() >= languageVersionWithSupertypes {
+		var length C.uint32_t
+
+		ptr := C.ts_language_supertypes(l.c(), &length)
+
+		return slices.Clone(unsafe.Slice((*Symbol)(ptr), length))
+	}
+
+	for i, s := range l.Symbols() {
+		if s.Type == SymbolTypeSupertype {
+			syms = append(syms, Symbol(i))
+		}
+	}
+
+	return
+}
+
+// Subtypes returns the symbols (sorted) of the nodes a node of the given
+// supertype symbol can stand for, as listed by the language.
+//
+// The languages older than ABI 15 do not list them, so for those they are
+// inferred from the parse table instead: the productions of the symbol having
+// a single child, the hidden rules (other than the supertypes) being expanded
+// into their own subtypes. That is an approximation, which may tell other
+// symbols than "tree-sitter generate" would, for the unusual grammars.
+func (l *Language) Subtypes(supertype Symbol) []Symbol {
+	symbols := l.Symbols()
+	if int(supertype) >= len(symbols) {
+		return nil
+	}
+
+	if l.Version() >= languageVersionWithSupertypes {
+		var length C.uint32_t
+
+		ptr := C.ts_language_subtypes(l.c(), supertype, &length)
+
+		return slices.Sorted(slices.Values(unsafe.Slice((*Symbol)(ptr), length)))
+	}
+
+	seen := make([]bool, len(symbols))
+	subtypes := map[Symbol]bool{}
+
+	var expand func(s Symbol)
+
+	expand = func(s Symbol) {
+		marks := make([]C.bool, len(symbols))
+		C.go_language_subtypes(l.c(), s, &marks[0])
+
+		for i, marked := range marks {
+			if !bool(marked) || seen[i] {
+				continue
+			}
+
+			seen[i] = true
+
+			if sub := Symbol(i); symbols[i].Type == SymbolTypeAuxiliary {
+				expand(sub)
+			} else {
+				subtypes[sub] = true
+			}
+		}
+	}
+
+	seen[supertype] = true
+	expand(supertype)
+
+	return slices.Sorted(maps.Keys(subtypes))
+}
+
+// MustSymbol returns the symbol for the given node type name, trying the
+// named symbols first, then the anonymous ones. It panics if the name is not
+// known to the language, so it is meant for initializing the symbols used by
+// filters (i.e. with [Node.IsType]) once, rather than for lookups in hot paths.
+func (l *Language) MustSymbol(name string) Symbol {
+	for _, isNamed := range []bool{true, false} {
+		if s := l.SymbolID(name, isNamed); s != 0 {
+			return s
+		}
+	}
+
+	panic(fmt.Sprintf("unknown symbol %q", name))
+}
+
+func (l *Language) loadFields() *languageTables {
+	t := l.tables()
+	t.fieldsOnce.Do(func() {
+		count := int(C.ts_language_field_count(l.c()))
+		t.fieldNames = make([]string, count+1)
+		t.fieldIDs = make(map[string]FieldID, count)
+
+		for i := 1; i <= count; i++ {
+			t.fieldNames[i] = C.GoString(C.ts_language_field_name_for_id(l.c(), C.ushort(i)))
+			t.fieldIDs[t.fieldNames[i]] = FieldID(i)
+		}
+	})
+
+	return t
+}
+
+// tables returns the language's tables, as shared by all its values.
+func (l *Language) tables() *languageTables {
+	if t, ok := tablesByLanguage.Load(l.ptr); ok {
+		return t.(*languageTables) //nolint:forcetypeassert // we only ever store languageTables
+	}
+
+	t, _ := tablesByLanguage.LoadOrStore(l.ptr, &languageTables{})
+
+	return t.(*languageTables) //nolint:forcetypeassert // we only ever store languageTables
+}
+
 func (l *Language) c() *C.TSLanguage {
 	return (*C.TSLanguage)(l.ptr)
 }
@@ -107,3 +296,8 @@ func (e LanguageError) Error() string {
 	return fmt.Sprintf("Incompatible language version %d. Expected minimum %d, maximum %d",
 		e, C.TREE_SITTER_MIN_COMPATIBLE_LANGUAGE_VERSION, C.TREE_SITTER_LANGUAGE_VERSION)
 }
+
+// Unwrap makes all language errors match [ErrIncompatibleLanguage].
+func (e LanguageError) Unwrap() error {
+	return ErrIncompatibleLanguage
+}
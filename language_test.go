@@ -1,6 +1,12 @@
 package sitter
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
 
 func TestLanguageCopy(t *testing.T) {
 	t.Parallel()
@@ -95,7 +101,26 @@ func TestLanguageStateCount(t *testing.T) {
 
 func TestLanguageSymbolName(t *testing.T) {
 	t.Parallel()
-	t.Skip("tested implicitly via TestLanguage()")
+
+	// The symbols are shared by all the values of the language.
+	if act, exp := &NewLanguage(gr.ptr).Symbols()[0], &gr.Symbols()[0]; act != exp {
+		t.Fatal("Expected the symbols to be shared")
+	}
+}
+
+func BenchmarkNodeLanguageSymbolName(b *testing.B) {
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		b.Fatal("Expected no error, got", err)
+	}
+
+	n, exp := root.Child(0), root.Child(0).Type()
+
+	for range b.N {
+		if act := n.Language().SymbolName(n.Symbol()); act != exp {
+			b.Fatalf("Expected %q, got %q", exp, act)
+		}
+	}
 }
 
 func TestLanguageSymbolID(t *testing.T) {
@@ -166,7 +191,7 @@ func TestLanguageSymbolType(t *testing.T) {
 func TestLanguageVersion(t *testing.T) {
 	t.Parallel()
 
-	exp := TREE_SITTER_LANGUAGE_VERSION
+	exp := 14 // The test grammar is generated with an older tree-sitter.
 	if act := gr.Version(); act != exp {
 		t.Fatalf("Expected %d, got %d", exp, act)
 	}
@@ -176,3 +201,146 @@ func TestLanguageNextState(t *testing.T) {
 	t.Parallel()
 	t.Skip("won't test: not really the Go code's job to assert the parser parses correctly")
 }
+
+func TestLanguageFieldNames(t *testing.T) {
+	t.Parallel()
+
+	exp := []string{"", "left", "right"}
+	if act := gr.FieldNames(); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestLanguageSymbols(t *testing.T) {
+	t.Parallel()
+
+	symbols := gr.Symbols()
+	if act, exp := len(symbols), int(gr.SymbolCount()); act != exp {
+		t.Fatalf("Expected %d symbols, got %d", exp, act)
+	}
+
+	root, err := Parse(context.Background(), []byte("1 + (2 x"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	err = NewIterator(root).ForEach(func(n Node) error {
+		if n.IsError() {
+			if act := gr.SymbolName(n.Symbol()); act != "ERROR" {
+				t.Fatalf("Expected %q, got %q", "ERROR", act)
+			}
+
+			return nil
+		}
+
+		exp := SymbolInfo{Name: n.Type(), Type: gr.SymbolType(n.Symbol())}
+		if act := symbols[n.Symbol()]; act != exp {
+			t.Fatalf("Expected %+v, got %+v", exp, act)
+		}
+
+		return nil
+	})
+	if !errors.Is(err, io.EOF) {
+		t.Fatal("Expected io.EOF, got", err)
+	}
+}
+
+func TestLanguageSupertypes(t *testing.T) {
+	t.Parallel()
+
+	// The test grammar has no supertypes.
+	if act := gr.Supertypes(); act != nil {
+		t.Fatal("Expected no supertypes, got", act)
+	}
+
+	// The older languages have their supertypes told by the symbols' metadata.
+	for _, lang := range []*Language{grSuper, grSuper14} {
+		exp := []Symbol{lang.MustSymbol("_expression")}
+		if act := lang.Supertypes(); !reflect.DeepEqual(act, exp) {
+			t.Fatalf("Expected %v (ABI %d), got %v", exp, lang.Version(), act)
+		}
+	}
+}
+
+func TestLanguageSubtypes(t *testing.T) {
+	t.Parallel()
+
+	names := func(lang *Language, syms []Symbol) (names []string) {
+		for _, s := range syms {
+			names = append(names, lang.SymbolName(s))
+		}
+
+		return
+	}
+
+	// The ones listed by the language.
+	exp := []string{"number", "variable", "sum", "parenthesized"}
+	if act := names(grSuper, grSuper.Subtypes(grSuper.MustSymbol("_expression"))); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+
+	// The approximate ones, inferred from the parse table, agreeing with the
+	// listed ones for this grammar.
+	if act := names(grSuper14, grSuper14.Subtypes(grSuper14.MustSymbol("_expression"))); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+
+	// The expression rule is not a supertype, but it groups the other ones as
+	// if it were (except for the parenthesized expressions).
+	act, exp := names(gr, gr.Subtypes(gr.MustSymbol("expression"))), []string{"number", "variable", "sum"}
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+
+	for _, lang := range []*Language{gr, grSuper} {
+		for _, s := range []Symbol{lang.MustSymbol("sum"), lang.MustSymbol("number"), Symbol(lang.SymbolCount())} {
+			if act := lang.Subtypes(s); act != nil {
+				t.Fatalf("Expected no subtypes of %d, got %v", s, act)
+			}
+		}
+	}
+}
+
+func TestLanguageMustSymbol(t *testing.T) {
+	t.Parallel()
+
+	if act, exp := gr.MustSymbol("+"), gr.SymbolID("+", false); act != exp || act == 0 {
+		t.Fatalf("Expected %d, got %d", exp, act)
+	}
+
+	defer func() {
+		if r := recover(); r != `unknown symbol "nope"` {
+			t.Fatal("Expected panic, got", r)
+		}
+	}()
+
+	gr.MustSymbol("nope")
+}
+
+func TestLanguageLoadFields(t *testing.T) {
+	t.Parallel()
+
+	// The fields are shared by all the values of the language.
+	if act, exp := &NewLanguage(gr.ptr).FieldNames()[0], &gr.FieldNames()[0]; act != exp {
+		t.Fatal("Expected the fields to be shared")
+	}
+}
+
+func TestLanguageErrorUnwrap(t *testing.T) {
+	t.Parallel()
+
+	gr2 := gr.Copy()
+	gr2.Delete()
+
+	if _, err := NewQuery(gr2, []byte("(number) @n")); !errors.Is(err, ErrClosed) {
+		t.Fatalf("Expected %v, got %v", ErrClosed, err)
+	}
+
+	if _, err := NewParserWith(WithLanguage(gr2)); !errors.Is(err, ErrClosed) {
+		t.Fatalf("Expected %v, got %v", ErrClosed, err)
+	}
+
+	if err := error(LanguageError(99)); !errors.Is(err, ErrIncompatibleLanguage) {
+		t.Fatalf("Expected %v, got %v", ErrIncompatibleLanguage, err)
+	}
+}
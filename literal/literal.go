@@ -0,0 +1,233 @@
+// Package literal decodes string literal nodes into their runtime values,
+// handling the escape sequences, raw strings and interpolations, by way of
+// per-language [Syntax] descriptions:
+//
+//	decode := literal.NewDecoder(literal.Python)
+//	v, err := decode(node, src)
+//	// v.Text is the decoded text, v.Interpolations tell where the
+//	// interpolated values go (e.g. for f-strings).
+//
+// The escape sequences are the C style ones (\n, \t, \xHH, \uHHHH, \NNN,
+// etc.), along with \u{H...} and line continuations. The octal ones may be
+// shorter (e.g. \0 or \12), as in Python and JavaScript.
+//
+// Numeric literals are decoded likewise, see [NumberSyntax].
+package literal
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Syntax describes the string literals of a language, in terms of the node
+// types of its grammar. The delimiters of the literals are their first and
+// last children, if anonymous (or of one of the DelimiterTypes), or else the
+// quotes of their text. A prefix holding r or R (e.g. r"..." or rb"...")
+// makes a literal raw.
+type Syntax struct {
+	// DelimiterTypes are the types of the named delimiter nodes (e.g. the
+	// string_start and string_end nodes of Python).
+	DelimiterTypes []string
+	// InterpolationTypes are the types of the interpolation nodes (e.g.
+	// template_substitution for JavaScript).
+	InterpolationTypes []string
+	// RawTypes are the types of the raw string literals, whose escape
+	// sequences are not decoded (e.g. raw_string_literal for Go).
+	RawTypes []string
+	// KeepUnknownEscapes keeps the unknown escape sequences (e.g. "\d") as is,
+	// Python style, instead of dropping their backslash.
+	KeepUnknownEscapes bool
+}
+
+// Decoder decodes a string literal node, whose source text is src.
+type Decoder func(n sitter.Node, src []byte) (Value, error)
+
+// Value is the value of a string literal.
+type Value struct {
+	Text string
+	// Interpolations are the interpolated expressions, in order.
+	Interpolations []Interpolation
+	Raw            bool
+}
+
+// Interpolation is an interpolated expression of a string literal.
+type Interpolation struct {
+	// Range is the range of the interpolation node.
+	Range sitter.Range
+	// Offset is the offset in the decoded text, where the value goes.
+	Offset uint
+}
+
+// ErrInvalidEscape is returned for malformed escape sequences.
+var ErrInvalidEscape = errors.New("invalid escape sequence")
+
+// The syntaxes of some languages.
+//
+//nolint:gochecknoglobals // ok
+var (
+	Go     = Syntax{RawTypes: []string{"raw_string_literal"}}
+	Python = Syntax{
+		DelimiterTypes:     []string{"string_start", "string_end"},
+		InterpolationTypes: []string{"interpolation"},
+		KeepUnknownEscapes: true,
+	}
+	JavaScript = Syntax{InterpolationTypes: []string{"template_substitution"}}
+)
+
+// NewDecoder returns a decoder of the string literals of the given syntax.
+func NewDecoder(s Syntax) Decoder {
+	return func(n sitter.Node, src []byte) (v Value, err error) {
+		start, end := n.StartByte(), n.EndByte()
+		v.Raw = slices.Contains(s.RawTypes, n.Type())
+		count := n.ChildCount()
+
+		if count == 0 {
+			prefix, body := unquote(string(src[start:end]))
+			v.Raw = v.Raw || strings.ContainsAny(prefix, "rR")
+			start += uint(len(prefix))
+			end = start + uint(len(body))
+		}
+
+		var holes []sitter.Range
+
+		for i := range count {
+			c := n.Child(i)
+
+			switch delim := !c.IsNamed() || slices.Contains(s.DelimiterTypes, c.Type()); {
+			case delim && i == 0:
+				start = c.EndByte()
+				v.Raw = v.Raw || strings.ContainsAny(c.Content(src), "rR")
+			case delim && i == count-1:
+				end = c.StartByte()
+			case slices.Contains(s.InterpolationTypes, c.Type()):
+				holes = append(holes, c.Range())
+			}
+		}
+
+		var b strings.Builder
+
+		for _, h := range holes {
+			if err = s.unescape(&b, src[start:h.StartByte], v.Raw); err != nil {
+				return
+			}
+
+			v.Interpolations = append(v.Interpolations, Interpolation{Range: h, Offset: uint(b.Len())})
+			start = h.EndByte
+		}
+
+		if err = s.unescape(&b, src[start:max(start, end)], v.Raw); err != nil {
+			return
+		}
+
+		v.Text = b.String()
+
+		return
+	}
+}
+
+// unescape writes the text, with its escape sequences decoded unless raw.
+func (s Syntax) unescape(b *strings.Builder, text []byte, raw bool) error {
+	if raw {
+		b.Write(text)
+		return nil
+	}
+
+	for str := string(text); str != ""; {
+		i := strings.IndexByte(str, '\\')
+		if i < 0 || i == len(str)-1 {
+			b.WriteString(str)
+			return nil
+		}
+
+		b.WriteString(str[:i])
+		str = str[i:]
+
+		switch c := str[1]; {
+		case c == '\n':
+			str = str[2:]
+		case strings.HasPrefix(str, "\\\r\n"):
+			str = str[3:]
+		case c == '"' || c == '\'':
+			b.WriteByte(c)
+			str = str[2:]
+		case strings.HasPrefix(str, `\u{`):
+			j := strings.IndexByte(str, '}')
+			if j < 0 {
+				return fmt.Errorf("%w: %q", ErrInvalidEscape, str)
+			}
+
+			r, err := strconv.ParseUint(str[3:j], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(r)) {
+				return fmt.Errorf("%w: %q", ErrInvalidEscape, str[:j+1])
+			}
+
+			b.WriteRune(rune(r))
+			str = str[j+1:]
+		case c >= '0' && c <= '7':
+			j := 2
+			for j < min(len(str), 4) && str[j] >= '0' && str[j] <= '7' {
+				j++
+			}
+
+			r, _ := strconv.ParseUint(str[1:j], 8, 16)
+			if r > 0xff {
+				return fmt.Errorf("%w: %q", ErrInvalidEscape, str[:j])
+			}
+
+			b.WriteByte(byte(r))
+			str = str[j:]
+		default:
+			r, multibyte, tail, err := strconv.UnquoteChar(str, 0)
+
+			switch {
+			case err == nil && multibyte:
+				b.WriteRune(r)
+			case err == nil:
+				b.WriteByte(byte(r))
+			case strings.IndexByte("xuU", c) >= 0:
+				return fmt.Errorf("%w: %q", ErrInvalidEscape, str[:min(len(str), 4)])
+			default:
+				_, size := utf8.DecodeRuneInString(str[1:])
+				if s.KeepUnknownEscapes {
+					b.WriteString(str[:1+size])
+				} else {
+					b.WriteString(str[1 : 1+size])
+				}
+
+				tail = str[1+size:]
+			}
+
+			str = tail
+		}
+	}
+
+	return nil
+}
+
+// unquote splits the text of a quoted literal into its prefix (e.g. r or b)
+// and its body, without the quotes (up to three of them, as in """...""").
+func unquote(text string) (prefix, body string) {
+	i := strings.IndexAny(text, "\"'`")
+	if i < 0 {
+		return "", text
+	}
+
+	prefix, text = text[:i], text[i:]
+
+	q := 1
+	for q < 3 && q < len(text) && text[q] == text[0] {
+		q++
+	}
+
+	if 2*q > len(text) {
+		q = len(text) / 2
+	}
+
+	return prefix, text[q : len(text)-q]
+}
@@ -0,0 +1,125 @@
+package literal
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestNewDecoder(t *testing.T) {
+	t.Parallel()
+
+	// Calc has no strings: parenthesized expressions stand for them (the
+	// parentheses being their delimiters), and their inner expressions for
+	// interpolations.
+	src := []byte("(1 + 2)")
+
+	root, err := sitter.Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		syntax Syntax
+		exp    Value
+	}{
+		{Syntax{}, Value{Text: "1 + 2"}},
+		{Syntax{RawTypes: []string{"expression"}}, Value{Text: "1 + 2", Raw: true}},
+		{
+			Syntax{InterpolationTypes: []string{"expression"}},
+			Value{Interpolations: []Interpolation{{Range: root.Child(1).Range()}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		act, err := NewDecoder(tc.syntax)(root, src)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %+v, got %+v", tc.exp, act)
+		}
+	}
+
+	// Leaves are unquoted textually.
+	number := root.Child(1).Child(0).Child(0).Child(0)
+	if act, err := NewDecoder(Go)(number, src); err != nil || act.Text != "1" {
+		t.Fatalf("Expected 1, got %+v, %v", act, err)
+	}
+}
+
+func TestSyntaxUnescape(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		text, exp, keep string
+		raw             bool
+		err             error
+	}{
+		{text: `plain`, exp: `plain`},
+		{text: `a\tb\nc\\d`, exp: "a\tb\nc\\d"},
+		{text: `\"q\' \x41\101é\U0001F600 \u{1F600}`, exp: "\"q' AAé😀 😀"},
+		{text: `\xff`, exp: "\xff"},
+		{text: `a\0b\1\12\1234`, exp: "a\x00b\x01\n\x534"},
+		{text: `\08\377`, exp: "\x008\xff"},
+		{text: "line\\\ncont\\\r\ninued", exp: "linecontinued"},
+		{text: `\d\$`, exp: `d$`, keep: `\d\$`},
+		{text: `\é`, exp: `é`, keep: `\é`},
+		{text: `trailing\`, exp: `trailing\`},
+		{text: `raw\n`, exp: `raw\n`, raw: true},
+		{text: `\x4`, err: ErrInvalidEscape},
+		{text: `\u{110000}`, err: ErrInvalidEscape},
+		{text: `\u{41`, err: ErrInvalidEscape},
+		{text: `\400`, err: ErrInvalidEscape},
+	}
+
+	for _, tc := range testCases {
+		for _, keep := range []bool{false, true} {
+			var b strings.Builder
+
+			err := Syntax{KeepUnknownEscapes: keep}.unescape(&b, []byte(tc.text), tc.raw)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("Expected error %v for %q, got %v", tc.err, tc.text, err)
+			}
+
+			exp := tc.exp
+			if keep && tc.keep != "" {
+				exp = tc.keep
+			}
+
+			if err == nil && b.String() != exp {
+				t.Fatalf("Expected %q for %q (keep: %v), got %q", exp, tc.text, keep, b.String())
+			}
+		}
+	}
+}
+
+func TestUnquote(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct{ text, prefix, body string }{
+		{`"abc"`, "", "abc"},
+		{`""`, "", ""},
+		{`''`, "", ""},
+		{"`raw`", "", "raw"},
+		{`rb'x'`, "rb", "x"},
+		{`"""doc "string" """`, "", `doc "string" `},
+		{`f''''''`, "f", ""},
+		{`42`, "", "42"},
+	}
+
+	for _, tc := range testCases {
+		if prefix, body := unquote(tc.text); prefix != tc.prefix || body != tc.body {
+			t.Fatalf("Expected %q, %q for %q, got %q, %q", tc.prefix, tc.body, tc.text, prefix, body)
+		}
+	}
+}
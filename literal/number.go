@@ -0,0 +1,173 @@
+package literal
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// NumberKind is the kind of a numeric literal.
+type NumberKind int
+
+// The possible number kinds.
+const (
+	Integer NumberKind = iota
+	Float
+	Imaginary
+)
+
+// NumberSyntax describes the numeric literals of a language. The hexadecimal
+// (0x), binary (0b) and octal (0o) prefixes are always recognized.
+type NumberSyntax struct {
+	// Separators are the digit separators (e.g. "_", or "'" for C++).
+	Separators string
+	// Suffixes are the type suffixes (e.g. "u", "ll", "i32"), matched case
+	// insensitively, longest first. For hexadecimal literals, the suffixes made
+	// of hexadecimal digits only (e.g. "f") are not recognized.
+	Suffixes []string
+	// FloatSuffixes are the suffixes making a literal a float (e.g. "f").
+	FloatSuffixes []string
+	// ImaginarySuffixes are the suffixes making a literal imaginary (e.g. "i"
+	// for Go, "j" for Python).
+	ImaginarySuffixes []string
+	// LegacyOctal tells that a leading 0 (e.g. 0755) makes an integer octal.
+	LegacyOctal bool
+}
+
+// NumberDecoder decodes a numeric literal node, whose source text is src.
+type NumberDecoder func(n sitter.Node, src []byte) (Number, error)
+
+// Number is the value of a numeric literal.
+type Number struct {
+	// Int is the value of integer literals.
+	Int *big.Int
+	// Suffix is the type suffix, if any (as written).
+	Suffix string
+	// Float is the value of float literals, and the imaginary part of
+	// imaginary ones.
+	Float float64
+	Kind  NumberKind
+}
+
+// ErrInvalidNumber is returned for malformed numeric literals.
+var ErrInvalidNumber = errors.New("invalid numeric literal")
+
+// The numeric literals syntaxes of some languages.
+//
+//nolint:gochecknoglobals // ok
+var (
+	GoNumbers         = NumberSyntax{Separators: "_", ImaginarySuffixes: []string{"i"}, LegacyOctal: true}
+	PythonNumbers     = NumberSyntax{Separators: "_", ImaginarySuffixes: []string{"j"}}
+	JavaScriptNumbers = NumberSyntax{Separators: "_", Suffixes: []string{"n"}}
+	CNumbers          = NumberSyntax{
+		Separators: "'", Suffixes: []string{"ull", "llu", "ul", "lu", "ll", "u", "l", "f"},
+		FloatSuffixes: []string{"f"}, LegacyOctal: true,
+	}
+	RustNumbers = NumberSyntax{Separators: "_", Suffixes: []string{
+		"i8", "i16", "i32", "i64", "i128", "isize", "u8", "u16", "u32", "u64", "u128", "usize", "f32", "f64",
+	}, FloatSuffixes: []string{"f32", "f64"}}
+)
+
+// NewNumberDecoder returns a decoder of the numeric literals of the given
+// syntax.
+func NewNumberDecoder(s NumberSyntax) NumberDecoder {
+	return func(n sitter.Node, src []byte) (Number, error) {
+		return s.Parse(n.Content(src))
+	}
+}
+
+// Parse parses the text of a numeric literal.
+func (s NumberSyntax) Parse(text string) (num Number, err error) {
+	digits := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(s.Separators, r) {
+			return -1
+		}
+
+		return r
+	}, text)
+
+	base, prefix := 10, ""
+	if len(digits) > 1 && digits[0] == '0' {
+		switch digits[1] {
+		case 'x', 'X':
+			base, prefix = 16, digits[:2]
+		case 'b', 'B':
+			base, prefix = 2, digits[:2]
+		case 'o', 'O':
+			base, prefix = 8, digits[:2]
+		}
+	}
+
+	if suffix := s.suffix(s.ImaginarySuffixes, digits, base); suffix != "" {
+		num.Kind, num.Suffix, digits = Imaginary, suffix, digits[:len(digits)-len(suffix)]
+	} else if suffix = s.suffix(s.Suffixes, digits, base); suffix != "" {
+		num.Suffix, digits = suffix, digits[:len(digits)-len(suffix)]
+	}
+
+	isFloat := strings.ContainsAny(digits, ".pP") || (base == 10 && strings.ContainsAny(digits, "eE")) ||
+		(num.Suffix != "" && num.Kind == Integer && containsFold(s.FloatSuffixes, num.Suffix))
+
+	switch {
+	case isFloat:
+		if num.Float, err = strconv.ParseFloat(digits, 64); err != nil && !errors.Is(err, strconv.ErrRange) {
+			return num, fmt.Errorf("%w: %q", ErrInvalidNumber, text)
+		}
+
+		if num.Kind != Imaginary {
+			num.Kind = Float
+		}
+
+		return num, nil
+	case base == 10 && s.LegacyOctal && num.Kind == Integer && len(digits) > 1 && digits[0] == '0':
+		// The imaginary ones stay decimal (e.g. 0755i), as in Go.
+		base, prefix = 8, "0"
+	}
+
+	i, ok := new(big.Int).SetString(digits[len(prefix):], base)
+	if !ok {
+		return num, fmt.Errorf("%w: %q", ErrInvalidNumber, text)
+	}
+
+	if num.Kind == Imaginary {
+		num.Float, _ = new(big.Float).SetInt(i).Float64()
+	} else {
+		num.Int = i
+	}
+
+	return num, nil
+}
+
+// suffix returns the (longest) suffix of the digits among suffixes, if any.
+// For hexadecimal literals, the suffixes made of hexadecimal digits are not
+// considered.
+func (s NumberSyntax) suffix(suffixes []string, digits string, base int) (suffix string) {
+	for _, sfx := range suffixes {
+		if len(sfx) <= len(suffix) || len(sfx) >= len(digits) ||
+			!strings.EqualFold(digits[len(digits)-len(sfx):], sfx) {
+			continue
+		}
+
+		if base == 16 && strings.Trim(strings.ToLower(sfx), "0123456789abcdef") == "" {
+			continue
+		}
+
+		suffix = digits[len(digits)-len(sfx):]
+	}
+
+	return
+}
+
+// containsFold reports whether the list contains s, case insensitively.
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package literal
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+func TestNewNumberDecoder(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("1 + 42")
+
+	root, err := sitter.Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	decode := NewNumberDecoder(GoNumbers)
+	act := []string{}
+
+	for _, n := range []sitter.Node{root.Child(0).Child(0).Child(0), root.Child(0).Child(2).Child(0)} {
+		num, err := decode(n, src)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		act = append(act, num.Int.String())
+	}
+
+	if exp := []string{"1", "42"}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestNumberSyntaxParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		syntax NumberSyntax
+		text   string
+		exp    string
+		suffix string
+		kind   NumberKind
+		err    error
+	}{
+		{GoNumbers, "1_000_000", "1000000", "", Integer, nil},
+		{GoNumbers, "0x_FF", "255", "", Integer, nil},
+		{GoNumbers, "0b1010", "10", "", Integer, nil},
+		{GoNumbers, "0o17", "15", "", Integer, nil},
+		{GoNumbers, "0755", "493", "", Integer, nil},
+		{GoNumbers, "0", "0", "", Integer, nil},
+		{GoNumbers, "123456789012345678901234567890", "123456789012345678901234567890", "", Integer, nil},
+		{GoNumbers, "1.5e3", "1500", "", Float, nil},
+		{GoNumbers, "0x1p-2", "0.25", "", Float, nil},
+		{GoNumbers, "1e400", "+Inf", "", Float, nil},
+		{GoNumbers, "2.5i", "2.5", "i", Imaginary, nil},
+		{GoNumbers, "0x10i", "16", "i", Imaginary, nil},
+		{GoNumbers, "0o17i", "15", "i", Imaginary, nil},
+		{GoNumbers, "0b1i", "1", "i", Imaginary, nil},
+		{GoNumbers, "0755i", "755", "i", Imaginary, nil},
+		{GoNumbers, "0x1p4i", "16", "i", Imaginary, nil},
+		{GoNumbers, "0xi", "", "i", Imaginary, ErrInvalidNumber},
+		{GoNumbers, "0xE5", "229", "", Integer, nil},
+		{GoNumbers, "09", "", "", Integer, ErrInvalidNumber},
+		{GoNumbers, "0x", "", "", Integer, ErrInvalidNumber},
+		{GoNumbers, "1.2.3", "", "", Float, ErrInvalidNumber},
+		{PythonNumbers, "3J", "3", "J", Imaginary, nil},
+		{PythonNumbers, "010", "10", "", Integer, nil},
+		{JavaScriptNumbers, "0xffn", "255", "n", Integer, nil},
+		{CNumbers, "1'000ULL", "1000", "ULL", Integer, nil},
+		{CNumbers, "0x1F", "31", "", Integer, nil},
+		{CNumbers, "0x1Fu", "31", "u", Integer, nil},
+		{CNumbers, "1.5f", "1.5", "f", Float, nil},
+		{RustNumbers, "0xffu8", "255", "u8", Integer, nil},
+		{RustNumbers, "1f32", "1", "f32", Float, nil},
+		{RustNumbers, "1_000i128", "1000", "i128", Integer, nil},
+	}
+
+	for _, tc := range testCases {
+		num, err := tc.syntax.Parse(tc.text)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("Expected error %v for %q, got %v", tc.err, tc.text, err)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		act := ""
+		if num.Int != nil {
+			act = num.Int.String()
+		} else {
+			act = strconv.FormatFloat(num.Float, 'f', -1, 64)
+		}
+
+		if act != tc.exp || num.Suffix != tc.suffix || num.Kind != tc.kind {
+			t.Fatalf("Expected %s, %q, %d for %q, got %s, %q, %d", tc.exp, tc.suffix, tc.kind, tc.text,
+				act, num.Suffix, num.Kind)
+		}
+	}
+}
+
+func TestNumberSyntaxSuffix(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestContainsFold(t *testing.T) {
+	t.Parallel()
+
+	if !containsFold([]string{"f", "d"}, "F") || containsFold([]string{"f"}, "l") {
+		t.Fatal("Expected a case insensitive match")
+	}
+}
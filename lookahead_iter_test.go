@@ -17,12 +17,50 @@ func TestLookaheadIteratorDelete(t *testing.T) {
 
 func TestLookaheadIteratorResetState(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + "), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	state := root.Child(0).Child(1).ParseState()
+
+	iter := NewLookaheadIterator(gr, 0)
+	defer iter.Delete()
+
+	if !iter.ResetState(state) || !iter.Next() || iter.CurrentSymbolName() != "comment" {
+		t.Fatal("Expected the iterator to list the symbols of the new state, got", iter.CurrentSymbolName())
+	}
+
+	if iter.ResetState(StateID(gr.StateCount())) {
+		t.Fatal("Expected an invalid state to be rejected")
+	}
 }
 
 func TestLookaheadIteratorReset(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + "), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	state := root.Child(0).Child(1).ParseState()
+
+	iter := NewLookaheadIterator(gr, 0)
+	defer iter.Delete()
+
+	if !iter.Reset(gr, state) || iter.Language().ptr != gr.ptr {
+		t.Fatal("Expected the iterator to be reset")
+	}
+
+	if !iter.Next() || iter.CurrentSymbolName() != "comment" {
+		t.Fatal("Expected the symbols of the new state, got", iter.CurrentSymbolName())
+	}
+
+	if iter.Reset(gr, StateID(gr.StateCount())) {
+		t.Fatal("Expected an invalid state to be rejected")
+	}
 }
 
 func TestLookaheadIteratorLanguage(t *testing.T) {
@@ -0,0 +1,87 @@
+package sitter
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// MatchJSON is the JSON representation of a [QueryMatch], as written by
+// [EncodeMatchesJSON] and read by [DecodeMatchesJSON].
+type MatchJSON struct {
+	Captures []CaptureJSON `json:"captures"`
+	Pattern  uint          `json:"pattern"`
+}
+
+// CaptureJSON is the JSON representation of a [QueryCapture]. The start and
+// end points are encoded as [row, column] pairs.
+type CaptureJSON struct {
+	Name      string  `json:"name"`
+	Text      string  `json:"text"`
+	Start     [2]uint `json:"start"`
+	End       [2]uint `json:"end"`
+	StartByte uint    `json:"start_byte"`
+	EndByte   uint    `json:"end_byte"`
+}
+
+// EncodeMatchesJSON writes all the matches to w as JSON, one match per line
+// (JSON Lines), suitable for piping into jq or other tools, as well as for
+// test fixtures. It returns the first encoding error, or the matches' error,
+// if any (see [QueryMatches.Err]).
+func EncodeMatchesJSON(w io.Writer, q *Query, matches QueryMatches, src []byte) error {
+	enc := json.NewEncoder(w)
+
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		if err := enc.Encode(NewMatchJSON(q, m, src)); err != nil {
+			return err
+		}
+	}
+
+	return matches.Err()
+}
+
+// DecodeMatchesJSON reads back the matches written by [EncodeMatchesJSON].
+func DecodeMatchesJSON(r io.Reader) (out []MatchJSON, err error) {
+	dec := json.NewDecoder(r)
+
+	for {
+		var m MatchJSON
+		if err = dec.Decode(&m); err != nil {
+			if errors.Is(err, io.EOF) {
+				return out, nil
+			}
+
+			return nil, err
+		}
+
+		out = append(out, m)
+	}
+}
+
+// NewMatchJSON converts a [QueryMatch] to its JSON representation.
+func NewMatchJSON(q *Query, m *QueryMatch, src []byte) MatchJSON {
+	out := MatchJSON{Pattern: m.PatternIndex, Captures: make([]CaptureJSON, 0, len(m.Captures))}
+
+	for _, c := range m.Captures {
+		r := c.Node.Range()
+		out.Captures = append(out.Captures, CaptureJSON{
+			Name:      q.CaptureNameForID(c.Index),
+			Text:      c.Node.Content(src),
+			Start:     [2]uint{r.StartPoint.Row, r.StartPoint.Column},
+			End:       [2]uint{r.EndPoint.Row, r.EndPoint.Column},
+			StartByte: r.StartByte,
+			EndByte:   r.EndByte,
+		})
+	}
+
+	return out
+}
+
+// Range returns the capture's range.
+func (c CaptureJSON) Range() Range {
+	return Range{
+		StartPoint: Point{Row: c.Start[0], Column: c.Start[1]},
+		EndPoint:   Point{Row: c.End[0], Column: c.End[1]},
+		StartByte:  c.StartByte, EndByte: c.EndByte,
+	}
+}
@@ -0,0 +1,67 @@
+package sitter
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEncodeMatchesJSON(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + 22")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(sum left: (expression (number) @left) right: (expression (number) @right))"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err = EncodeMatchesJSON(buf, q, NewQueryCursor().Matches(q, root, input), input); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := `{"captures":[{"name":"left","text":"1","start":[0,0],"end":[0,1],"start_byte":0,"end_byte":1},` +
+		`{"name":"right","text":"22","start":[0,4],"end":[0,6],"start_byte":4,"end_byte":6}],"pattern":0}` + "\n"
+	if act := buf.String(); act != exp {
+		t.Fatalf("Expected\n%s\ngot\n%s", exp, act)
+	}
+
+	matches, err := DecodeMatchesJSON(strings.NewReader(exp))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if len(matches) != 1 || len(matches[0].Captures) != 2 {
+		t.Fatalf("Expected 1 match with 2 captures, got %v", matches)
+	}
+
+	if exp, act := root.Child(0).Child(2).Range(), matches[0].Captures[1].Range(); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+}
+
+func TestDecodeMatchesJSON(t *testing.T) {
+	t.Parallel()
+
+	if _, err := DecodeMatchesJSON(strings.NewReader(`{"pattern":`)); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
+
+func TestNewMatchJSON(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestCaptureJSONRange(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
@@ -0,0 +1,176 @@
+// Package metrics computes code metrics (statement and branch counts,
+// cyclomatic complexity, nesting depth) per function, driven by per-language
+// tree-sitter queries rather than by bespoke tree walkers.
+//
+// The queries map captures to metric events:
+//
+//   - @function: a function (or method, closure, etc.), with an optional @name;
+//   - @statement: a statement;
+//   - @branch: a branch point (if, loop, case, && and ||, etc.);
+//   - @nesting: a node nesting its content one level deeper (blocks, etc.).
+//
+// Each event is attributed to the innermost function containing it, nested
+// functions being measured on their own. For instance, for Go:
+//
+//	(function_declaration name: (identifier) @name) @function
+//	(method_declaration name: (field_identifier) @name) @function
+//	(func_literal) @function
+//	[(expression_statement) (return_statement) (assignment_statement)] @statement
+//	[(if_statement) (for_statement) (expression_case) (binary_expression operator: ["&&" "||"])] @branch
+//	(block) @nesting
+package metrics
+
+import (
+	"cmp"
+	"slices"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Rules are the metrics rules of a language, see [NewRules].
+type Rules struct {
+	query *sitter.Query
+}
+
+// Metrics are the metrics of a function, or of a whole file.
+type Metrics struct {
+	Statements int
+	Branches   int
+	// MaxNesting is the maximum number of @nesting nodes nested into one
+	// another (within the function).
+	MaxNesting int
+}
+
+// FunctionMetrics are the metrics of a function.
+type FunctionMetrics struct {
+	Name  string
+	Range sitter.Range
+	Metrics
+}
+
+// Report holds the metrics of a file, as computed by [Rules.Compute].
+type Report struct {
+	// File holds the metrics of the whole file, functions included (its
+	// MaxNesting is the maximum one of the functions and of the top level).
+	File      Metrics
+	Functions []FunctionMetrics
+}
+
+// event is a captured node, of one of the event* kinds.
+type event struct {
+	rng  sitter.Range
+	name string
+	kind string
+}
+
+// NewRules compiles the metrics rules of a language.
+func NewRules(lang *sitter.Language, pattern string) (*Rules, error) {
+	q, err := sitter.NewQuery(lang, []byte(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rules{query: q}, nil
+}
+
+// Cyclomatic returns the cyclomatic complexity, i.e. one more than the
+// number of branches.
+func (m Metrics) Cyclomatic() int {
+	return m.Branches + 1
+}
+
+// Compute computes the metrics of the file rooted at root, whose source text
+// is src. The functions are sorted by their position.
+func (r *Rules) Compute(root sitter.Node, src []byte) (report Report) {
+	var fns, nestings, events []event
+
+	names := r.query.CaptureNames()
+	qc := sitter.NewQueryCursor()
+
+	matches := qc.Matches(r.query, root, src)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		var fn *event
+
+		for _, c := range m.Captures {
+			switch kind := names[c.Index]; kind {
+			case "function":
+				fns = append(fns, event{rng: c.Node.Range(), kind: kind})
+				fn = &fns[len(fns)-1]
+			case "nesting":
+				nestings = append(nestings, event{rng: c.Node.Range(), kind: kind})
+			case "statement", "branch":
+				events = append(events, event{rng: c.Node.Range(), kind: kind})
+			}
+		}
+
+		for _, c := range m.Captures {
+			if fn != nil && names[c.Index] == "name" {
+				fn.name = c.Node.Content(src)
+			}
+		}
+	}
+
+	byPosition := func(a, b event) int {
+		return cmp.Or(cmp.Compare(a.rng.StartByte, b.rng.StartByte), cmp.Compare(b.rng.EndByte, a.rng.EndByte))
+	}
+
+	slices.SortStableFunc(fns, byPosition)
+	fns = slices.CompactFunc(fns, func(a, b event) bool { return a.rng == b.rng })
+
+	metrics := make([]Metrics, len(fns))
+	add := func(fn int, update func(*Metrics)) {
+		update(&report.File)
+
+		if fn >= 0 {
+			update(&metrics[fn])
+		}
+	}
+
+	for _, e := range events {
+		add(innermost(fns, e.rng), func(m *Metrics) {
+			if e.kind == "statement" {
+				m.Statements++
+			} else {
+				m.Branches++
+			}
+		})
+	}
+
+	for _, n := range nestings {
+		fn := innermost(fns, n.rng)
+		depth := 0
+
+		for _, outer := range nestings {
+			if contains(outer.rng, n.rng) && (fn < 0 || contains(fns[fn].rng, outer.rng)) {
+				depth++
+			}
+		}
+
+		add(fn, func(m *Metrics) { m.MaxNesting = max(m.MaxNesting, depth) })
+	}
+
+	for i, fn := range fns {
+		report.Functions = append(report.Functions, FunctionMetrics{Name: fn.name, Range: fn.rng, Metrics: metrics[i]})
+	}
+
+	return
+}
+
+// innermost returns the index of the innermost function containing the range,
+// -1 if none. The functions must be sorted by position.
+func innermost(fns []event, rng sitter.Range) (fn int) {
+	fn = -1
+
+	for i, f := range fns {
+		if contains(f.rng, rng) {
+			fn = i
+		}
+	}
+
+	return
+}
+
+// contains reports whether the outer range contains the inner one (or is the same).
+func contains(outer, inner sitter.Range) bool {
+	return outer.StartByte <= inner.StartByte && inner.EndByte <= outer.EndByte
+}
@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+// rng returns a range spanning the given bytes, on the first row.
+func rng(start, end uint) sitter.Range {
+	return sitter.Range{
+		StartByte: start, EndByte: end,
+		StartPoint: sitter.Point{Column: start}, EndPoint: sitter.Point{Column: end},
+	}
+}
+
+func TestNewRules(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesCompute()")
+}
+
+func TestMetricsCyclomatic(t *testing.T) {
+	t.Parallel()
+
+	for branches, exp := range map[int]int{0: 1, 1: 2, 5: 6} {
+		if act := (Metrics{Branches: branches}).Cyclomatic(); act != exp {
+			t.Fatalf("Expected %d for %d branches, got %d", exp, branches, act)
+		}
+	}
+}
+
+func TestRulesCompute(t *testing.T) {
+	t.Parallel()
+
+	// In these calc "programs", parenthesized expressions are functions, sums
+	// are statements (and nest), and numbers are branches.
+	rules, err := NewRules(gr, `
+(expression "(") @function
+(sum) @statement @nesting
+(number) @branch
+`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	src := []byte("1 + (2 + (3 + 4 + 5)) + (6)")
+
+	root, err := sitter.Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	report := rules.Compute(root, src)
+
+	exp := Metrics{Statements: 5, Branches: 6, MaxNesting: 2}
+	if report.File != exp {
+		t.Fatalf("Expected %+v, got %+v", exp, report.File)
+	}
+
+	act := []Metrics{}
+	for _, fn := range report.Functions {
+		act = append(act, fn.Metrics)
+	}
+
+	expFns := []Metrics{
+		{Statements: 1, Branches: 1, MaxNesting: 1},
+		{Statements: 2, Branches: 3, MaxNesting: 2},
+		{Branches: 1},
+	}
+	if !reflect.DeepEqual(act, expFns) {
+		t.Fatalf("Expected %+v, got %+v", expFns, act)
+	}
+
+	if c := report.Functions[1].Cyclomatic(); c != 4 {
+		t.Fatal("Expected 4, got", c)
+	}
+}
+
+func TestInnermost(t *testing.T) {
+	t.Parallel()
+
+	fns := []event{{rng: rng(0, 20)}, {rng: rng(2, 8)}, {rng: rng(4, 6)}, {rng: rng(10, 15)}}
+
+	for r, exp := range map[sitter.Range]int{
+		rng(0, 20): 0, rng(1, 2): 0, rng(2, 3): 1, rng(4, 5): 2, rng(11, 12): 3, rng(14, 16): 0, rng(20, 21): -1,
+	} {
+		if act := innermost(fns, r); act != exp {
+			t.Fatalf("Expected %d for %v, got %d", exp, r, act)
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		outer, inner sitter.Range
+		exp          bool
+	}{
+		{rng(0, 5), rng(0, 5), true},
+		{rng(0, 5), rng(1, 4), true},
+		{rng(1, 4), rng(0, 5), false},
+		{rng(0, 5), rng(4, 6), false},
+	} {
+		if act := contains(tc.outer, tc.inner); act != tc.exp {
+			t.Fatalf("Expected %v for %v in %v, got %v", tc.exp, tc.inner, tc.outer, act)
+		}
+	}
+}
@@ -1,9 +1,15 @@
 package sitter
 
+// #include <string.h>
 // #include "sitter.h"
 import "C"
 
-import "unsafe"
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"unsafe"
+)
 
 // Node represents a single node in the syntax tree
 // It tracks its start and end positions in the source code,
@@ -36,50 +42,52 @@ func newNode(ptr C.TSNode) (n Node) {
 
 // Type returns the node's type.
 func (n Node) Type() string {
-	return C.GoString(C.ts_node_type(n.c))
+	return profiled(CgoNode, func() string { return C.GoString(C.ts_node_type(n.c)) })
 }
 
 // Symbol returns the node's type.
 func (n Node) Symbol() Symbol {
-	return C.ts_node_symbol(n.c)
+	return profiled(CgoNode, func() Symbol { return C.ts_node_symbol(n.c) })
 }
 
 // Language returns the node's language.
 func (n Node) Language() *Language {
-	return NewLanguage(unsafe.Pointer(C.ts_node_language(n.c)))
+	return profiled(CgoNode, func() *Language { return NewLanguage(unsafe.Pointer(C.ts_node_language(n.c))) })
 }
 
 // GrammarType returns the node's type as it appears in the grammar,
 // ignoring aliases.
 func (n Node) GrammarType() string {
-	return C.GoString(C.ts_node_grammar_type(n.c))
+	return profiled(CgoNode, func() string { return C.GoString(C.ts_node_grammar_type(n.c)) })
 }
 
 // GrammarSymbol returns the node's symbol as it appears in the grammar,
 // ignoring aliases.
 // This should be used in `ts_language_next_state` instead of `ts_node_symbol`.
 func (n Node) GrammarSymbol() Symbol {
-	return Symbol(C.ts_node_grammar_symbol(n.c)) //nolint:unconvert // we need the methods on the aliased type
+	return profiled(CgoNode, func() Symbol {
+		return Symbol(C.ts_node_grammar_symbol(n.c)) //nolint:unconvert // we need the methods on the aliased type
+	})
 }
 
 // StartByte returns the node's start byte.
 func (n Node) StartByte() uint {
-	return uint(C.ts_node_start_byte(n.c))
+	return profiled(CgoNode, func() uint { return uint(C.ts_node_start_byte(n.c)) })
 }
 
 // StartPoint returns the node's start position in terms of rows and columns.
 func (n Node) StartPoint() Point {
-	return mkPoint(C.ts_node_start_point(n.c))
+	return profiled(CgoNode, func() Point { return mkPoint(C.ts_node_start_point(n.c)) })
 }
 
 // EndByte returns the node's end byte.
 func (n Node) EndByte() uint {
-	return uint(C.ts_node_end_byte(n.c))
+	return profiled(CgoNode, func() uint { return uint(C.ts_node_end_byte(n.c)) })
 }
 
 // EndPoint returns the node's end position in terms of rows and columns.
 func (n Node) EndPoint() Point {
-	return mkPoint(C.ts_node_end_point(n.c))
+	return profiled(CgoNode, func() Point { return mkPoint(C.ts_node_end_point(n.c)) })
 }
 
 // String returns an S-expression representing the node as a string.
@@ -87,10 +95,12 @@ func (n Node) EndPoint() Point {
 // This string is allocated with `malloc` and the caller is responsible for
 // freeing it using `free`.
 func (n Node) String() string {
-	p := C.ts_node_string(n.c)
-	defer C.free(unsafe.Pointer(p))
+	return profiled(CgoNode, func() string {
+		p := C.ts_node_string(n.c)
+		defer C.free(unsafe.Pointer(p))
 
-	return C.GoString(p)
+		return C.GoString(p)
+	})
 }
 
 // IsNull checks if the node is null.
@@ -98,7 +108,7 @@ func (n Node) String() string {
 // Functions like `ts_node_child` and `ts_node_next_sibling` will return a null
 // node to indicate that no such node was found.
 func (n Node) IsNull() bool {
-	return bool(C.ts_node_is_null(n.c))
+	return profiled(CgoNode, func() bool { return bool(C.ts_node_is_null(n.c)) })
 }
 
 // IsNamed checks if the node is *named*.
@@ -106,7 +116,7 @@ func (n Node) IsNull() bool {
 // Named nodes correspond to named rules in the grammar,
 // whereas *anonymous* nodes correspond to string literals in the grammar.
 func (n Node) IsNamed() bool {
-	return bool(C.ts_node_is_named(n.c))
+	return profiled(CgoNode, func() bool { return bool(C.ts_node_is_named(n.c)) })
 }
 
 // IsMissing checks if the node is *missing*.
@@ -114,7 +124,7 @@ func (n Node) IsNamed() bool {
 // Missing nodes are inserted by the parser in order to recover from certain
 // kinds of syntax errors.
 func (n Node) IsMissing() bool {
-	return bool(C.ts_node_is_missing(n.c))
+	return profiled(CgoNode, func() bool { return bool(C.ts_node_is_missing(n.c)) })
 }
 
 // IsExtra checks if the node is *extra*.
@@ -122,17 +132,17 @@ func (n Node) IsMissing() bool {
 // Extra nodes represent things like comments, which are not required the grammar,
 // but can appear anywhere.
 func (n Node) IsExtra() bool {
-	return bool(C.ts_node_is_extra(n.c))
+	return profiled(CgoNode, func() bool { return bool(C.ts_node_is_extra(n.c)) })
 }
 
 // HasChanges checks if a syntax node has been edited.
 func (n Node) HasChanges() bool {
-	return bool(C.ts_node_has_changes(n.c))
+	return profiled(CgoNode, func() bool { return bool(C.ts_node_has_changes(n.c)) })
 }
 
 // HasError check if the node is a syntax error or contains any syntax errors.
 func (n Node) HasError() bool {
-	return bool(C.ts_node_has_error(n.c))
+	return profiled(CgoNode, func() bool { return bool(C.ts_node_has_error(n.c)) })
 }
 
 // IsError checks if the node is a syntax error.
@@ -140,17 +150,64 @@ func (n Node) HasError() bool {
 // Syntax errors represent parts of the code that could not be incorporated
 // into a valid syntax tree.
 func (n Node) IsError() bool {
-	return bool(C.ts_node_is_error(n.c))
+	return profiled(CgoNode, func() bool { return bool(C.ts_node_is_error(n.c)) })
+}
+
+// ErrorFraction returns the fraction (from 0 to 1) of the node's non-space
+// bytes (src being its source text) spanned by syntax errors, i.e. skipped
+// by the parser, as an approximation of the error recovery cost (which the
+// runtime does not expose), e.g. for skipping the analyses of the regions
+// too broken to be useful. The missing nodes, spanning no bytes, do not count.
+func (n Node) ErrorFraction(src []byte) float64 {
+	if !n.HasError() {
+		return 0
+	}
+
+	total := nonSpaceCount(src[n.StartByte():n.EndByte()])
+	if total == 0 {
+		return 0
+	}
+
+	c := NewTreeCursor(n)
+	defer c.close()
+
+	var errs int
+
+	for {
+		if m := c.CurrentNode(); m.IsError() {
+			errs += nonSpaceCount(src[m.StartByte():m.EndByte()])
+		} else if m.HasError() && c.GoToFirstChild() {
+			continue
+		}
+
+		for !c.GoToNextSibling() {
+			if !c.GoToParent() {
+				return float64(errs) / float64(total)
+			}
+		}
+	}
+}
+
+func nonSpaceCount(b []byte) (count int) {
+	for _, c := range b {
+		switch c {
+		case ' ', '\t', '\n', '\v', '\f', '\r':
+		default:
+			count++
+		}
+	}
+
+	return
 }
 
 // ParseState returns this node's parse state.
 func (n Node) ParseState() StateID {
-	return C.ts_node_parse_state(n.c)
+	return profiled(CgoNode, func() StateID { return C.ts_node_parse_state(n.c) })
 }
 
 // NextParseState returns the parse state after this node.
 func (n Node) NextParseState() StateID {
-	return C.ts_node_next_parse_state(n.c)
+	return profiled(CgoNode, func() StateID { return C.ts_node_next_parse_state(n.c) })
 }
 
 // Parent returns the node's immediate parent.
@@ -158,17 +215,20 @@ func (n Node) NextParseState() StateID {
 // Prefer `ts_node_child_containing_descendant` for
 // iterating over the node's ancestors.
 func (n Node) Parent() Node {
-	return newNode(C.ts_node_parent(n.c))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_parent(n.c)) })
 }
 
 // ChildContainingDescendant returns the node's child that contains `descendant`.
-// Deprecated: use [`ts_node_contains_descendant`] instead, this will be removed in 0.25
+// This will not return the descendant if it is a direct child of `self`.
 //
-// Get the node's child containing `descendant`. This will not return
-// the descendant if it is a direct child of `self`, for that use
-// `ts_node_contains_descendant`.
+// Deprecated: use [Node.ChildWithDescendant] instead. The runtime dropped
+// `ts_node_child_containing_descendant` in 0.25, so it is emulated with it.
 func (n Node) ChildContainingDescendant(d Node) Node {
-	return newNode(C.ts_node_child_containing_descendant(n.c, d.c))
+	if c := n.ChildWithDescendant(d); c != d {
+		return c
+	}
+
+	return Node{}
 }
 
 // ChildWithDescendant returns the node that contains `descendant`.
@@ -176,52 +236,63 @@ func (n Node) ChildContainingDescendant(d Node) Node {
 // NOTE: that this can return `descendant` itself, unlike the deprecated function
 // [`ts_node_child_containing_descendant`].
 func (n Node) ChildWithDescendant(d Node) Node {
-	return newNode(C.ts_node_child_with_descendant(n.c, d.c))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_child_with_descendant(n.c, d.c)) })
 }
 
 // Child returns the node's child at the given index, where zero represents the
 // first child.
 func (n Node) Child(idx uint32) Node {
-	return newNode(C.ts_node_child(n.c, C.uint(idx)))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_child(n.c, C.uint(idx))) })
 }
 
 // FieldNameForChild returns the field name of the child at the given index,
 // or "" if not named.
 func (n Node) FieldNameForChild(idx int) string {
-	return C.GoString(C.ts_node_field_name_for_child(n.c, C.uint(idx)))
+	return profiled(CgoNode, func() string { return C.GoString(C.ts_node_field_name_for_child(n.c, C.uint(idx))) })
+}
+
+// FieldIDFor returns the field id of the child at the given index, or zero
+// if the child has no field. Unlike [Node.FieldNameForChild], no string is
+// created, so it is cheaper to use in hot traversals.
+func (n Node) FieldIDFor(childIdx int) FieldID {
+	return profiled(CgoNode, func() FieldID { return C.go_node_field_id_for_child(n.c, C.uint(childIdx)) })
 }
 
 // FieldNameForNamedChild returns the field name for node's named child at the given index, where zero
 // represents the first named child. Returns NULL, if no field is found.
 func (n Node) FieldNameForNamedChild(idx uint32) string {
-	return C.GoString(C.ts_node_field_name_for_named_child(n.c, C.uint(idx)))
+	return profiled(CgoNode, func() string {
+		return C.GoString(C.ts_node_field_name_for_named_child(n.c, C.uint(idx)))
+	})
 }
 
 // ChildCount returns the node's number of children.
 func (n Node) ChildCount() uint32 {
-	return uint32(C.ts_node_child_count(n.c))
+	return profiled(CgoNode, func() uint32 { return uint32(C.ts_node_child_count(n.c)) })
 }
 
 // NamedChild returns the node's *named* child at the given index.
 //
 // See also `ts_node_is_named`.
 func (n Node) NamedChild(idx uint32) Node {
-	return newNode(C.ts_node_named_child(n.c, C.uint(idx)))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_named_child(n.c, C.uint(idx))) })
 }
 
 // NamedChildCount returns the node's number of *named* children.
 //
 // See also `ts_node_is_named`.
 func (n Node) NamedChildCount() uint32 {
-	return uint32(C.ts_node_named_child_count(n.c))
+	return profiled(CgoNode, func() uint32 { return uint32(C.ts_node_named_child_count(n.c)) })
 }
 
 // ChildByFieldName returns the node's child with the given field name.
 func (n Node) ChildByFieldName(name string) Node {
-	str := C.CString(name)
-	defer C.free(unsafe.Pointer(str))
+	return profiled(CgoNode, func() Node {
+		str := C.CString(name)
+		defer C.free(unsafe.Pointer(str))
 
-	return newNode(C.ts_node_child_by_field_name(n.c, str, C.uint(len(name))))
+		return newNode(C.ts_node_child_by_field_name(n.c, str, C.uint(len(name))))
+	})
 }
 
 // ChildByFieldID returns the node's child with the given numerical field id.
@@ -229,69 +300,161 @@ func (n Node) ChildByFieldName(name string) Node {
 // You can convert a field name to an id using the
 // `ts_language_field_id_for_name` function.
 func (n Node) ChildByFieldID(id FieldID) Node {
-	return newNode(C.ts_node_child_by_field_id(n.c, id))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_child_by_field_id(n.c, id)) })
+}
+
+// Children returns an iterator over the node's children. It walks them with a
+// [TreeCursor], which is cheaper than looking them up by index (see
+// [Node.Child]).
+func (n Node) Children() iter.Seq[Node] {
+	return n.children(false, 0)
+}
+
+// NamedChildren is like [Node.Children], for the named children only.
+func (n Node) NamedChildren() iter.Seq[Node] {
+	return n.children(true, 0)
+}
+
+// ChildrenByFieldName is like [Node.Children], for the children with the given
+// field name only (unlike [Node.ChildByFieldName], which returns the first).
+func (n Node) ChildrenByFieldName(name string) iter.Seq[Node] {
+	if n.IsNull() {
+		return n.children(false, 0)
+	}
+
+	str := C.CString(name)
+	defer C.free(unsafe.Pointer(str))
+
+	id := C.ts_language_field_id_for_name(C.ts_node_language(n.c), str, C.uint(len(name)))
+	if id == 0 {
+		return func(func(Node) bool) {}
+	}
+
+	return n.children(false, id)
+}
+
+// Walk returns an iterator over the node and its descendants, in preorder,
+// along with their depths (relative to the node, whose depth is 0). Like
+// [Node.Children], it walks them with a [TreeCursor] (from [AcquireCursor]),
+// which is much cheaper than recursing with [Node.Child].
+func (n Node) Walk() iter.Seq2[Node, int] {
+	return func(yield func(Node, int) bool) {
+		if n.IsNull() {
+			return
+		}
+
+		c := AcquireCursor(n)
+		defer ReleaseCursor(c)
+
+		for depth := 0; yield(c.CurrentNode(), depth); {
+			if c.GoToFirstChild() {
+				depth++
+				continue
+			}
+
+			for depth > 0 && !c.GoToNextSibling() {
+				c.GoToParent()
+				depth--
+			}
+
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+// children returns an iterator over the node's children, all of them, or only
+// the named ones, or only the ones with the given field (if not zero).
+func (n Node) children(named bool, field FieldID) iter.Seq[Node] {
+	return func(yield func(Node) bool) {
+		if n.IsNull() {
+			return
+		}
+
+		c := NewTreeCursor(n)
+		defer c.close()
+
+		for ok := c.GoToFirstChild(); ok; ok = c.GoToNextSibling() {
+			if field != 0 && c.CurrentFieldID() != field {
+				continue
+			}
+
+			if child := c.CurrentNode(); (!named || child.IsNamed()) && !yield(child) {
+				return
+			}
+		}
+	}
 }
 
 // NextSibling returns the node's next sibling.
 func (n Node) NextSibling() Node {
-	return newNode(C.ts_node_next_sibling(n.c))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_next_sibling(n.c)) })
 }
 
 // PrevSibling returns the node's previous sibling.
 func (n Node) PrevSibling() Node {
-	return newNode(C.ts_node_prev_sibling(n.c))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_prev_sibling(n.c)) })
 }
 
 // NextNamedSibling returns the node's next *named* sibling.
 func (n Node) NextNamedSibling() Node {
-	return newNode(C.ts_node_next_named_sibling(n.c))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_next_named_sibling(n.c)) })
 }
 
 // PrevNamedSibling returns the node's previous *named* sibling.
 func (n Node) PrevNamedSibling() Node {
-	return newNode(C.ts_node_prev_named_sibling(n.c))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_prev_named_sibling(n.c)) })
 }
 
 // FirstChildForByte returns the node's first child that extends beyond the
 // given byte offset.
 func (n Node) FirstChildForByte(ofs uint32) Node {
-	return newNode(C.ts_node_first_child_for_byte(n.c, C.uint(ofs)))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_first_child_for_byte(n.c, C.uint(ofs))) })
 }
 
 // FirstNamedChildForByte returns the node's first named child that extends
 // beyond the given byte offset.
 func (n Node) FirstNamedChildForByte(ofs uint32) Node {
-	return newNode(C.ts_node_first_named_child_for_byte(n.c, C.uint(ofs)))
+	return profiled(CgoNode, func() Node { return newNode(C.ts_node_first_named_child_for_byte(n.c, C.uint(ofs))) })
 }
 
 // DescendantCount returns the node's number of descendants, including one
 // for the node itself.
 func (n Node) DescendantCount() uint32 {
-	return uint32(C.ts_node_descendant_count(n.c))
+	return profiled(CgoNode, func() uint32 { return uint32(C.ts_node_descendant_count(n.c)) })
 }
 
 // DescendantForByteRange returns the smallest node within this node that spans
 // the given range of bytes.
 func (n Node) DescendantForByteRange(start, end uint32) Node {
-	return newNode(C.ts_node_descendant_for_byte_range(n.c, C.uint(start), C.uint(end)))
+	return profiled(CgoNode, func() Node {
+		return newNode(C.ts_node_descendant_for_byte_range(n.c, C.uint(start), C.uint(end)))
+	})
 }
 
 // DescendantForPointRange returns the smallest node within this node that spans
 // the given range of {row, column} positions.
 func (n Node) DescendantForPointRange(start, end Point) Node {
-	return newNode(C.ts_node_descendant_for_point_range(n.c, start.c(), end.c()))
+	return profiled(CgoNode, func() Node {
+		return newNode(C.ts_node_descendant_for_point_range(n.c, start.c(), end.c()))
+	})
 }
 
 // NamedDescendantForByteRange returns the smallest named node within this node
 // that spans the given range of bytes.
 func (n Node) NamedDescendantForByteRange(start, end uint32) Node {
-	return newNode(C.ts_node_named_descendant_for_byte_range(n.c, C.uint(start), C.uint(end)))
+	return profiled(CgoNode, func() Node {
+		return newNode(C.ts_node_named_descendant_for_byte_range(n.c, C.uint(start), C.uint(end)))
+	})
 }
 
 // NamedDescendantForPointRange returns the smallest named node within this node
 // that spans the given range of row/column positions.
 func (n Node) NamedDescendantForPointRange(start, end Point) Node {
-	return newNode(C.ts_node_named_descendant_for_point_range(n.c, start.c(), end.c()))
+	return profiled(CgoNode, func() Node {
+		return newNode(C.ts_node_named_descendant_for_point_range(n.c, start.c(), end.c()))
+	})
 }
 
 // Edit the node to keep it in-sync with source code that has been edited.
@@ -301,17 +464,41 @@ func (n Node) NamedDescendantForPointRange(start, end Point) Node {
 // afterward will already reflect the edit. You only need to use `ts_node_edit`
 // when you have a `TSNode` instance that you want to keep and continue to use
 // after an edit.
-func (n Node) Edit(i InputEdit) {
-	C.ts_node_edit(&n.c, i.c()) //nolint:gocritic // ok
+func (n *Node) Edit(i InputEdit) {
+	profiledDo(CgoNode, func() {
+		C.ts_node_edit(&n.c, i.c())
+	})
 }
 
 // Equal checks if two nodes are identical.
 func (n Node) Equal(other Node) bool {
-	return bool(C.ts_node_eq(n.c, other.c))
+	return profiled(CgoNode, func() bool { return bool(C.ts_node_eq(n.c, other.c)) })
 }
 
 // Non API.
 
+// NodeKey identifies a node of a tree, see [Node.Key]. Unlike [Node], whose
+// struct equality also compares its (cached) position, it is meant to be used
+// as a map key, e.g. by caches and symbol tables.
+type NodeKey struct {
+	Tree uintptr
+	ID   uintptr
+}
+
+// ID returns the identifier of the node, which is unique within its tree (but
+// not across trees, nor stable across the parses of the same text, incremental
+// or not).
+func (n Node) ID() uintptr {
+	return uintptr(n.c.id)
+}
+
+// Key returns the key of the node, made of its tree and its [Node.ID], which
+// is unique across the trees alive (the copies of a tree, see [Tree.Copy],
+// included).
+func (n Node) Key() NodeKey {
+	return NodeKey{Tree: uintptr(unsafe.Pointer(n.c.tree)), ID: n.ID()}
+}
+
 // Range returns the node range.
 func (n Node) Range() Range {
 	return Range{
@@ -322,5 +509,127 @@ func (n Node) Range() Range {
 
 // Content returns node's source code from input as a string.
 func (n Node) Content(input []byte) string {
-	return string(input[n.StartByte():n.EndByte()])
+	return string(n.bytes(input))
+}
+
+// IsType checks if the node is of the given type, comparing symbols rather than
+// type names (see [Language.MustSymbol]).
+func (n Node) IsType(sym Symbol) bool {
+	return n.Symbol() == sym
+}
+
+// ContentView returns node's source code from src as a string, without
+// copying it. The returned string shares memory with src, so it is only valid
+// for as long as src is alive and unmodified; use [Node.Content] if the string
+// needs to outlive the source.
+func (n Node) ContentView(src []byte) string {
+	b := n.bytes(src)
+	if len(b) == 0 {
+		return ""
+	}
+
+	return unsafe.String(&b[0], len(b))
+}
+
+// AppendContent appends node's source code from src to dst and returns the
+// extended buffer, allowing hot paths to reuse a buffer instead of allocating
+// a string for each node.
+func (n Node) AppendContent(dst, src []byte) []byte {
+	return append(dst, n.bytes(src)...)
+}
+
+// AppendType appends node's type to dst and returns the extended buffer.
+func (n Node) AppendType(dst []byte) []byte {
+	return profiled(CgoNode, func() []byte {
+		p := C.ts_node_type(n.c)
+		return append(dst, unsafe.Slice((*byte)(unsafe.Pointer(p)), C.strlen(p))...)
+	})
+}
+
+// AppendString appends the S-expression representing the node to dst and
+// returns the extended buffer.
+func (n Node) AppendString(dst []byte) []byte {
+	return profiled(CgoNode, func() []byte {
+		p := C.ts_node_string(n.c)
+		defer C.free(unsafe.Pointer(p))
+
+		return append(dst, unsafe.Slice((*byte)(unsafe.Pointer(p)), C.strlen(p))...)
+	})
+}
+
+// SexpWithFields returns an S-expression representing the node, in the format
+// used by the upstream CLI (`tree-sitter parse`): one node per line, indented,
+// with field name prefixes, ranges and MISSING annotations, i.e.:
+//
+//	(sum [0, 0] - [0, 3]
+//	  left: (expression [0, 0] - [0, 1]
+//	    (number [0, 0] - [0, 1]))
+//	  right: (expression [0, 3] - [0, 3]
+//	    (MISSING number [0, 3] - [0, 3])))
+func (n Node) SexpWithFields() string {
+	sb := &strings.Builder{}
+	c := NewTreeCursor(n)
+	depth, visited, newline := 0, false, false
+
+	for {
+		node := c.CurrentNode()
+		visible := node.IsNamed() || node.IsMissing()
+
+		if visited {
+			if visible {
+				sb.WriteString(")")
+			}
+
+			if c.GoToNextSibling() {
+				visited = false
+			} else if depth > 0 && c.GoToParent() {
+				depth--
+			} else {
+				break
+			}
+
+			continue
+		}
+
+		if visible {
+			if newline {
+				sb.WriteString("\n")
+			}
+
+			sb.WriteString(strings.Repeat("  ", depth))
+
+			if name := c.CurrentFieldName(); name != "" {
+				sb.WriteString(name + ": ")
+			}
+
+			sb.WriteString("(")
+
+			switch {
+			case node.IsMissing() && node.IsNamed():
+				sb.WriteString("MISSING " + node.Type())
+			case node.IsMissing():
+				fmt.Fprintf(sb, "MISSING %q", node.Type())
+			default:
+				sb.WriteString(node.Type())
+			}
+
+			start, end := node.StartPoint(), node.EndPoint()
+			fmt.Fprintf(sb, " [%d, %d] - [%d, %d]", start.Row, start.Column, end.Row, end.Column)
+
+			newline = true
+		}
+
+		if c.GoToFirstChild() {
+			depth++
+		} else {
+			visited = true
+		}
+	}
+
+	return sb.String()
+}
+
+// bytes returns the node's source code from src, without copying it.
+func (n Node) bytes(src []byte) []byte {
+	return src[n.StartByte():n.EndByte()]
 }
@@ -0,0 +1,113 @@
+package sitter
+
+// #include "sitter.h"
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// NodeCache memoizes the results of [Node.Type] and [Node.String] for the
+// nodes of a tree, for the code paths calling them repeatedly for the same
+// nodes (e.g. logging or rule engines), see [Tree.EnableNodeCache].
+//
+// A nil cache is valid and caches nothing, so that the callers can use
+// [Tree.NodeCache] whether it was enabled or not.
+type NodeCache struct {
+	types   map[nodeKey]string
+	strings map[nodeKey]string
+	max     int
+	mu      sync.Mutex
+}
+
+// nodeKey identifies a node of a tree: its subtree and its alias (the same
+// subtree being of a different type, when aliased).
+type nodeKey struct {
+	id    unsafe.Pointer
+	alias C.uint32_t
+}
+
+// EnableNodeCache enables memoizing the results of [Node.Type] and
+// [Node.String] for the nodes of the tree, returning the (new) cache. At most
+// max results are cached (unlimited if zero or negative), the ones computed
+// once the cache is full not being cached. The cache is cleared when the tree
+// is edited (see [Tree.Edit]).
+func (t *Tree) EnableNodeCache(max int) *NodeCache { //nolint:predeclared // ok
+	t.cache = &NodeCache{types: map[nodeKey]string{}, strings: map[nodeKey]string{}, max: max}
+	return t.cache
+}
+
+// NodeCache returns the tree's node cache, nil if not enabled (see
+// [Tree.EnableNodeCache]).
+func (t *Tree) NodeCache() *NodeCache {
+	return t.cache
+}
+
+// Type returns the node's type (see [Node.Type]), memoized.
+func (c *NodeCache) Type(n Node) string {
+	return c.get(false, n, Node.Type)
+}
+
+// String returns the node's S-expression (see [Node.String]), memoized.
+func (c *NodeCache) String(n Node) string {
+	return c.get(true, n, Node.String)
+}
+
+// Len returns the number of results cached.
+func (c *NodeCache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.types) + len(c.strings)
+}
+
+// Clear empties the cache.
+func (c *NodeCache) Clear() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.types)
+	clear(c.strings)
+}
+
+// get returns the cached result of fn (among the strings or the types) for
+// the node, computing (and caching it, unless full) if needed.
+func (c *NodeCache) get(strings bool, n Node, fn func(Node) string) string {
+	if c == nil || n.IsNull() {
+		return fn(n)
+	}
+
+	key := nodeKey{id: n.c.id, alias: n.c.context[3]}
+
+	c.mu.Lock()
+	m := c.types
+	if strings {
+		m = c.strings
+	}
+
+	s, ok := m[key]
+	c.mu.Unlock()
+
+	if ok {
+		return s
+	}
+
+	s = fn(n)
+
+	c.mu.Lock()
+	if c.max <= 0 || len(c.types)+len(c.strings) < c.max {
+		m[key] = s
+	}
+	c.mu.Unlock()
+
+	return s
+}
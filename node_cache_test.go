@@ -0,0 +1,122 @@
+package sitter
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTreeEnableNodeCache(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + (2)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if c := tree.NodeCache(); c != nil {
+		t.Fatal("Expected no cache, got", c)
+	}
+
+	c := tree.EnableNodeCache(0)
+	if tree.NodeCache() != c {
+		t.Fatal("Expected the enabled cache")
+	}
+
+	root := tree.RootNode()
+	sum := root.Child(0)
+
+	for range 2 {
+		if act, exp := c.Type(sum), "sum"; act != exp {
+			t.Fatalf("Expected %q, got %q", exp, act)
+		}
+
+		if act, exp := c.String(root), root.String(); act != exp {
+			t.Fatalf("Expected %q, got %q", exp, act)
+		}
+	}
+
+	if act := c.Len(); act != 2 {
+		t.Fatal("Expected 2 results cached, got", act)
+	}
+
+	tree.Edit(InputEdit{OldEndIndex: 1, NewEndIndex: 1, OldEndPoint: Point{Column: 1}, NewEndPoint: Point{Column: 1}})
+
+	if act := c.Len(); act != 0 {
+		t.Fatal("Expected the cache to be cleared on edit, got", act)
+	}
+}
+
+func TestTreeNodeCache(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeCacheType(t *testing.T) {
+	t.Parallel()
+
+	var c *NodeCache
+
+	root, err := Parse(context.Background(), []byte("1"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act, exp := c.Type(root), root.Type(); act != exp {
+		t.Fatalf("Expected %q from a nil cache, got %q", exp, act)
+	}
+
+	if act := c.Len(); act != 0 {
+		t.Fatal("Expected nothing cached, got", act)
+	}
+}
+
+func TestNodeCacheString(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeCacheLen(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeCacheClear(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeCacheGet(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := tree.EnableNodeCache(2)
+	root := tree.RootNode()
+
+	for n := range root.Child(0).Children() {
+		c.Type(n)
+	}
+
+	if act := c.Len(); act != 2 {
+		t.Fatal("Expected the cache to be bounded to 2 results, got", act)
+	}
+
+	if act, exp := c.String(root), root.String(); act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	c.Clear()
+
+	if act := c.Len(); act != 0 {
+		t.Fatal("Expected an empty cache, got", act)
+	}
+}
@@ -2,8 +2,11 @@ package sitter
 
 import (
 	"context"
+	"fmt"
+	"iter"
 	"reflect"
 	"testing"
+	"unsafe"
 )
 
 type (
@@ -144,6 +147,37 @@ func TestNodeIsError(t *testing.T) {
 	t.Skip("tested implicitly")
 }
 
+func TestNodeErrorFraction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		src string
+		exp float64
+	}{
+		{"1 + 2", 0},
+		{"1 + + 2", 1.0 / 4},
+		{"1 + (2 * 3)", 2.0 / 7},
+		{"@@@", 1},
+		{"", 0},
+	}
+
+	for _, tc := range testCases {
+		root, err := Parse(context.Background(), []byte(tc.src), gr)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if act := root.ErrorFraction([]byte(tc.src)); act != tc.exp {
+			t.Fatalf("Expected %v for %q, got %v", tc.exp, tc.src, act)
+		}
+	}
+}
+
+func TestNonSpaceCount(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
 func TestNodeParseState(t *testing.T) {
 	t.Parallel()
 	testParserSequence(t, "1 + 2", seqTestCases[StateID]{
@@ -283,9 +317,47 @@ func TestNodeFieldNameForChild(t *testing.T) {
 	t.Skip("tested implicitly")
 }
 
+func TestNodeFieldIDFor(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum := root.Child(0)
+	for i, exp := range []FieldID{1, 0, 2, 0} {
+		if act := sum.FieldIDFor(i); act != exp {
+			t.Fatalf("Expected field id %d for child #%d, got %d", exp, i, act)
+		}
+	}
+
+	if act := root.FieldIDFor(1); act != 0 {
+		t.Fatal("Expected no field id for the comment, got", act)
+	}
+}
+
 func TestNodeFieldNameForNamedChild(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum := root.Child(0)
+
+	for i, exp := range []string{"left", "right"} {
+		if act := sum.FieldNameForNamedChild(uint32(i)); act != exp { //nolint:gosec // ok
+			t.Fatalf("Expected %q for named child #%d, got %q", exp, i, act)
+		}
+	}
+
+	if act := root.FieldNameForNamedChild(1); act != "" {
+		t.Fatal("Expected no field name for the comment, got", act)
+	}
 }
 
 func TestNodeChildCount(t *testing.T) {
@@ -310,7 +382,115 @@ func TestNodeChildByFieldName(t *testing.T) {
 
 func TestNodeChildByFieldID(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum := root.Child(0)
+
+	if act := sum.ChildByFieldID(gr.FieldID("right")).Content(input); act != "2" {
+		t.Fatal("Expected the right operand, got", act)
+	}
+
+	if act := root.ChildByFieldID(gr.FieldID("left")); !act.IsNull() {
+		t.Fatal("Expected no child, got", act)
+	}
+}
+
+func TestNodeChildren(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum := root.Child(0)
+
+	testCases := []struct {
+		seq iter.Seq[Node]
+		exp []string
+	}{
+		{sum.Children(), []string{"expression", "+", "expression"}},
+		{sum.NamedChildren(), []string{"expression", "expression"}},
+		{root.NamedChildren(), []string{"sum", "comment"}},
+		{sum.ChildrenByFieldName("left"), []string{"expression"}},
+		{sum.ChildrenByFieldName("nope"), nil},
+		{Node{}.Children(), nil},
+		{Node{}.ChildrenByFieldName("left"), nil},
+	}
+
+	for i, tc := range testCases {
+		var act []string
+
+		for n := range tc.seq {
+			act = append(act, n.Type())
+		}
+
+		if !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %q for #%d, got %q", tc.exp, i, act)
+		}
+	}
+
+	for n := range sum.Children() {
+		if n.Type() != "expression" || n.Content([]byte("1 + 2 // c")) != "1" {
+			t.Fatal("Unexpected first child", n)
+		}
+
+		break
+	}
+}
+
+func TestNodeNamedChildren(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeChildrenByFieldName(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeWalk(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + (2) // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	var act []string
+
+	for n, depth := range root.Child(0).Walk() {
+		act = append(act, fmt.Sprintf("%d:%s", depth, n.Type()))
+	}
+
+	exp := []string{
+		"0:sum", "1:expression", "2:number", "1:+", "1:expression", "2:(", "2:expression", "3:number", "2:)",
+	}
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	act = nil
+
+	for n, depth := range root.Walk() {
+		if act = append(act, fmt.Sprintf("%d:%s", depth, n.Type())); len(act) == 3 {
+			break
+		}
+	}
+
+	if exp = []string{"0:expression", "1:sum", "2:expression"}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	for range (Node{}).Walk() {
+		t.Fatal("Expected no nodes")
+	}
 }
 
 func TestNodeNextSibling(t *testing.T) {
@@ -335,12 +515,32 @@ func TestNodePrevNamedSibling(t *testing.T) {
 
 func TestNodeFirstChildForByte(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := root.Child(0).FirstChildForByte(2); act.Type() != "+" {
+		t.Fatal("Expected the operator, got", act)
+	}
 }
 
 func TestNodeFirstNamedChildForByte(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := root.Child(0).FirstNamedChildForByte(2).Content(input); act != "2" {
+		t.Fatal("Expected the right operand, got", act)
+	}
 }
 
 func TestNodeDescendantCount(t *testing.T) {
@@ -357,17 +557,60 @@ func TestNodeDescendantCount(t *testing.T) {
 
 func TestNodeDescendantForByteRange(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		start, end uint32
+		exp        string
+	}{
+		{4, 5, "number"},
+		{2, 3, "+"},
+		{0, 5, "sum"},
+		{0, 10, "expression"},
+	}
+
+	for _, tc := range testCases {
+		if act := root.DescendantForByteRange(tc.start, tc.end).Type(); act != tc.exp {
+			t.Fatalf("Expected %q for [%d, %d], got %q", tc.exp, tc.start, tc.end, act)
+		}
+	}
 }
 
 func TestNodeDescendantForPointRange(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := root.DescendantForPointRange(Point{Column: 4}, Point{Column: 5}).Type(); act != "number" {
+		t.Fatal("Expected number, got", act)
+	}
 }
 
 func TestNodeNamedDescendantForByteRange(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// The operator is anonymous.
+	if act := root.NamedDescendantForByteRange(2, 3).Type(); act != "sum" {
+		t.Fatal("Expected sum, got", act)
+	}
 }
 
 func TestNodeNamedDescendantForPointRange(t *testing.T) {
@@ -377,12 +620,85 @@ func TestNodeNamedDescendantForPointRange(t *testing.T) {
 
 func TestNodeEdit(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// Prepend "10 + ".
+	n := root.Child(0).Child(2)
+	n.Edit(InputEdit{NewEndIndex: 5, NewEndPoint: Point{Column: 5}})
+
+	if act := n.StartByte(); act != 9 {
+		t.Fatal("Expected 9, got", act)
+	}
+
+	if act := root.Child(0).Child(2).StartByte(); act != 4 {
+		t.Fatal("Expected the tree to be left as it was, got", act)
+	}
 }
 
 func TestNodeEqual(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum := root.Child(0)
+
+	if !sum.Equal(root.Child(0)) || sum.Equal(root) || sum.Child(0).Equal(sum.Child(2)) {
+		t.Fatal("Expected only the same nodes to be equal")
+	}
+}
+
+func TestNodeID(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum := root.NamedChild(0)
+	left, right := sum.ChildByFieldName("left"), sum.ChildByFieldName("right")
+
+	if left.ID() == right.ID() || sum.ID() == left.ID() || left.ID() == 0 {
+		t.Fatalf("Expected distinct identifiers, got %d, %d and %d", sum.ID(), left.ID(), right.ID())
+	}
+
+	if act := right.PrevSibling().PrevSibling().ID(); act != left.ID() {
+		t.Fatalf("Expected %d, got %d", left.ID(), act)
+	}
+}
+
+func TestNodeKey(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	root, other := tree.RootNode(), tree.Copy().RootNode()
+	keys := map[NodeKey]string{root.Key(): "root"}
+
+	if act := keys[tree.RootNode().Key()]; act != "root" {
+		t.Fatalf("Expected %q, got %q", "root", act)
+	}
+
+	if other.Key() == root.Key() {
+		t.Fatalf("Expected different keys, got %v and %v", root.Key(), other.Key())
+	}
 }
 
 func TestNodeRange(t *testing.T) {
@@ -409,6 +725,131 @@ func TestNodeContent(t *testing.T) {
 	})
 }
 
+func TestNodeIsType(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum := gr.MustSymbol("sum")
+	if !root.Child(0).IsType(sum) || root.IsType(sum) {
+		t.Fatal("Expected only the child to be a sum")
+	}
+}
+
+func TestNodeContentView(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + 2")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum := root.Child(0)
+	if act := sum.ContentView(input); act != "1 + 2" {
+		t.Fatalf("Expected %q, got %q", "1 + 2", act)
+	}
+
+	if act := sum.Child(2).ContentView(input); act != "2" {
+		t.Fatalf("Expected %q, got %q", "2", act)
+	}
+
+	if act := sum.ContentView(input); unsafe.StringData(act) != &input[0] {
+		t.Fatal("Expected content view to share memory with the source")
+	}
+}
+
+func TestNodeAppendContent(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + 2")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	buf := make([]byte, 0, 16)
+	buf = root.AppendContent(buf, input)
+	buf = append(buf, '|')
+	buf = root.Child(0).Child(0).AppendContent(buf, input)
+
+	if exp, act := "1 + 2|1", string(buf); act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestNodeAppendType(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if exp, act := root.Type(), string(root.AppendType([]byte("type:"))); act != "type:"+exp {
+		t.Fatalf("Expected %q, got %q", "type:"+exp, act)
+	}
+}
+
+func TestNodeAppendString(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if exp, act := root.String(), string(root.AppendString(nil)); act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestNodeSexpWithFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input, exp string
+	}{
+		{"1 +", `(expression [0, 0] - [0, 3]
+  (sum [0, 0] - [0, 3]
+    left: (expression [0, 0] - [0, 1]
+      (number [0, 0] - [0, 1]))
+    right: (expression [0, 3] - [0, 3]
+      (MISSING number [0, 3] - [0, 3]))))`},
+		{"1 2 // c", `(expression [0, 0] - [0, 8]
+  (ERROR [0, 0] - [0, 1]
+    (expression [0, 0] - [0, 1]
+      (number [0, 0] - [0, 1])))
+  (number [0, 2] - [0, 3])
+  (comment [0, 4] - [0, 8]))`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			t.Parallel()
+
+			root, err := Parse(context.Background(), []byte(tc.input), gr)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			if act := root.SexpWithFields(); act != tc.exp {
+				t.Fatalf("Expected\n%s\ngot\n%s", tc.exp, act)
+			}
+		})
+	}
+}
+
+func TestNodeBytes(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
 func testParserSequence[T any](t *testing.T, source string, testCases seqTestCases[T]) { //nolint:cyclop // ok
 	t.Helper()
 
@@ -0,0 +1,121 @@
+package sitter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// NodeTypes is the schema of a language's syntax trees, as described by the
+// node-types.json file tree-sitter generates along with the grammar, its node
+// types being linked to the language's symbols, see [NewNodeTypes].
+type NodeTypes struct {
+	bySymbol map[Symbol]*NodeTypeInfo
+	byName   map[NodeTypeRef]*NodeTypeInfo
+	types    []NodeTypeInfo
+}
+
+// NodeTypeInfo describes a node type.
+type NodeTypeInfo struct {
+	// Fields are the types of the children of each field.
+	Fields map[string]ChildTypes `json:"fields"`
+	// Children are the types of the named children not in any field, if any.
+	Children *ChildTypes `json:"children"`
+	NodeTypeRef
+	// Subtypes are the types a supertype stands for, see [Language.Supertypes].
+	Subtypes []NodeTypeRef `json:"subtypes"`
+	// Root tells whether the type is that of the root nodes, and Extra whether
+	// its nodes can appear anywhere (e.g. comments). Only the recent versions
+	// of tree-sitter set them.
+	Root  bool `json:"root"`
+	Extra bool `json:"extra"`
+}
+
+// NodeTypeRef is a reference to a node type, by its name and namedness, along
+// with its symbol (linked by [NewNodeTypes]).
+type NodeTypeRef struct {
+	Type   string `json:"type"`
+	Named  bool   `json:"named"`
+	Symbol Symbol `json:"-"`
+}
+
+// ChildTypes describes the children of a node type (of a field, or not in any).
+type ChildTypes struct {
+	Types    []NodeTypeRef `json:"types"`
+	Multiple bool          `json:"multiple"`
+	Required bool          `json:"required"`
+}
+
+// ErrUnknownNodeType is returned by [NewNodeTypes] for the node types unknown
+// to the language, e.g. as the node-types.json file is that of another version
+// of the grammar.
+var ErrUnknownNodeType = errors.New("unknown node type")
+
+// NewNodeTypes parses the contents of the language's node-types.json file,
+// linking its node types to the language's symbols.
+func NewNodeTypes(lang *Language, data []byte) (*NodeTypes, error) {
+	nt := &NodeTypes{bySymbol: map[Symbol]*NodeTypeInfo{}, byName: map[NodeTypeRef]*NodeTypeInfo{}}
+
+	if err := json.Unmarshal(data, &nt.types); err != nil {
+		return nil, fmt.Errorf("cannot parse node types: %w", err)
+	}
+
+	link := func(ref *NodeTypeRef) (err error) {
+		if ref.Symbol = lang.SymbolID(ref.Type, ref.Named); ref.Symbol == 0 {
+			err = fmt.Errorf("%w: %q (named: %v)", ErrUnknownNodeType, ref.Type, ref.Named)
+		}
+
+		return
+	}
+
+	linkAll := func(refs []NodeTypeRef) error {
+		for i := range refs {
+			if err := link(&refs[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for i := range nt.types {
+		t := &nt.types[i]
+
+		errs := []error{link(&t.NodeTypeRef), linkAll(t.Subtypes)}
+		for _, c := range t.Fields {
+			errs = append(errs, linkAll(c.Types))
+		}
+
+		if t.Children != nil {
+			errs = append(errs, linkAll(t.Children.Types))
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return nil, err
+		}
+
+		nt.bySymbol[t.Symbol] = t
+		nt.byName[NodeTypeRef{Type: t.Type, Named: t.Named}] = t
+	}
+
+	return nt, nil
+}
+
+// Types returns all the node types, in the order node-types.json lists them.
+// The returned slice must not be modified.
+func (nt *NodeTypes) Types() []NodeTypeInfo {
+	return nt.types
+}
+
+// Lookup returns the node type of the given name and namedness, if any.
+func (nt *NodeTypes) Lookup(name string, named bool) (*NodeTypeInfo, bool) {
+	t, ok := nt.byName[NodeTypeRef{Type: name, Named: named}]
+	return t, ok
+}
+
+// ForSymbol returns the node type of the given symbol (e.g. that of a node,
+// see [Node.Symbol]), if any.
+func (nt *NodeTypes) ForSymbol(s Symbol) (*NodeTypeInfo, bool) {
+	t, ok := nt.bySymbol[s]
+	return t, ok
+}
@@ -0,0 +1,90 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestNewNodeTypes(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/node-types.json")
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	nt, err := NewNodeTypes(gr, data)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum, ok := nt.Lookup("sum", true)
+	if !ok || sum.Symbol != gr.MustSymbol("sum") || len(sum.Fields) != 2 || sum.Children != nil {
+		t.Fatalf("Expected the sum type, got %+v", sum)
+	}
+
+	if left := sum.Fields["left"]; !left.Required || left.Multiple || left.Types[0].Symbol != gr.MustSymbol("expression") {
+		t.Fatalf("Expected a required expression on the left, got %+v", left)
+	}
+
+	if comment, _ := nt.Lookup("comment", true); !comment.Extra || comment.Root {
+		t.Fatalf("Expected an extra comment type, got %+v", comment)
+	}
+
+	if len(nt.Types()) != 8 {
+		t.Fatalf("Expected 8 types, got %d", len(nt.Types()))
+	}
+
+	testCases := []struct {
+		data string
+		err  error
+	}{
+		{`[{"type": "nope", "named": true}]`, ErrUnknownNodeType},
+		{`[{"type": "sum", "named": false}]`, ErrUnknownNodeType},
+		{`[{"type": "sum", "named": true, "subtypes": [{"type": "nope", "named": true}]}]`, ErrUnknownNodeType},
+		{`[{"type": "sum", "named": true, "fields": {"x": {"types": [{"type": "+", "named": true}]}}}]`, ErrUnknownNodeType},
+		{`{}`, nil},
+	}
+
+	for _, tc := range testCases {
+		_, err = NewNodeTypes(gr, []byte(tc.data))
+		if tc.err == nil && err == nil || tc.err != nil && !errors.Is(err, tc.err) {
+			t.Fatalf("Expected %v for %s, got %v", tc.err, tc.data, err)
+		}
+	}
+}
+
+func TestNodeTypesTypes(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeTypesLookup(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeTypesForSymbol(t *testing.T) {
+	t.Parallel()
+
+	nt, err := NewNodeTypes(gr, []byte(`[{"type": "number", "named": true}, {"type": "+", "named": false}]`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	sum := root.NamedChild(0)
+	if typ, ok := nt.ForSymbol(sum.Child(1).Symbol()); !ok || typ.Type != "+" || typ.Named {
+		t.Fatalf("Expected the + type, got %+v", typ)
+	}
+
+	if typ, ok := nt.ForSymbol(sum.Symbol()); ok {
+		t.Fatalf("Expected no sum type, got %+v", typ)
+	}
+}
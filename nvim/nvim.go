@@ -0,0 +1,224 @@
+// Package nvim makes the query files written for Neovim (e.g. the ones of
+// nvim-treesitter) usable with this package, unmodified:
+//
+//   - [Transpile] rewrites the predicates using Lua patterns (#lua-match? and
+//     its variants) or Vim regular expressions (#match?, #vim-match? and their
+//     variants) into #match? predicates using the equivalent Go regular
+//     expressions;
+//   - [Predicators] implements the predicates checking the captured nodes
+//     (#has-parent?, #has-ancestor?, #kind-eq? and #contains?, along with
+//     their variants);
+//   - [NewQuery] does both.
+//
+// The "; inherits:" comments are left alone, see the queries package for
+// resolving them, and so are the directives (#set!, #offset!, etc.), which
+// end up as the query's general predicates, if not registered.
+package nvim
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// ErrUnsupported is returned for the patterns using features that have no Go
+// regular expression equivalent (e.g. Lua's %b or Vim's look-arounds).
+var ErrUnsupported = errors.New("unsupported pattern")
+
+// NewQuery transpiles the query source (see [Transpile]) and compiles it with
+// the [Predicators] registered, before the given options.
+func NewQuery(lang *sitter.Language, src []byte, opts ...sitter.QueryOption) (*sitter.Query, error) {
+	src, err := Transpile(src)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append([]sitter.QueryOption{sitter.WithContextPredicators(Predicators())}, opts...)
+
+	return sitter.NewQuery(lang, src, opts...)
+}
+
+// Transpile rewrites the predicates matching patterns of the query source,
+// from Lua patterns or Vim regular expressions to Go regular expressions,
+// leaving the rest of the source as is.
+func Transpile(src []byte) ([]byte, error) {
+	var out bytes.Buffer
+
+	for i := 0; i < len(src); {
+		switch src[i] {
+		case ';':
+			j := bytes.IndexByte(src[i:], '\n')
+			if j < 0 {
+				j = len(src) - i
+			}
+
+			out.Write(src[i : i+j])
+			i += j
+		case '"':
+			j := stringEnd(src, i)
+			out.Write(src[i:j])
+			i = j
+		case '#':
+			j, err := predicate(&out, src, i)
+			if err != nil {
+				return nil, err
+			}
+
+			i = j
+		default:
+			out.WriteByte(src[i])
+			i++
+		}
+	}
+
+	return out.Bytes(), nil
+}
+
+// predicate writes the predicate starting (with its name) at offset i,
+// rewritten if needed, returning the offset it ends at (before the closing
+// parenthesis).
+func predicate(out *bytes.Buffer, src []byte, i int) (int, error) {
+	j := i + 1
+	for j < len(src) && !isDelimiter(src[j]) {
+		j++
+	}
+
+	name, convert := rename(string(src[i+1 : j]))
+	if convert == nil {
+		out.Write(src[i:j])
+		return j, nil
+	}
+
+	out.WriteString("#" + name)
+
+	for j < len(src) && src[j] != ')' {
+		k := j + 1
+
+		switch c := src[j]; {
+		case c == ';':
+			if k = bytes.IndexByte(src[j:], '\n'); k < 0 {
+				k = len(src)
+			} else {
+				k += j
+			}
+
+			out.Write(src[j:k])
+		case c == '@':
+			for k < len(src) && !isDelimiter(src[k]) {
+				k++
+			}
+
+			out.Write(src[j:k])
+		case isDelimiter(c) && c != '"':
+			out.WriteByte(c)
+		default:
+			var pattern []byte
+
+			if c == '"' {
+				if k = stringEnd(src, j); src[k-1] != '"' || k == j+1 {
+					out.Write(src[j:]) // Unterminated, left for the query parser to report.
+					return len(src), nil
+				}
+
+				pattern = unquote(src[j+1 : k-1])
+			} else {
+				for k < len(src) && !isDelimiter(src[k]) {
+					k++
+				}
+
+				pattern = src[j:k]
+			}
+
+			re, err := convert(string(pattern))
+			if err != nil {
+				row := bytes.Count(src[:j], []byte("\n"))
+				col := j - bytes.LastIndexByte(src[:j], '\n')
+
+				return 0, fmt.Errorf("%w at %d:%d", err, row+1, col)
+			}
+
+			out.WriteString(quote(re))
+		}
+
+		j = k
+	}
+
+	return j, nil
+}
+
+// rename returns the name the predicate is rewritten to, along with the
+// function converting its patterns, or nil if it is not to be rewritten.
+func rename(name string) (string, func(string) (string, error)) {
+	base, prefix := name, ""
+
+	for _, p := range []string{"any-", "not-"} {
+		if rest, ok := strings.CutPrefix(base, p); ok {
+			base, prefix = rest, prefix+p
+		}
+	}
+
+	switch base {
+	case "lua-match?":
+		return prefix + "match?", luaPattern
+	case "vim-match?", "match?":
+		return prefix + "match?", vimPattern
+	}
+
+	return name, nil
+}
+
+// stringEnd returns the offset just past the end of the string literal
+// starting at offset i (or the end of src, if it is not terminated).
+func stringEnd(src []byte, i int) int {
+	for j := i + 1; j < len(src); j++ {
+		switch src[j] {
+		case '\\':
+			j++
+		case '"':
+			return j + 1
+		}
+	}
+
+	return len(src)
+}
+
+// unquote decodes the content of a query string literal.
+func unquote(s []byte) []byte {
+	var out []byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) {
+			i++
+
+			switch c = s[i]; c {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case '0':
+				c = 0
+			}
+		}
+
+		out = append(out, c)
+	}
+
+	return out
+}
+
+// quote encodes s as a query string literal.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "\x00", `\0`).
+		Replace(s) + `"`
+}
+
+// isDelimiter reports whether c ends a predicate name, capture or identifier.
+func isDelimiter(c byte) bool {
+	return strings.IndexByte(" \t\n\r()\";", c) >= 0
+}
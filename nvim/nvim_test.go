@@ -0,0 +1,148 @@
+package nvim
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestNewQuery(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + (2) + 34")
+
+	root, err := sitter.Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		pattern string
+		exp     []string
+	}{
+		{`((expression (number)) @e (#has-parent? @e "sum"))`, []string{"1", "34"}},
+		{`((expression (number)) @e (#not-has-parent? @e sum))`, []string{"2"}},
+		{`((number) @n (#has-ancestor? @n "foo" "sum"))`, []string{"1", "2", "34"}},
+		{`((expression) @e (#kind-eq? @e "number" "expression") (#lua-match? @e "^%(%d+%)$"))`, []string{"(2)"}},
+		{`((number) @n (#lua-match? @n "^%d%d$"))`, []string{"34"}},
+		{`((number) @n (#not-kind-eq? @n "number"))`, nil},
+		{`((sum) @s (#contains? @s "(2)"))`, []string{"1 + (2) + 34", "1 + (2)"}},
+		{`((sum (expression (number) @n)+) (#any-contains? @n "4"))`, []string{"34"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			t.Parallel()
+
+			q, err := NewQuery(gr, []byte(tc.pattern))
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			var act []string
+
+			matches := sitter.NewQueryCursor().Matches(q, root, input)
+			for m := range matches.All() {
+				for _, c := range m.Captures {
+					act = append(act, c.Node.Content(input))
+				}
+			}
+
+			if !reflect.DeepEqual(act, tc.exp) {
+				t.Fatalf("Expected %q, got %q", tc.exp, act)
+			}
+		})
+	}
+
+	for _, pattern := range []string{
+		`((number) @n (#has-parent? @n))`,
+		`((number) @n (#kind-eq? "number" @n))`,
+		`((number) @n (#kind-eq? @n @n))`,
+	} {
+		if _, err := NewQuery(gr, []byte(pattern)); !errors.Is(err, sitter.ErrPredicateBase) {
+			t.Fatalf("Expected a predicate error for %s, got %v", pattern, err)
+		}
+	}
+
+	_, err = NewQuery(gr, []byte(`((number) @n (#lua-match? @n "%b()"))`))
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatal("Expected an unsupported pattern error, got", err)
+	}
+}
+
+func TestTranspile(t *testing.T) {
+	t.Parallel()
+
+	//nolint:lll // ok
+	testCases := []struct {
+		src, exp string
+		err      error
+	}{
+		{`((identifier) @x (#eq? @x "a"))`, `((identifier) @x (#eq? @x "a"))`, nil},
+		{`((identifier) @x (#lua-match? @x "^%u[%u%d_]*$"))`, `((identifier) @x (#match? @x "^[[:upper:]][[:upper:][:digit:]_]*$"))`, nil},
+		{`((identifier) @x (#not-lua-match? @x "^_"))`, `((identifier) @x (#not-match? @x "^_"))`, nil},
+		{`((identifier) @x (#any-lua-match? @x "%."))`, `((identifier) @x (#any-match? @x "\\."))`, nil},
+		{`((identifier) @x (#vim-match? @x "^\\(foo\\|bar\\)$"))`, `((identifier) @x (#match? @x "^(foo|bar)$"))`, nil},
+		{`((identifier) @x (#match? @x "^[A-Z]+$"))`, `((identifier) @x (#match? @x "^[A-Z]\\+$"))`, nil},
+		{`((identifier) @x (#match? @x ^[a-z]$))`, `((identifier) @x (#match? @x "^[a-z]$"))`, nil},
+		{"; (#lua-match? @x \"%.\")\n(x) @x \"#lua-match?\"", "; (#lua-match? @x \"%.\")\n(x) @x \"#lua-match?\"", nil},
+		{"((x) @x (#lua-match? @x ; \"%a\"\n \"%a\"))", "((x) @x (#match? @x ; \"%a\"\n \"[[:alpha:]]\"))", nil},
+		{`((x) @x (#set! "priority" 105) (#offset! @x 0 1 0 -1))`, `((x) @x (#set! "priority" 105) (#offset! @x 0 1 0 -1))`, nil},
+		{`((x) @x (#lua-match? @x "`, `((x) @x (#match? @x "`, nil},
+		{"(x)\n((x) @x (#lua-match? @x \"%b()\"))", "", ErrUnsupported},
+	}
+
+	for _, tc := range testCases {
+		act, err := Transpile([]byte(tc.src))
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("Expected %v for %s, got %v", tc.err, tc.src, err)
+		}
+
+		if string(act) != tc.exp {
+			t.Fatalf("Expected\n%s\ngot\n%s", tc.exp, act)
+		}
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRename(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestStringEnd(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestUnquote(t *testing.T) {
+	t.Parallel()
+
+	if act := string(unquote([]byte(`a\"b\\c\nd\te\0f\x`))); act != "a\"b\\c\nd\te\x00fx" {
+		t.Fatalf("Unexpected %q", act)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+
+	if act := quote("a\"b\\c\nd\te\x00f"); act != `"a\"b\\c\nd\te\0f"` {
+		t.Fatalf("Unexpected %s", act)
+	}
+}
+
+func TestIsDelimiter(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
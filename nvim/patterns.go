@@ -0,0 +1,324 @@
+package nvim
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// classes maps the Lua (%a, etc.) and Vim (\a, etc.) character classes to the
+// POSIX ones, their uppercase forms being the complements.
+var classes = map[byte]string{ //nolint:gochecknoglobals // ok
+	'a': "alpha", 'c': "cntrl", 'd': "digit", 'g': "graph", 'l': "lower",
+	'p': "punct", 's': "space", 'u': "upper", 'w': "alnum", 'x': "xdigit",
+}
+
+// luaPattern converts a Lua pattern to a Go regular expression.
+func luaPattern(p string) (string, error) {
+	var b strings.Builder
+
+	// item tells whether the previous element can be repeated, as quantifiers
+	// are literals otherwise.
+	item := false
+
+	for i := 0; i < len(p); i++ {
+		c, quantifiable := p[i], true
+
+		switch {
+		case c == '%':
+			if i++; i == len(p) {
+				return "", fmt.Errorf("%w: Lua pattern %q ends with %%", ErrUnsupported, p)
+			}
+
+			if d := p[i]; d == 'b' || d == 'f' || d >= '0' && d <= '9' {
+				return "", fmt.Errorf("%w: %%%c in Lua pattern %q", ErrUnsupported, d, p)
+			}
+
+			b.WriteString(luaClass(p[i], false))
+		case c == '[':
+			j := luaSetEnd(p, i)
+			if j < 0 {
+				return "", fmt.Errorf("%w: unterminated set in Lua pattern %q", ErrUnsupported, p)
+			}
+
+			b.WriteString(luaSet(p[i+1 : j]))
+			i = j
+		case c == '-' && item:
+			b.WriteString("*?")
+			quantifiable = false
+		case (c == '*' || c == '+' || c == '?') && item:
+			b.WriteByte(c)
+			quantifiable = false
+		case c == '^' && i == 0, c == '$' && i == len(p)-1, c == '(', c == ')':
+			b.WriteByte(c)
+			quantifiable = false
+		case c == '.':
+			b.WriteString("(?s:.)")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+
+		item = quantifiable
+	}
+
+	return b.String(), nil
+}
+
+// luaClass converts the Lua character class (or escaped character) %c,
+// inside a set or not.
+func luaClass(c byte, inSet bool) string {
+	name, ok := classes[c|0x20]
+	if !ok {
+		if inSet {
+			return setLiteral(c)
+		}
+
+		return regexp.QuoteMeta(string(c))
+	}
+
+	if c < 'a' {
+		name = "^" + name
+	}
+
+	if inSet {
+		return "[:" + name + ":]"
+	}
+
+	return "[[:" + name + ":]]"
+}
+
+// luaSetEnd returns the offset of the "]" closing the set starting at offset
+// i, or -1.
+func luaSetEnd(p string, i int) int {
+	j := i + 1
+	if j < len(p) && p[j] == '^' {
+		j++
+	}
+
+	if j < len(p) && p[j] == ']' {
+		j++
+	}
+
+	for ; j < len(p); j++ {
+		switch p[j] {
+		case '%':
+			j++
+		case ']':
+			return j
+		}
+	}
+
+	return -1
+}
+
+// luaSet converts the content of a Lua set (without the brackets).
+func luaSet(s string) string {
+	var b strings.Builder
+
+	b.WriteByte('[')
+
+	if strings.HasPrefix(s, "^") {
+		b.WriteByte('^')
+		s = s[1:]
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '%' && i+1 < len(s):
+			i++
+			b.WriteString(luaClass(s[i], true))
+		case i+2 < len(s) && s[i+1] == '-':
+			b.WriteString(setLiteral(c) + "-" + setLiteral(s[i+2]))
+			i += 2
+		default:
+			b.WriteString(setLiteral(c))
+		}
+	}
+
+	b.WriteByte(']')
+
+	return b.String()
+}
+
+// setLiteral returns the character c, escaped for a Go regular expression set.
+func setLiteral(c byte) string {
+	if strings.IndexByte(`\[]^-`, c) >= 0 {
+		return `\` + string(c)
+	}
+
+	return string(c)
+}
+
+// vimPattern converts a Vim regular expression ("magic" by default, or "very
+// magic" after \v) to a Go regular expression.
+func vimPattern(p string) (string, error) {
+	var b strings.Builder
+
+	veryMagic, flags := false, ""
+
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+
+		switch {
+		case c == '[':
+			if j := vimSetEnd(p, i); j > 0 {
+				b.WriteString(strings.ReplaceAll(p[i:j+1], `\e`, `\x1b`))
+				i = j
+
+				continue
+			}
+
+			b.WriteString(`\[`)
+		case c == '\\' && i+1 < len(p):
+			i++
+
+			s, err := vimEscape(p, &i, &veryMagic, &flags)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(s)
+		case c == '.' || c == '*' || c == '^' || c == '$':
+			b.WriteByte(c)
+		case !veryMagic:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		case c == '{':
+			s, err := vimBrace(p, &i)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(s)
+		case c == '%' && i+1 < len(p) && p[i+1] == '(':
+			b.WriteString("(?:")
+			i++
+		case c == '<' || c == '>':
+			b.WriteString(`\b`)
+		case c == '=':
+			b.WriteByte('?')
+		case c == '@' || c == '%' || c == '~':
+			return "", fmt.Errorf("%w: %c in Vim pattern %q", ErrUnsupported, c, p)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	if flags != "" {
+		return "(?" + flags + ")" + b.String(), nil
+	}
+
+	return b.String(), nil
+}
+
+// vimEscape converts the escape sequence at offset *i (just past the
+// backslash), moving *i to its last character and updating the mode and the
+// flags, as needed.
+func vimEscape(p string, i *int, veryMagic *bool, flags *string) (string, error) {
+	c := p[*i]
+
+	switch {
+	case c == 'v' || c == 'm' || c == 'M' || c == 'V':
+		*veryMagic = c == 'v'
+		if c == 'M' || c == 'V' {
+			return "", fmt.Errorf("%w: \\%c in Vim pattern %q", ErrUnsupported, c, p)
+		}
+
+		return "", nil
+	case c == 'c':
+		*flags = "i"
+		return "", nil
+	case c == 'C':
+		return "", nil
+	case c == 'n':
+		return `\n`, nil
+	case c == 't':
+		return `\t`, nil
+	case c == 'e':
+		return `\x1b`, nil
+	case c == 's' || c == 'S' || c == 'd' || c == 'D' || c == 'w' || c == 'W':
+		return `\` + string(c), nil
+	case c == 'h':
+		return `[A-Za-z_]`, nil
+	case c == 'H':
+		return `[^A-Za-z_]`, nil
+	case classes[c|0x20] != "" && c != 'g' && c != 'G' && c != 'p' && c != 'P':
+		return luaClass(c, false), nil
+	case *veryMagic:
+	case c == '(' || c == ')' || c == '|' || c == '+' || c == '?':
+		return string(c), nil
+	case c == '=':
+		return "?", nil
+	case c == '<' || c == '>':
+		return `\b`, nil
+	case c == '{':
+		return vimBrace(p, i)
+	case c == '%' && *i+1 < len(p) && p[*i+1] == '(':
+		*i++
+		return "(?:", nil
+	}
+
+	if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || !*veryMagic && (c == '@' || c == '%') {
+		return "", fmt.Errorf("%w: \\%c in Vim pattern %q", ErrUnsupported, c, p)
+	}
+
+	return regexp.QuoteMeta(string(c)), nil
+}
+
+// vimBrace converts the {n,m} multi whose "{" is at offset *i, moving *i to
+// its closing "}".
+func vimBrace(p string, i *int) (string, error) {
+	j := strings.IndexByte(p[*i:], '}')
+	if j < 0 {
+		return "", fmt.Errorf("%w: unterminated {} in Vim pattern %q", ErrUnsupported, p)
+	}
+
+	body := strings.TrimSuffix(p[*i+1:*i+j], `\`)
+	*i += j
+
+	body, lazy := strings.CutPrefix(body, "-")
+	if lazy {
+		return multi(body) + "?", nil
+	}
+
+	return multi(body), nil
+}
+
+// multi converts the body of a {n,m} multi, but for the laziness.
+func multi(body string) string {
+	switch {
+	case body == "" || body == ",":
+		return "*"
+	case strings.HasPrefix(body, ","):
+		return "{0" + body + "}"
+	}
+
+	return "{" + body + "}"
+}
+
+// vimSetEnd returns the offset of the "]" closing the set starting at offset
+// i, or -1 (the "[" being a literal then).
+func vimSetEnd(p string, i int) int {
+	j := i + 1
+	if j < len(p) && p[j] == '^' {
+		j++
+	}
+
+	if j < len(p) && p[j] == ']' {
+		j++
+	}
+
+	for ; j < len(p); j++ {
+		switch {
+		case p[j] == '\\':
+			j++
+		case p[j] == '[' && j+1 < len(p) && p[j+1] == ':':
+			if k := strings.Index(p[j:], ":]"); k > 0 {
+				j += k + 1
+			}
+		case p[j] == ']':
+			return j
+		}
+	}
+
+	return -1
+}
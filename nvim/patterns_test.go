@@ -0,0 +1,135 @@
+package nvim
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestLuaPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern, exp string
+		matches      []string
+		err          error
+	}{
+		{"^%u[%u%d_]*$", "^[[:upper:]][[:upper:][:digit:]_]*$", []string{"FOO_1"}, nil},
+		{"^[%a_][%w_]*$", "^[[:alpha:]_][[:alnum:]_]*$", []string{"_a1"}, nil},
+		{"%S+", "[[:^space:]]+", []string{"ab"}, nil},
+		{"^%-%-", `^--`, []string{"--x"}, nil},
+		{"a.-b", "a(?s:.)*?b", []string{"a\nb"}, nil},
+		{"*a?", `\*a?`, []string{"*"}, nil},
+		{"a^b$c$", `a\^b\$c$`, []string{"a^b$c"}, nil},
+		{"[^%]%-]", `[^\]\-]`, []string{"a"}, nil},
+		{"[]a-z\\]", `[\]a-z\\]`, []string{"]"}, nil},
+		{"(a)+|{", `(a)\+\|\{`, []string{"a+|{"}, nil},
+		{"%b()", "", nil, ErrUnsupported},
+		{"%f[%w]", "", nil, ErrUnsupported},
+		{"(a)%1", "", nil, ErrUnsupported},
+		{"[a", "", nil, ErrUnsupported},
+		{"a%", "", nil, ErrUnsupported},
+	}
+
+	for _, tc := range testCases {
+		act, err := luaPattern(tc.pattern)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("Expected %v for %q, got %v", tc.err, tc.pattern, err)
+		}
+
+		if act != tc.exp {
+			t.Fatalf("Expected %s for %q, got %s", tc.exp, tc.pattern, act)
+		}
+
+		for _, s := range tc.matches {
+			if !regexp.MustCompile(act).MatchString(s) {
+				t.Fatalf("Expected %s to match %q", act, s)
+			}
+		}
+	}
+}
+
+func TestLuaClass(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestLuaSetEnd(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestLuaSet(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestSetLiteral(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestVimPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern, exp string
+		matches      []string
+		err          error
+	}{
+		{"^[A-Z_][A-Z0-9_]*$", "^[A-Z_][A-Z0-9_]*$", []string{"FOO_1"}, nil},
+		{`^\(get\|set\)_\w\+$`, `^(get|set)_\w+$`, []string{"get_x"}, nil},
+		{`\<self\>`, `\bself\b`, []string{"self.x"}, nil},
+		{`^\v(get|set)_\w+$`, `^(get|set)_\w+$`, []string{"set_y"}, nil},
+		{`\v<(a|b)>`, `\b(a|b)\b`, []string{"a"}, nil},
+		{`(a)+|?{1}`, `\(a\)\+\|\?\{1\}`, []string{"(a)+|?{1}"}, nil},
+		{`a\{2,3}b\{-1,}c\{,2\}`, `a{2,3}b{1,}?c{0,2}`, []string{"aabc"}, nil},
+		{`\v^a{-}b=$`, `^a*?b?$`, []string{"a"}, nil},
+		{`\c^todo\%(:\)\=`, `(?i)^todo(?::)?`, []string{"TODO:"}, nil},
+		{`[[:upper:]\]]\a\U\h\.`, `[[:upper:]\]][[:alpha:]][[:^upper:]][A-Za-z_]\.`, []string{"]ab_."}, nil},
+		{`[a`, `\[a`, []string{"[a"}, nil},
+		{`\v\(\)`, `\(\)`, []string{"()"}, nil},
+		{`a\@=`, "", nil, ErrUnsupported},
+		{`\v(a)@=`, "", nil, ErrUnsupported},
+		{`\(a\)\1`, "", nil, ErrUnsupported},
+		{`\Vabc`, "", nil, ErrUnsupported},
+		{`a\{2`, "", nil, ErrUnsupported},
+	}
+
+	for _, tc := range testCases {
+		act, err := vimPattern(tc.pattern)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("Expected %v for %q, got %v", tc.err, tc.pattern, err)
+		}
+
+		if act != tc.exp {
+			t.Fatalf("Expected %s for %q, got %s", tc.exp, tc.pattern, act)
+		}
+
+		for _, s := range tc.matches {
+			if !regexp.MustCompile(act).MatchString(s) {
+				t.Fatalf("Expected %s to match %q", act, s)
+			}
+		}
+	}
+}
+
+func TestVimEscape(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestVimBrace(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestMulti(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestVimSetEnd(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
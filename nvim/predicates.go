@@ -0,0 +1,107 @@
+package nvim
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// nodeCheck tells whether a node satisfies a predicate, given its arguments.
+type nodeCheck func(n sitter.Node, text []byte, args []string) bool
+
+// Predicators returns the predicators of the Neovim predicates checking the
+// captured nodes, for [sitter.WithContextPredicators]:
+//
+//   - #has-parent? @capture type...: the node's parent has one of the types;
+//   - #has-ancestor? @capture type...: an ancestor has one of the types;
+//   - #kind-eq? @capture type...: the node has one of the types;
+//   - #contains? @capture text...: the node's text contains one of the texts.
+//
+// Each also comes in a "not-" variant, negating it. For quantified captures,
+// the predicate must hold for all the nodes, or for any of them, with the
+// "any-" variants (e.g. #any-contains?).
+func Predicators() map[string]sitter.ContextPredicator {
+	checks := map[string]nodeCheck{
+		"has-parent?":   hasParent,
+		"has-ancestor?": hasAncestor,
+		"kind-eq?":      kindEq,
+		"contains?":     contains,
+	}
+
+	fns := make(map[string]sitter.ContextPredicator, 4*len(checks)) //nolint:mnd // ok
+	for name, check := range checks {
+		for _, prefix := range []string{"", "not-", "any-", "any-not-"} {
+			fns[prefix+name] = nodePredicate(check)
+		}
+	}
+
+	return fns
+}
+
+// nodePredicate returns the predicator of a predicate taking a capture and one
+// or more strings, checked for each of the captured nodes.
+func nodePredicate(check nodeCheck) sitter.ContextPredicator {
+	return func(pc sitter.PredicateContext) (any, error) {
+		if n := len(pc.Steps) - 1; n < 2 { //nolint:mnd // ok
+			return nil, pc.Error(sitter.ErrPredicateArgsWrongCount,
+				fmt.Sprintf("#%s (expected at least 2, got %d)", pc.Op, n))
+		}
+
+		if pc.Steps[1].Type != sitter.QueryPredicateStepTypeCapture {
+			return nil, pc.Error(sitter.ErrPredicateWrongType,
+				fmt.Sprintf("#%s (arg #1 must be a Capture, got %q)", pc.Op, pc.Arg(1)))
+		}
+
+		args := make([]string, 0, len(pc.Steps)-2) //nolint:mnd // ok
+
+		for i := 2; i < len(pc.Steps); i++ {
+			if pc.Steps[i].Type == sitter.QueryPredicateStepTypeCapture {
+				return nil, pc.Error(sitter.ErrPredicateWrongType,
+					fmt.Sprintf("#%s (arg #%d must NOT be a Capture, got %q)", pc.Op, i, pc.Arg(i)))
+			}
+
+			args = append(args, pc.Arg(i))
+		}
+
+		id := uint(pc.Steps[1].ValueID)
+		anyNode := strings.HasPrefix(pc.Op, "any-")
+		negated := strings.HasPrefix(strings.TrimPrefix(pc.Op, "any-"), "not-")
+
+		return sitter.MatchPredicate(func(m *sitter.QueryMatch, text []byte) bool {
+			for _, n := range m.NodesForCaptureIndex(id) {
+				if ok := check(n, text, args) != negated; ok == anyNode {
+					return ok
+				}
+			}
+
+			return !anyNode
+		}), nil
+	}
+}
+
+func hasParent(n sitter.Node, _ []byte, types []string) bool {
+	p := n.Parent()
+	return !p.IsNull() && slices.Contains(types, p.Type())
+}
+
+func hasAncestor(n sitter.Node, _ []byte, types []string) bool {
+	for p := n.Parent(); !p.IsNull(); p = p.Parent() {
+		if slices.Contains(types, p.Type()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func kindEq(n sitter.Node, _ []byte, types []string) bool {
+	return slices.Contains(types, n.Type())
+}
+
+func contains(n sitter.Node, text []byte, texts []string) bool {
+	content := n.Content(text)
+
+	return slices.ContainsFunc(texts, func(s string) bool { return strings.Contains(content, s) })
+}
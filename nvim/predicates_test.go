@@ -0,0 +1,33 @@
+package nvim
+
+import "testing"
+
+func TestPredicators(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestNewQuery()")
+}
+
+func TestNodePredicate(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestNewQuery()")
+}
+
+func TestHasParent(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestNewQuery()")
+}
+
+func TestHasAncestor(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestNewQuery()")
+}
+
+func TestKindEq(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestNewQuery()")
+}
+
+func TestContains(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestNewQuery()")
+}
@@ -0,0 +1,106 @@
+package sitter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseEventKind indicates the kind of a [ParseEvent].
+type ParseEventKind int
+
+// ParseEvent is a structured view of one of the parser's log messages,
+// describing what happened to a subtree during an (incremental) parse.
+type ParseEvent struct {
+	// Symbol is the name of the subtree (or token) symbol.
+	Symbol string
+	// Reason explains why a subtree could not be reused (for [ParseEventCantReuse]).
+	Reason string
+	// Message is the raw log message the event was parsed from.
+	Message string
+	// Size is the size in bytes of the lexed token (for [ParseEventLex]).
+	Size uint
+	Kind ParseEventKind
+}
+
+// ParseEventFunc is a function that receives the parse events.
+type ParseEventFunc func(ParseEvent)
+
+// The possible parse event kinds.
+const (
+	// ParseEventNewParse signals the start of a parse from scratch.
+	ParseEventNewParse ParseEventKind = iota
+	// ParseEventParseAfterEdit signals the start of an incremental parse.
+	ParseEventParseAfterEdit
+	// ParseEventReuse is sent for each subtree of the old tree that is reused.
+	ParseEventReuse
+	// ParseEventCantReuse is sent for each subtree of the old tree that could not be reused.
+	ParseEventCantReuse
+	// ParseEventLex is sent for each token that had to be (re)lexed.
+	ParseEventLex
+	// ParseEventDone signals the end of the parse.
+	ParseEventDone
+)
+
+// SetParseEventFunc registers a function to be called with the structured
+// parse events, which allow monitoring the progress of a parse, as well as
+// how effective the reuse of the old tree is during incremental parsing.
+//
+// The events are obtained from the parser's log, so this replaces any
+// previously set logger. Passing nil disables it.
+func (p *Parser) SetParseEventFunc(fn ParseEventFunc) {
+	if fn == nil {
+		p.SetLoggerFunc(nil)
+		return
+	}
+
+	p.SetLoggerFunc(func(t LogType, msg string) {
+		if t != LogTypeParse {
+			return
+		}
+
+		if ev, ok := parseEvent(msg); ok {
+			fn(ev)
+		}
+	})
+}
+
+// parseEvent converts a parser log message into a [ParseEvent], if it is one
+// of the messages we know about.
+func parseEvent(msg string) (ev ParseEvent, ok bool) {
+	ev.Message = msg
+
+	switch {
+	case msg == "new_parse":
+		ev.Kind = ParseEventNewParse
+	case msg == "parse_after_edit":
+		ev.Kind = ParseEventParseAfterEdit
+	case msg == "done":
+		ev.Kind = ParseEventDone
+	case strings.HasPrefix(msg, "reuse_node symbol:"):
+		ev.Kind, ev.Symbol = ParseEventReuse, strings.TrimPrefix(msg, "reuse_node symbol:")
+	case strings.HasPrefix(msg, "cant_reuse_node symbol:"):
+		ev.Kind, ev.Reason = ParseEventCantReuse, "first_leaf"
+		ev.Symbol, _, _ = strings.Cut(strings.TrimPrefix(msg, "cant_reuse_node symbol:"), ", first_leaf_symbol:")
+	case strings.HasPrefix(msg, "cant_reuse_node_"):
+		ev.Kind = ParseEventCantReuse
+		ev.Reason, ev.Symbol, _ = strings.Cut(strings.TrimPrefix(msg, "cant_reuse_node_"), " tree:")
+	case strings.HasPrefix(msg, "lexed_lookahead sym:"):
+		rest := strings.TrimPrefix(msg, "lexed_lookahead sym:")
+
+		i := strings.LastIndex(rest, ", size:")
+		if i < 0 {
+			return ev, false
+		}
+
+		size, err := strconv.ParseUint(rest[i+len(", size:"):], 10, 32)
+		if err != nil {
+			return ev, false
+		}
+
+		ev.Kind, ev.Symbol, ev.Size = ParseEventLex, rest[:i], uint(size)
+	default:
+		return ev, false
+	}
+
+	return ev, true
+}
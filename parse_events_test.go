@@ -0,0 +1,90 @@
+package sitter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParserSetParseEventFunc(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	counts := map[ParseEventKind]int{}
+
+	p.SetParseEventFunc(func(ev ParseEvent) { counts[ev.Kind]++ })
+
+	input := []byte("1 + 2 + 3")
+
+	tree, err := p.ParseString(context.Background(), nil, input)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if counts[ParseEventNewParse] != 1 || counts[ParseEventDone] != 1 || counts[ParseEventLex] == 0 {
+		t.Fatalf("Unexpected events for a new parse: %v", counts)
+	}
+
+	clear(counts)
+
+	tree.Edit(InputEdit{
+		StartIndex: 8, OldEndIndex: 9, NewEndIndex: 9,
+		StartPoint: Point{Column: 8}, OldEndPoint: Point{Column: 9}, NewEndPoint: Point{Column: 9},
+	})
+
+	if _, err = p.ParseString(context.Background(), tree, []byte("1 + 2 + 4")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if counts[ParseEventParseAfterEdit] != 1 || counts[ParseEventReuse] == 0 {
+		t.Fatalf("Unexpected events for an incremental parse: %v", counts)
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		msg string
+		exp ParseEvent
+		ok  bool
+	}{
+		{"new_parse", ParseEvent{Kind: ParseEventNewParse}, true},
+		{"reuse_node symbol:sum", ParseEvent{Kind: ParseEventReuse, Symbol: "sum"}, true},
+		{"reuse_node symbol:,", ParseEvent{Kind: ParseEventReuse, Symbol: ","}, true},
+		{
+			"cant_reuse_node_is_fragile tree:expression",
+			ParseEvent{Kind: ParseEventCantReuse, Symbol: "expression", Reason: "is_fragile"}, true,
+		},
+		{
+			"cant_reuse_node symbol:sum, first_leaf_symbol:number",
+			ParseEvent{Kind: ParseEventCantReuse, Symbol: "sum", Reason: "first_leaf"}, true,
+		},
+		{"lexed_lookahead sym:number, size:3", ParseEvent{Kind: ParseEventLex, Symbol: "number", Size: 3}, true},
+		{"lexed_lookahead sym:,, size:1", ParseEvent{Kind: ParseEventLex, Symbol: ",", Size: 1}, true},
+		{"lexed_lookahead sym:number", ParseEvent{}, false},
+		{"shift state:1", ParseEvent{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+
+			act, ok := parseEvent(tc.msg)
+			if ok != tc.ok {
+				t.Fatalf("Expected ok to be %v, got %v", tc.ok, ok)
+			}
+
+			if !ok {
+				return
+			}
+
+			tc.exp.Message = tc.msg
+			if !reflect.DeepEqual(act, tc.exp) {
+				t.Fatalf("Expected %+v, got %+v", tc.exp, act)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package sitter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// SizeStrategy tells how [Parser.ParseFile] handles the files larger than its
+// limit, e.g. the generated ones, which would otherwise dominate the parsing
+// time and memory.
+type SizeStrategy int
+
+// Size strategies.
+const (
+	// SizeSkip does not parse them, failing with [ErrTooLarge].
+	SizeSkip SizeStrategy = iota
+	// SizeTruncate only reads and parses their first bytes, up to the limit
+	// (cut back to the last line break, if any), see [ParsedFile.Truncated].
+	SizeTruncate
+	// SizeStream parses them as they are read (see [Parser.ParseReader]),
+	// without ever holding their text in memory, so their content is not set.
+	SizeStream
+)
+
+// ErrTooLarge is returned by [Parser.ParseFile] for the files larger than its
+// limit, with the [SizeSkip] strategy.
+var ErrTooLarge = errors.New("file too large")
+
+// ParseFile reads and parses the file at path. The files larger than maxSize
+// bytes (if positive) are handled according to the given strategy.
+func (p *Parser) ParseFile(ctx context.Context, path string, maxSize int64, strategy SizeStrategy) (pf ParsedFile, err error) { //nolint:lll // ok
+	pf.Path = path
+
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+
+	defer f.Close() //nolint:errcheck // ok, read only
+
+	info, err := f.Stat()
+	if err != nil {
+		return
+	}
+
+	var tree *Tree
+
+	switch size := info.Size(); {
+	case maxSize <= 0 || size <= maxSize:
+		if pf.Content, err = io.ReadAll(f); err == nil {
+			tree, err = p.ParseString(ctx, nil, pf.Content)
+		}
+	case strategy == SizeTruncate:
+		pf.Content = make([]byte, maxSize)
+		if _, err = io.ReadFull(f, pf.Content); err != nil {
+			return
+		}
+
+		if i := bytes.LastIndexByte(pf.Content, '\n'); i >= 0 {
+			pf.Content = pf.Content[:i+1]
+		}
+
+		pf.Truncated = true
+		tree, err = p.ParseString(ctx, nil, pf.Content)
+	case strategy == SizeStream:
+		tree, err = p.ParseReader(ctx, nil, io.NewSectionReader(f, 0, size))
+	default:
+		return pf, fmt.Errorf("%w: %s (%d bytes)", ErrTooLarge, path, size)
+	}
+
+	if err != nil {
+		return
+	}
+
+	pf.Root = tree.RootNode()
+
+	return
+}
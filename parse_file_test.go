@@ -0,0 +1,71 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParserParseFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "a.calc")
+	if err := os.WriteFile(path, []byte("1 + 2\n+ 3\n"), 0o600); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		name      string
+		maxSize   int64
+		strategy  SizeStrategy
+		content   string
+		truncated bool
+		err       error
+	}{
+		{"no limit", 0, SizeSkip, "1 + 2\n+ 3\n", false, nil},
+		{"within limit", 10, SizeSkip, "1 + 2\n+ 3\n", false, nil},
+		{"skip", 9, SizeSkip, "", false, ErrTooLarge},
+		{"truncate", 8, SizeTruncate, "1 + 2\n", true, nil},
+		{"stream", 8, SizeStream, "", false, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewParser()
+			p.SetLanguage(gr)
+
+			pf, err := p.ParseFile(context.Background(), path, tc.maxSize, tc.strategy)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("Expected %v, got %v", tc.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if string(pf.Content) != tc.content || pf.Truncated != tc.truncated || pf.Path != path {
+				t.Fatalf("Expected %q (truncated: %v), got %q (truncated: %v)",
+					tc.content, tc.truncated, pf.Content, pf.Truncated)
+			}
+
+			if exp := uint(len("1 + 2\n+ 3\n")); !tc.truncated && pf.Root.EndByte() != exp {
+				t.Fatalf("Expected root to end at %d, got %d", exp, pf.Root.EndByte())
+			}
+
+			if pf.Root.HasError() {
+				t.Fatal("Expected no syntax errors, got", pf.Root)
+			}
+		})
+	}
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	if _, err := p.ParseFile(context.Background(), path+".nope", 0, SizeSkip); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected %v, got %v", os.ErrNotExist, err)
+	}
+}
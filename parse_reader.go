@@ -0,0 +1,150 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// ReaderOption configures [ParseReader].
+type ReaderOption func(*readerInput)
+
+// readerInput feeds the parser from an [io.Reader], reading only as much as the
+// parser asked for so far and keeping (at most max bytes of) it buffered, as
+// the parser may ask again for text it already read.
+type readerInput struct {
+	ctx   context.Context //nolint:containedctx // only used to check for cancellation
+	r     io.Reader
+	err   error
+	buf   []byte
+	start uint32
+	max   int
+	chunk int
+}
+
+// ErrBufferLimit is returned when the parser needs text that was already
+// discarded from the buffer of [ParseReader].
+var ErrBufferLimit = errors.New("text is no longer buffered")
+
+const defaultReaderChunk = 32 * 1024
+
+// ParseReader is a shortcut for parsing the source code read from r, returns
+// the root node. It reads from r as the parser asks for more text (blocking as
+// needed), so that streams (pipes, sockets, etc.) can be parsed as they arrive.
+//
+// Reads are not interrupted by cancelling the context, but no further reads are
+// done afterwards. Read errors (other than [io.EOF]) abort the parse and are
+// returned.
+//
+// By default all the text read is kept, see [WithMaxBuffer] for bounding it.
+// The returned tree does not hold the text, so use an [io.TeeReader] if you
+// also need it. See [Parser.ParseReader] for reading an [io.SectionReader].
+func ParseReader(ctx context.Context, r io.Reader, lang *Language, opts ...ReaderOption) (n Node, err error) {
+	p := NewParser()
+	p.SetLanguage(lang)
+
+	tree, err := p.ParseReader(ctx, nil, r, opts...)
+	if err != nil {
+		return
+	}
+
+	return tree.RootNode(), nil
+}
+
+// ParseReader is like the package level [ParseReader], but uses the parser
+// (with its language, included ranges, etc.) and the (optional) old tree, for
+// incremental parsing, as [Parser.Parse] does.
+//
+// If r is an [*io.SectionReader] (e.g. over an *os.File), the text is read
+// from it at the offsets (within the section) the parser asks for, a chunk at
+// a time, regardless of its current offset, and only the last chunk is kept
+// buffered (its size being bounded by [WithMaxBuffer], if given). That suits
+// incremental parsing best, as the parser skips over the unchanged parts of
+// the text. Any other reader is read from its current offset on, even if it
+// implements [io.ReaderAt].
+func (p *Parser) ParseReader(ctx context.Context, oldTree *Tree, r io.Reader, opts ...ReaderOption) (*Tree, error) {
+	in := &readerInput{ctx: ctx, r: r, chunk: defaultReaderChunk}
+	for _, opt := range opts {
+		opt(in)
+	}
+
+	read := in.read
+	if sr, ok := r.(*io.SectionReader); ok {
+		read = in.readAt(sr)
+	}
+
+	return p.Parse2(ctx, oldTree, Input2{Read: read, Encoding: InputEncodingUTF8})
+}
+
+// WithMaxBuffer bounds the text kept buffered by [ParseReader] to (about) n
+// bytes, by discarding the text that precedes the parser's current position.
+// If the parser later needs the discarded text (i.e. it backtracks more than
+// n bytes), the parse fails with [ErrBufferLimit].
+func WithMaxBuffer(n int) ReaderOption {
+	return func(in *readerInput) {
+		if n > 0 {
+			in.max, in.chunk = n, min(defaultReaderChunk, n)
+		}
+	}
+}
+
+func (in *readerInput) read(offset uint32, _ Point) ([]byte, error) {
+	if offset < in.start {
+		return nil, fmt.Errorf("%w: offset %d (buffered from %d)", ErrBufferLimit, offset, in.start)
+	}
+
+	for int(offset-in.start) >= len(in.buf) {
+		if in.err != nil {
+			return nil, in.err
+		}
+
+		in.fill(offset)
+	}
+
+	return in.buf[offset-in.start:], nil
+}
+
+// readAt returns a read function reading a chunk from ra at each call.
+func (in *readerInput) readAt(ra io.ReaderAt) ReadFunc2 {
+	return func(offset uint32, _ Point) ([]byte, error) {
+		if err := in.ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		in.buf = slices.Grow(in.buf[:0], in.chunk)[:in.chunk]
+
+		n, err := ra.ReadAt(in.buf, int64(offset))
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+
+		return in.buf[:n], err
+	}
+}
+
+// fill reads the next chunk, discarding as much of the buffered text as needed
+// (but never text at or past offset) to stay within the limit.
+func (in *readerInput) fill(offset uint32) {
+	if err := in.ctx.Err(); err != nil {
+		in.err = err
+		return
+	}
+
+	if in.max > 0 {
+		if discard := min(len(in.buf)+in.chunk-in.max, int(offset-in.start), len(in.buf)); discard > 0 {
+			in.buf = in.buf[:copy(in.buf, in.buf[discard:])]
+			in.start += uint32(discard) //nolint:gosec // discard is bounded by len(in.buf)
+		}
+	}
+
+	in.buf = slices.Grow(in.buf, in.chunk)
+
+	n, err := in.r.Read(in.buf[len(in.buf) : len(in.buf)+in.chunk])
+	in.buf = in.buf[:len(in.buf)+n]
+
+	if err != nil {
+		in.err = err
+	}
+}
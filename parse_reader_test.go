@@ -0,0 +1,198 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestParseReader(t *testing.T) {
+	t.Parallel()
+
+	input := strings.Repeat("1 + ", 1000) + "1"
+
+	exp, err := Parse(context.Background(), []byte(input), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	errRead := errors.New("read failed")
+	section := func(prefix string) io.Reader {
+		return io.NewSectionReader(strings.NewReader(prefix+input), int64(len(prefix)), int64(len(input)))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancel()
+
+	testCases := []struct {
+		name string
+		ctx  context.Context //nolint:containedctx // ok
+		r    io.Reader
+		opts []ReaderOption
+		err  error
+	}{
+		{"whole", context.Background(), strings.NewReader(input), nil, nil},
+		{"one byte reads", context.Background(), iotest.OneByteReader(strings.NewReader(input)), nil, nil},
+		{"max buffer", context.Background(), strings.NewReader(input), []ReaderOption{WithMaxBuffer(64)}, nil},
+		{"max buffer 1", context.Background(), strings.NewReader(input), []ReaderOption{WithMaxBuffer(1)}, nil},
+		{"partly read", context.Background(), partlyRead("2 * 3 + "+input, 8), nil, nil},
+		{"section", context.Background(), section("2 * "), nil, nil},
+		{"section max buffer", context.Background(), section(""), []ReaderOption{WithMaxBuffer(64)}, nil},
+		{"read error", context.Background(), io.MultiReader(strings.NewReader(input[:10]), iotest.ErrReader(errRead)), nil, errRead},
+		{"cancelled", ctx, strings.NewReader(input), nil, context.Canceled},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			act, err := ParseReader(tc.ctx, tc.r, gr, tc.opts...)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("Expected %v, got %v", tc.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			if act.String() != exp.String() {
+				t.Fatalf("Expected %s, got %s", exp, act)
+			}
+		})
+	}
+}
+
+func TestParserParseReader(t *testing.T) {
+	t.Parallel()
+
+	oldInput, input := "1 + 2", "1 + 2 + 3"
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	exp, err := p.ParseString(context.Background(), nil, []byte(input))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for _, r := range []io.Reader{strings.NewReader(input), io.NewSectionReader(strings.NewReader(input), 0, 9)} {
+		oldTree, err := p.ParseReader(context.Background(), nil, strings.NewReader(oldInput))
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		oldTree.Edit(InputEdit{
+			StartIndex: 5, OldEndIndex: 5, NewEndIndex: 9,
+			StartPoint: Point{Column: 5}, OldEndPoint: Point{Column: 5}, NewEndPoint: Point{Column: 9},
+		})
+
+		act, err := p.ParseReader(context.Background(), oldTree, r)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if act.RootNode().String() != exp.RootNode().String() {
+			t.Fatalf("Expected %s, got %s", exp.RootNode(), act.RootNode())
+		}
+	}
+}
+
+func TestWithMaxBuffer(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestReaderInputRead(t *testing.T) {
+	t.Parallel()
+
+	in := &readerInput{ctx: context.Background(), r: strings.NewReader("0123456789")}
+	WithMaxBuffer(4)(in)
+
+	testCases := []struct {
+		offset uint32
+		exp    string
+		err    error
+	}{
+		{0, "0123", nil},
+		{2, "23", nil},
+		{6, "67", nil},
+		{1, "", ErrBufferLimit},
+		{9, "9", nil},
+		{10, "", io.EOF},
+	}
+
+	for _, tc := range testCases {
+		act, err := in.read(tc.offset, Point{})
+		if !errors.Is(err, tc.err) || string(act) != tc.exp {
+			t.Fatalf("Expected %q, %v at %d, got %q, %v", tc.exp, tc.err, tc.offset, act, err)
+		}
+
+		if len(in.buf) > in.max {
+			t.Fatalf("Expected at most %d bytes buffered, got %d", in.max, len(in.buf))
+		}
+	}
+}
+
+func TestReaderInputReadAt(t *testing.T) {
+	t.Parallel()
+
+	errRead := errors.New("read failed")
+	in := &readerInput{ctx: context.Background(), chunk: 4}
+
+	testCases := []struct {
+		r      io.ReaderAt
+		offset uint32
+		exp    string
+		err    error
+	}{
+		{strings.NewReader("0123456789"), 0, "0123", nil},
+		{strings.NewReader("0123456789"), 7, "789", nil},
+		{strings.NewReader("0123456789"), 2, "2345", nil},
+		{strings.NewReader("0123456789"), 10, "", nil},
+		{readerAtFunc(func([]byte, int64) (int, error) { return 0, errRead }), 0, "", errRead},
+	}
+
+	for _, tc := range testCases {
+		act, err := in.readAt(tc.r)(tc.offset, Point{})
+		if !errors.Is(err, tc.err) || string(act) != tc.exp {
+			t.Fatalf("Expected %q, %v at %d, got %q, %v", tc.exp, tc.err, tc.offset, act, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	in.ctx = ctx
+	if _, err := in.readAt(strings.NewReader("0123"))(0, Point{}); !errors.Is(err, context.Canceled) {
+		t.Fatal("Expected a cancellation error, got", err)
+	}
+}
+
+// partlyRead returns a reader of s, whose first n bytes were already read.
+func partlyRead(s string, n int64) io.Reader {
+	r := strings.NewReader(s)
+	if _, err := r.Seek(n, io.SeekStart); err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+type readerAtFunc func([]byte, int64) (int, error)
+
+func (f readerAtFunc) ReadAt(b []byte, off int64) (int, error) {
+	return f(b, off)
+}
+
+func TestReaderInputFill(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
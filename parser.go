@@ -7,10 +7,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
 	"runtime"
+	"runtime/cgo"
+	"runtime/trace"
 	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 )
 
@@ -18,9 +23,19 @@ import (
 type Parser struct {
 	c      *C.TSParser
 	cancel *uint64
+	logFn  cgo.Handle
+	log    *logState
 	once   sync.Once
+
+	metrics Metrics
+	slog    *slog.Logger
+	busy    atomic.Bool
+	dot     <-chan error // The result of writing the dot graphs, see WriteDotGraphs.
 }
 
+// ParserOption configures a [Parser] created with [NewParserWith].
+type ParserOption func(*Parser) error
+
 // Input type lets you specify how to read the text.
 type Input struct {
 	// Payload an arbitrary pointer that will be passed to each invocation
@@ -35,37 +50,81 @@ type Input struct {
 	//  [`bytes_read`] pointer to indicate the end of the document.
 	Read ReadFunc
 	// Encoding is an indication of how the text is encoded.
-	// Either `TSInputEncodingUTF8` or `TSInputEncodingUTF16`.
+	// One of [InputEncodingUTF8], [InputEncodingUTF16LE] or [InputEncodingUTF16BE].
 	Encoding InputEncoding
+	// Decode, if set, decodes the text, which is then in a custom encoding
+	// (i.e. Encoding is [InputEncodingCustom]).
+	Decode DecodeFunction
 }
 
+// Input2 is like [Input], except that its Read function can fail.
+type Input2 struct {
+	// Read is a function to retrieve a chunk of text at a given byte offset
+	// and (row, column) position. See [ReadFunc2].
+	Read ReadFunc2
+	// Encoding is an indication of how the text is encoded.
+	Encoding InputEncoding
+	// Decode is as in [Input].
+	Decode DecodeFunction
+}
+
+// ParserOptions holds a snapshot of a [Parser]'s configuration, as returned by
+// [Parser.Options] and restored by [Parser.SetOptions].
+type ParserOptions struct {
+	Language         *Language
+	CancellationFlag *uint64
+	IncludedRanges   []Range
+	TimeoutMicros    int
+}
+
+// LogType indicates the source of a log message (parsing or lexing).
+type LogType uint8
+
+// LogFunc is a function that receives the parser's log messages.
+type LogFunc func(t LogType, msg string)
+
 // InputEncoding indicates the encoding of the text to parse.
 type InputEncoding = C.TSInputEncoding
 
 // ReadFunc is a function to retrieve a chunk of text at a given byte offset and
 // (row, column) position. It should return nil to indicate the end of the document.
+// If it panics, the parse is aborted and the panic is returned as an
+// [ErrCallbackPanic] error.
 type ReadFunc func(offset uint32, position Point) []byte
 
-// keeps callbacks for parser.parse method
-type readFuncsMap struct {
-	funcs map[int]ReadFunc
-	index int
-	sync.RWMutex
-}
+// ReadFunc2 is like [ReadFunc], except that it can also return an error, which
+// aborts the parse and is returned by [Parser.Parse2]. The end of the document is
+// indicated by returning no bytes, optionally with an [io.EOF] error (which is
+// not treated as a failure).
+type ReadFunc2 func(offset uint32, position Point) ([]byte, error)
 
 // Input encoding types.
 const (
-	InputEncodingUTF8  = C.TSInputEncodingUTF8
-	InputEncodingUTF16 = C.TSInputEncodingUTF16
+	InputEncodingUTF8    = C.TSInputEncodingUTF8
+	InputEncodingUTF16LE = C.TSInputEncodingUTF16LE
+	InputEncodingUTF16BE = C.TSInputEncodingUTF16BE
+	InputEncodingCustom  = C.TSInputEncodingCustom // See [Input.Decode].
+
+	// Deprecated: use [InputEncodingUTF16LE], which it stands for.
+	InputEncodingUTF16 = InputEncodingUTF16LE
 )
 
-// Maintain a map of read functions that can be called from C.
-var readFuncs = &readFuncsMap{funcs: map[int]ReadFunc{}} //nolint:gochecknoglobals // ok
+// Log types.
+const (
+	LogTypeParse LogType = C.TSLogTypeParse
+	LogTypeLex   LogType = C.TSLogTypeLex
+)
 
 // Possible error types.
 var (
-	ErrOperationLimit = errors.New("operation limit was hit")
-	ErrNoLanguage     = errors.New("cannot parse without language")
+	ErrOperationLimit       = errors.New("operation limit was hit")
+	ErrNoLanguage           = errors.New("cannot parse without language")
+	ErrInvalidRanges        = errors.New("included ranges must be ordered and must not overlap")
+	ErrCallbackPanic        = errors.New("callback panicked")
+	ErrIncompatibleLanguage = errors.New("incompatible language") // See [LanguageError].
+	ErrClosed               = errors.New("object was deleted")
+	ErrParserBusy           = errors.New("parser is already parsing")
+	ErrNoDecoder            = errors.New("custom encoding without a decode function")
 )
 
 // NewParser creates a new Parser.
@@ -85,7 +144,11 @@ func NewParser() (p *Parser) {
 // As the constructor in go-tree-sitter would set this func call through runtime.SetFinalizer,
 // parser.close() will be called by Go's garbage collector and users need not call this manually.
 func (p *Parser) close() {
-	p.once.Do(func() { C.ts_parser_delete(p.c) })
+	p.once.Do(func() {
+		_ = p.WriteDotGraphs(nil)
+		C.ts_parser_delete(p.c)
+		p.releaseLogFunc()
+	})
 }
 
 // Language returns the parser's current language, if set.
@@ -98,7 +161,7 @@ func (p *Parser) Language() (_ *Language) {
 // Returns a boolean indicating whether or not the language was successfully
 // assigned. True means assignment succeeded. False means there was a version
 // mismatch: the language was generated with an incompatible version of the
-// Tree-sitter CLI. Check the language's version using `ts_language_version`
+// Tree-sitter CLI. Check the language's version using `ts_language_abi_version`
 // and compare it to this library's `TREE_SITTER_LANGUAGE_VERSION` and
 // `TREE_SITTER_MIN_COMPATIBLE_LANGUAGE_VERSION` constants.
 func (p *Parser) SetLanguage(lang *Language) bool {
@@ -127,12 +190,8 @@ func (p *Parser) SetLanguage(lang *Language) bool {
 // will not be assigned, and this function will return `false`. On success,
 // this function returns `true`.
 func (p *Parser) SetIncludedRanges(ranges []Range) bool {
-	cRanges := make([]C.TSRange, len(ranges))
-	for i, r := range ranges {
-		cRanges[i] = r.c()
-	}
-
-	return bool(C.ts_parser_set_included_ranges(p.c, (*C.TSRange)(unsafe.Pointer(&cRanges[0])), C.uint(len(ranges))))
+	cr, count := cRanges(ranges)
+	return bool(C.ts_parser_set_included_ranges(p.c, cr, count))
 }
 
 // IncludedRanges returns the ranges of text that the parser will include when parsing.
@@ -175,18 +234,122 @@ func (p *Parser) IncludedRanges() (out []Range) {
 //     from where the parser left out by calling [`ts_parser_parse`] again with
 //     the same arguments.
 func (p *Parser) Parse(ctx context.Context, oldTree *Tree, input Input) (*Tree, error) {
+	return p.Parse2(ctx, oldTree, Input2{
+		Read: func(offset uint32, position Point) ([]byte, error) {
+			return input.Read(offset, position), nil
+		},
+		Encoding: input.Encoding,
+		Decode:   input.Decode,
+	})
+}
+
+// Parse2 is like [Parser.Parse], except that it reads the text using a
+// [ReadFunc2]. If reading fails, the parse is aborted and the error returned
+// by the read function is returned.
+//
+// The parse runs within a "sitter.Parse" [trace] region, as do the ones of
+// [Parser.ParseString].
+func (p *Parser) Parse2(ctx context.Context, oldTree *Tree, input Input2) (t *Tree, err error) {
 	var baseTree *C.TSTree
 
 	if oldTree != nil {
 		baseTree = oldTree.c
 	}
 
-	funcID := readFuncs.register(input.Read)
-	baseTree = C.call_ts_parser_parse(p.c, baseTree, C.int(funcID), input.Encoding)
+	if input.Decode != nil {
+		input.Encoding = InputEncodingCustom
+	} else if input.Encoding == InputEncodingCustom {
+		return nil, ErrNoDecoder
+	}
+
+	if !p.busy.CompareAndSwap(false, true) {
+		return nil, ErrParserBusy
+	}
+
+	defer p.busy.Store(false)
+
+	defer trace.StartRegion(ctx, "sitter.Parse").End()
+
+	restore, byDeadline := p.applyDeadline(ctx)
+	defer restore()
+
+	read := &readState{fn: input.Read, decode: input.Decode}
+	p.armCallbacks(&read.callbackGuard)
+
+	defer func(start time.Time) {
+		p.parseDone(oldTree != nil, t, err, read.extent, time.Since(start))
+	}(time.Now())
+
+	h := cgo.NewHandle(read)
+	baseTree = C.call_ts_parser_parse(p.c, baseTree, C.uintptr_t(h), input.Encoding)
+
+	h.Delete()
+
+	if err = p.callbackErr(baseTree, &read.callbackGuard); err != nil {
+		return
+	}
+
+	return p.convertTSTree(ctx, baseTree, byDeadline)
+}
+
+// ParseOptions are the options of a parse, see [Parser.ParseWithOptions].
+type ParseOptions struct {
+	// OnProgress, if set, is called with the state of the parse each time the
+	// parser reads text (i.e. as often as the chunks returned by the input's
+	// Read function allow) and once an error is detected, the parse halting
+	// (with [ErrProgressHalted]) if it returns false.
+	OnProgress func(ParseState) bool
+}
+
+// ParseState is the state of a parse, as reported to [ParseOptions.OnProgress].
+type ParseState struct {
+	// CurrentByteOffset is the offset of the text read last.
+	CurrentByteOffset uint32
+	// HasError tells whether the parser detected an error so far (possibly in
+	// one of the versions of the tree it then discarded).
+	HasError bool
+}
+
+// ParseWithOptions is like [Parser.Parse2], with the given parse options, for
+// monitoring (and halting) long parses more precisely than with timeouts.
+//
+// The progress is reported from the read and log callbacks: the parser's
+// logger is wrapped for the duration of the parse (to detect the errors), which
+// slows it down.
+func (p *Parser) ParseWithOptions(ctx context.Context, oldTree *Tree, input Input2, opts ParseOptions) (*Tree, error) {
+	if opts.OnProgress == nil {
+		return p.Parse2(ctx, oldTree, input)
+	}
+
+	var state ParseState
+
+	read := input.Read
+	input.Read = func(offset uint32, position Point) ([]byte, error) {
+		if state.CurrentByteOffset = offset; !opts.OnProgress(state) {
+			return nil, ErrProgressHalted
+		}
+
+		return read(offset, position)
+	}
+
+	logFn := p.LoggerFunc()
+	defer p.SetLoggerFunc(logFn)
+
+	p.SetLoggerFunc(func(t LogType, msg string) {
+		if logFn != nil {
+			logFn(t, msg)
+		}
+
+		if t != LogTypeParse || msg != "detect_error" || state.HasError {
+			return
+		}
 
-	readFuncs.unregister(funcID)
+		if state.HasError = true; !opts.OnProgress(state) {
+			p.log.fail(ErrProgressHalted)
+		}
+	})
 
-	return p.convertTSTree(ctx, baseTree)
+	return p.Parse2(ctx, oldTree, input)
 }
 
 // ParseString produces new Tree from content (optionally using old tree).
@@ -194,13 +357,32 @@ func (p *Parser) Parse(ctx context.Context, oldTree *Tree, input Input) (*Tree,
 // Uses the parser to parse some source code stored in one contiguous buffer.
 // If the optional encoding is passed, it will be used for parsing.
 // See `Parse()` for further details, as the behavior virtually the same.
-func (p *Parser) ParseString(ctx context.Context, oldTree *Tree, content []byte, opts ...InputEncoding) (*Tree, error) {
+func (p *Parser) ParseString(ctx context.Context, oldTree *Tree, content []byte, opts ...InputEncoding) (t *Tree, err error) { //nolint:lll // ok
 	var baseTree *C.TSTree
 
 	if oldTree != nil {
 		baseTree = oldTree.c
 	}
 
+	if len(opts) > 0 && opts[0] == InputEncodingCustom {
+		return nil, ErrNoDecoder // See Parse, for decoding the content.
+	}
+
+	if !p.busy.CompareAndSwap(false, true) {
+		return nil, ErrParserBusy
+	}
+
+	defer p.busy.Store(false)
+
+	defer trace.StartRegion(ctx, "sitter.Parse").End()
+
+	restore, byDeadline := p.applyDeadline(ctx)
+	defer restore()
+
+	defer func(start time.Time) {
+		p.parseDone(oldTree != nil, t, err, uint(len(content)), time.Since(start))
+	}(time.Now())
+
 	parseComplete := make(chan struct{})
 
 	// run goroutine only if context is cancelable to avoid performance impact
@@ -217,6 +399,8 @@ func (p *Parser) ParseString(ctx context.Context, oldTree *Tree, content []byte,
 
 	input := C.CBytes(content)
 
+	p.armCallbacks()
+
 	if len(opts) > 0 {
 		baseTree = C.ts_parser_parse_string_encoding(p.c, baseTree, (*C.char)(input), C.uint(len(content)), opts[0])
 	} else {
@@ -227,7 +411,11 @@ func (p *Parser) ParseString(ctx context.Context, oldTree *Tree, content []byte,
 
 	C.free(input)
 
-	return p.convertTSTree(ctx, baseTree)
+	if err = p.callbackErr(baseTree); err != nil {
+		return
+	}
+
+	return p.convertTSTree(ctx, baseTree, byDeadline)
 }
 
 // Reset instructs the parser to start the next parse from the beginning.
@@ -273,35 +461,58 @@ func (p *Parser) CancellationFlag() *uint64 {
 
 // Debug enables debug output to stderr.
 func (p *Parser) Debug() {
-	p.SetLogger(C.stderr_logger_new(true))
+	p.SetSlog(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})))
 }
 
-// SetLogger sets the logger that a parser should use during parsing.
+// SetLogger sets the logger that the parser should use during parsing.
 //
 // The parser does not take ownership over the logger payload. If a logger was
 // previously assigned, the caller is responsible for releasing any memory
 // owned by the previous logger.
+//
+// Deprecated: the C logger cannot be built outside this package, use
+// [Parser.SetLoggerFunc] instead.
 func (p *Parser) SetLogger(logger C.TSLogger) {
 	C.ts_parser_set_logger(p.c, logger)
+
+	// Unless given back (see Parser.Logger), the Go logger is replaced.
+	if p.logFn != 0 && uintptr(logger.payload) != uintptr(p.logFn) {
+		p.releaseLogFunc()
+	}
 }
 
 // Logger returns the parser's current logger.
+//
+// Deprecated: use [Parser.LoggerFunc] instead.
 func (p *Parser) Logger() C.TSLogger {
 	return C.ts_parser_logger(p.c)
 }
 
+// LoggerFunc returns the parser's current logger function, if set.
+func (p *Parser) LoggerFunc() LogFunc {
+	if p.log == nil || C.ts_parser_logger(p.c).log == nil {
+		return nil
+	}
+
+	return p.log.fn
+}
+
 // PrintDotGraphs can be used to write debugging graphs during parsing.
 //
 // The graphs are formatted in the DOT language. You may want
 // to pipe these graphs directly to a `dot(1)` process in order to generate
-// SVG output. You can turn off this logging by passing a negative number.
+// SVG output. You can turn off this logging with [Parser.WriteDotGraphs] (nil).
 func (p *Parser) PrintDotGraphs(name string) (err error) {
+	if err = p.WriteDotGraphs(nil); err != nil {
+		return
+	}
+
 	f, err := os.Create(name)
 	if err != nil {
 		return
 	}
 
-	C.ts_parser_print_dot_graphs(p.c, C.int(f.Fd()))
+	C.go_print_dot_graphs(p.c, C.int(f.Fd()))
 
 	if err = f.Close(); err != nil {
 		err = fmt.Errorf("cannot save dot file: %w", err)
@@ -310,16 +521,201 @@ func (p *Parser) PrintDotGraphs(name string) (err error) {
 	return
 }
 
+// Non API.
+
+// NewParserWith creates a new Parser and configures it with the given options,
+// returning the first configuration error encountered, if any.
+func NewParserWith(opts ...ParserOption) (p *Parser, err error) {
+	p = NewParser()
+
+	for _, opt := range opts {
+		if err = opt(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// WithLanguage sets the parser's language, failing with a [LanguageError]
+// if the language version is incompatible.
+func WithLanguage(lang *Language) ParserOption {
+	return func(p *Parser) error {
+		if lang == nil {
+			return ErrNoLanguage
+		}
+
+		if lang.ptr == nil {
+			return ErrClosed
+		}
+
+		if !p.SetLanguage(lang) {
+			return LanguageError(lang.Version())
+		}
+
+		return nil
+	}
+}
+
+// WithTimeout sets the maximum duration that parsing is allowed to take.
+func WithTimeout(d time.Duration) ParserOption {
+	return func(p *Parser) error {
+		p.SetTimeout(d)
+		return nil
+	}
+}
+
+// WithIncludedRanges sets the ranges of text that the parser should include
+// when parsing, failing with [ErrInvalidRanges] if they are not valid.
+func WithIncludedRanges(ranges []Range) ParserOption {
+	return func(p *Parser) error {
+		if !p.SetIncludedRanges(ranges) {
+			return ErrInvalidRanges
+		}
+
+		return nil
+	}
+}
+
+// WithLogger sets the function that receives the parser's log messages.
+func WithLogger(fn LogFunc) ParserOption {
+	return func(p *Parser) error {
+		p.SetLoggerFunc(fn)
+		return nil
+	}
+}
+
+// SetLoggerFunc sets a Go function as the parser's logger. Passing nil
+// disables logging.
+//
+// If the function panics, the parse is aborted and the panic is returned as
+// an [ErrCallbackPanic] error.
+func (p *Parser) SetLoggerFunc(fn LogFunc) {
+	if fn == nil {
+		C.ts_parser_set_logger(p.c, C.TSLogger{})
+		p.releaseLogFunc()
+
+		return
+	}
+
+	log := &logState{fn: fn}
+	h := cgo.NewHandle(log)
+	C.ts_parser_set_logger(p.c, C.go_logger_new(C.uintptr_t(h)))
+	p.releaseLogFunc()
+	p.logFn, p.log = h, log
+}
+
+// WriteDotGraphs is like [Parser.PrintDotGraphs], writing the graphs to w
+// (through a pipe) instead of a file, until turned off by passing nil, which
+// returns the first error writing to w, if any. As the parser buffers them,
+// the graphs are only written in full once turned off.
+func (p *Parser) WriteDotGraphs(w io.Writer) (err error) {
+	C.ts_parser_print_dot_graphs(p.c, -1)
+
+	if p.dot != nil {
+		err, p.dot = <-p.dot, nil
+	}
+
+	if w == nil || err != nil {
+		return
+	}
+
+	r, pw, err := os.Pipe()
+	if err != nil {
+		return
+	}
+
+	p.dot = copyPipe(w, r)
+	C.go_print_dot_graphs(p.c, C.int(pw.Fd()))
+
+	return pw.Close()
+}
+
+// SetTimeout limits the maximum duration that parsing should be allowed to
+// take before halting. Zero means no limit.
+//
+// It is the [time.Duration] based counterpart of [Parser.SetTimeoutMicros].
+func (p *Parser) SetTimeout(d time.Duration) {
+	p.SetTimeoutMicros(int(d.Microseconds()))
+}
+
+// Timeout returns the duration that parsing is allowed to take.
+func (p *Parser) Timeout() time.Duration {
+	return time.Duration(p.TimeoutMicros()) * time.Microsecond
+}
+
+// applyDeadline shortens the parser's timeout to the context's deadline, if
+// the context has one, returning a function that restores the original
+// timeout, as well as whether the deadline is now the effective limit.
+func (p *Parser) applyDeadline(ctx context.Context) (restore func(), byDeadline bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return func() {}, false
+	}
+
+	orig := p.Timeout()
+
+	d := max(time.Until(deadline), time.Microsecond)
+	if orig > 0 && orig <= d {
+		return func() {}, false
+	}
+
+	p.SetTimeout(d)
+
+	return func() { p.SetTimeout(orig) }, true
+}
+
+// Options returns a snapshot of the parser's current configuration.
+func (p *Parser) Options() ParserOptions {
+	return ParserOptions{
+		Language:         p.Language(),
+		CancellationFlag: p.CancellationFlag(),
+		IncludedRanges:   p.IncludedRanges(),
+		TimeoutMicros:    p.TimeoutMicros(),
+	}
+}
+
+// SetOptions restores the parser's configuration from a snapshot, in one call.
+//
+// The parser is also reset (see [Parser.Reset]), so that it is in a known state
+// afterwards. A nil language unsets the parser's language and a nil cancellation
+// flag is replaced by a new (unset) one. It returns a [LanguageError] if the
+// language is incompatible or [ErrInvalidRanges] if the ranges are not valid.
+func (p *Parser) SetOptions(opts ParserOptions) error {
+	p.Reset()
+
+	if opts.Language == nil {
+		C.ts_parser_set_language(p.c, nil)
+	} else if opts.Language.ptr == nil {
+		return ErrClosed
+	} else if !p.SetLanguage(opts.Language) {
+		return LanguageError(opts.Language.Version())
+	}
+
+	if opts.CancellationFlag == nil {
+		opts.CancellationFlag = new(uint64)
+	}
+
+	p.SetCancellationFlag(opts.CancellationFlag)
+	p.SetTimeoutMicros(opts.TimeoutMicros)
+
+	if !p.SetIncludedRanges(opts.IncludedRanges) {
+		return ErrInvalidRanges
+	}
+
+	return nil
+}
+
 // converts the tree-sitter response into a *Tree or an error.
 //
 // tree-sitter can fail for 3 reasons:
 // - cancelation
-// - operation limit hit
+// - operation limit hit (possibly derived from the context's deadline)
 // - no language set
 //
 // We check for all those conditions if there return value is nil.
 // See `Parse()` comment for further details.
-func (p *Parser) convertTSTree(ctx context.Context, tsTree *C.TSTree) (t *Tree, err error) {
+func (p *Parser) convertTSTree(ctx context.Context, tsTree *C.TSTree, byDeadline bool) (t *Tree, err error) {
 	if tsTree == nil {
 		if err = ctx.Err(); err != nil {
 			// reset cancellation flag so the parse can be re-used
@@ -329,6 +725,11 @@ func (p *Parser) convertTSTree(ctx context.Context, tsTree *C.TSTree) (t *Tree,
 			return nil, fmt.Errorf("failed converting TSTree -> Tree: %w", err)
 		}
 
+		if byDeadline && p.Language() != nil {
+			// the timeout derived from the deadline fired just before the context noticed
+			return nil, fmt.Errorf("failed converting TSTree -> Tree: %w", context.DeadlineExceeded)
+		}
+
 		if p.Language() == nil {
 			return nil, ErrNoLanguage
 		}
@@ -339,37 +740,164 @@ func (p *Parser) convertTSTree(ctx context.Context, tsTree *C.TSTree) (t *Tree,
 	return newTree(tsTree), nil
 }
 
-func (m *readFuncsMap) register(f ReadFunc) (id int) {
-	m.Lock()
-	defer m.Unlock()
+// parseDone reports the outcome of a parse of n bytes, that took d.
+func (p *Parser) parseDone(incremental bool, t *Tree, err error, n uint, d time.Duration) {
+	p.recordParse(incremental, t, err, n)
+	p.logParse(incremental, err, n, d)
+}
+
+func (p *Parser) releaseLogFunc() {
+	if p.logFn != 0 {
+		p.logFn.Delete()
+		p.logFn, p.log = 0, nil
+	}
+}
+
+// copyPipe copies r (the reading end of a pipe) to w in the background,
+// draining r past the first error (for the writing end not to block), which
+// is sent on the channel returned, once the writing end is closed.
+func copyPipe(w io.Writer, r *os.File) <-chan error {
+	done := make(chan error, 1)
+
+	go func() {
+		defer r.Close()
+
+		_, err := io.Copy(w, r)
+		if err != nil {
+			_, _ = io.Copy(io.Discard, r)
+		}
+
+		done <- err
+	}()
+
+	return done
+}
+
+// armCallbacks prepares the guards of the Go callbacks taking part in the
+// upcoming parse (the logger's included), so that a panic in any of them
+// cancels the parse.
+func (p *Parser) armCallbacks(guards ...*callbackGuard) {
+	if p.log != nil {
+		guards = append(guards, &p.log.callbackGuard)
+	}
+
+	for _, g := range guards {
+		g.cancel, g.err = p.cancel, nil
+	}
+}
+
+// callbackErr returns the error of the first callback that failed (or
+// panicked) during the parse, if any. In that case the (partial) tree is discarded and the
+// parser is reset, so that it can be used again.
+func (p *Parser) callbackErr(tsTree *C.TSTree, guards ...*callbackGuard) (err error) {
+	if p.log != nil {
+		guards = append(guards, &p.log.callbackGuard)
+	}
+
+	for _, g := range guards {
+		if err == nil {
+			err = g.err
+		}
+
+		g.cancel, g.err = nil, nil
+	}
 
-	m.index++
+	if err == nil {
+		return
+	}
+
+	if tsTree != nil {
+		C.ts_tree_delete(tsTree)
+	}
+
+	if p.cancel != nil {
+		atomic.StoreUint64(p.cancel, 0)
+	}
 
-	id = m.index
-	m.funcs[id] = f
+	p.Reset()
 
 	return
 }
 
-func (m *readFuncsMap) unregister(id int) {
-	m.Lock()
-	defer m.Unlock()
+// callbackGuard recovers from the panics of a Go callback invoked from C,
+// which must not unwind through the C stack frames. The first panic (or
+// error) is recorded and the parse is cancelled.
+type callbackGuard struct {
+	cancel *uint64
+	err    error
+}
 
-	delete(m.funcs, id)
+// readState is the value referenced by the handle passed to callReadFunc.
+type readState struct {
+	fn     ReadFunc2
+	decode DecodeFunction
+	// extent is the end offset of the text read so far.
+	extent uint
+	callbackGuard
 }
 
-func (m *readFuncsMap) get(id int) ReadFunc {
-	m.RLock()
-	defer m.RUnlock()
+// logState is the value referenced by the handle passed to callLogFunc.
+type logState struct {
+	fn LogFunc
+	callbackGuard
+}
 
-	return m.funcs[id]
+// recover must be deferred by the callback it guards.
+func (g *callbackGuard) recover() {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if err, ok := r.(error); ok {
+		g.fail(fmt.Errorf("%w: %w", ErrCallbackPanic, err))
+	} else {
+		g.fail(fmt.Errorf("%w: %v", ErrCallbackPanic, r))
+	}
+}
+
+// fail records the callback's error (unless it already failed) and cancels the parse.
+func (g *callbackGuard) fail(err error) {
+	if g.err == nil {
+		g.err = err
+	}
+
+	if g.cancel != nil {
+		atomic.StoreUint64(g.cancel, 1)
+	}
+}
+
+//export callLogFunc
+func callLogFunc(h C.uintptr_t, logType C.TSLogType, msg *C.char) {
+	log := cgo.Handle(h).Value().(*logState) //nolint:errcheck,forcetypeassert // we only ever store logStates
+	if log.err != nil {
+		return
+	}
+
+	defer log.recover()
+
+	log.fn(LogType(logType), C.GoString(msg))
 }
 
 //export callReadFunc
-func callReadFunc(id C.int, byteIndex C.uint32_t, pos C.TSPoint, bytesRead *C.uint32_t) *C.char {
-	readFunc := readFuncs.get(int(id))
-	content := readFunc(uint32(byteIndex), mkPoint(pos))
+func callReadFunc(h C.uintptr_t, byteIndex C.uint32_t, pos C.TSPoint, bytesRead *C.uint32_t) *C.char {
+	read := cgo.Handle(h).Value().(*readState) //nolint:errcheck,forcetypeassert // we only ever store readStates
+	*bytesRead = 0
+
+	if read.err != nil {
+		return nil
+	}
+
+	defer read.recover()
+
+	content, err := read.fn(uint32(byteIndex), mkPoint(pos))
+	if err != nil && !errors.Is(err, io.EOF) {
+		read.fail(err)
+		return nil
+	}
+
 	*bytesRead = C.uint32_t(len(content))
+	read.extent = max(read.extent, uint(byteIndex)+uint(len(content)))
 
 	// Note: This memory is freed inside the C code; see sitter.c
 	input := C.CBytes(content)
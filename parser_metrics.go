@@ -0,0 +1,89 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+)
+
+// Metrics receives the parser's counters, see [Parser.SetMetrics] (and
+// [NewParserPool] and [QueryCache.SetMetrics]).
+//
+// It is satisfied by [*expvar.Map] and is trivially adapted to other metrics
+// clients (e.g. Prometheus counters) via [MetricsFunc].
+type Metrics interface {
+	Add(name string, delta int64)
+}
+
+// MetricsFunc adapts a function to the [Metrics] interface.
+type MetricsFunc func(name string, delta int64)
+
+// The counters reported to [Metrics].
+const (
+	// MetricParses counts the parses (successful or not).
+	MetricParses = "parses"
+	// MetricIncrementalParses counts the parses that reused an old tree.
+	MetricIncrementalParses = "incremental_parses"
+	// MetricParseErrors counts the parses that produced trees with syntax errors.
+	MetricParseErrors = "parse_errors"
+	// MetricTimeouts counts the parses halted early (by a timeout, deadline or
+	// the cancellation flag).
+	MetricTimeouts = "timeouts"
+	// MetricFailures counts the parses that failed for any other reason.
+	MetricFailures = "failures"
+	// MetricBytesParsed counts the bytes of text parsed.
+	MetricBytesParsed = "bytes_parsed"
+	// MetricCacheHits counts the parsers reused by a [ParserPool] and the
+	// matches served (at least in part) from a [QueryCache].
+	MetricCacheHits = "cache_hits"
+)
+
+// Add implements [Metrics].
+func (fn MetricsFunc) Add(name string, delta int64) {
+	fn(name, delta)
+}
+
+// WithMetrics sets the parser's metrics, see [Parser.SetMetrics].
+func WithMetrics(m Metrics) ParserOption {
+	return func(p *Parser) error {
+		p.SetMetrics(m)
+		return nil
+	}
+}
+
+// SetMetrics sets where the parser reports its counters (see the Metric*
+// constants) to, after each parse. Passing nil disables reporting.
+func (p *Parser) SetMetrics(m Metrics) {
+	p.metrics = m
+}
+
+// Metrics returns the parser's metrics, if set.
+func (p *Parser) Metrics() Metrics {
+	return p.metrics
+}
+
+// recordParse reports the outcome of a parse of n bytes to the parser's metrics.
+func (p *Parser) recordParse(incremental bool, t *Tree, err error, n uint) {
+	m := p.metrics
+	if m == nil {
+		return
+	}
+
+	m.Add(MetricParses, 1)
+
+	if incremental {
+		m.Add(MetricIncrementalParses, 1)
+	}
+
+	switch {
+	case errors.Is(err, ErrOperationLimit), errors.Is(err, context.DeadlineExceeded):
+		m.Add(MetricTimeouts, 1)
+	case err != nil:
+		m.Add(MetricFailures, 1)
+	default:
+		m.Add(MetricBytesParsed, int64(n)) //nolint:gosec // n is bounded by the 4GB max text size
+
+		if t.RootNode().HasError() {
+			m.Add(MetricParseErrors, 1)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"strings"
+	"testing"
+)
+
+func TestParserSetMetrics(t *testing.T) {
+	t.Parallel()
+
+	m := new(expvar.Map).Init()
+
+	p, err := NewParserWith(WithLanguage(gr), WithMetrics(m))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if _, err = p.ParseString(context.Background(), tree, []byte("1 + 2")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	input := []byte("1 + (")
+	if _, err = p.Parse(context.Background(), nil, Input{Read: func(offset uint32, _ Point) []byte {
+		return input[min(int(offset), len(input)):]
+	}}); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	*p.CancellationFlag() = 1
+
+	long := []byte(strings.Repeat("1 + ", 1000) + "1")
+	if _, err = p.ParseString(context.Background(), nil, long); !errors.Is(err, ErrOperationLimit) {
+		t.Fatalf("Expected %v, got %v", ErrOperationLimit, err)
+	}
+
+	*p.CancellationFlag() = 0
+	p.Reset()
+
+	if err = p.SetOptions(ParserOptions{}); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if _, err = p.ParseString(context.Background(), nil, []byte("1")); err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	exp := `{"bytes_parsed": 15, "failures": 1, "incremental_parses": 1, "parse_errors": 1, "parses": 5, "timeouts": 1}`
+	if act := m.String(); act != exp {
+		t.Fatalf("Expected %s, got %s", exp, act)
+	}
+
+	p.SetMetrics(nil)
+
+	if p.Metrics() != nil {
+		t.Fatal("Expected no metrics")
+	}
+}
+
+func TestMetricsFuncAdd(t *testing.T) {
+	t.Parallel()
+
+	var act string
+
+	MetricsFunc(func(name string, _ int64) { act = name }).Add(MetricParses, 1)
+
+	if act != MetricParses {
+		t.Fatalf("Expected %q, got %q", MetricParses, act)
+	}
+}
+
+func TestWithMetrics(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestParserMetrics(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestParserRecordParse(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
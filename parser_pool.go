@@ -0,0 +1,87 @@
+package sitter
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// ParserPool is a pool of parsers, per language, for parsing many documents
+// concurrently (e.g. in a server), without creating a parser (and setting its
+// language) for each of them. Like [sync.Pool], its zero value is ready for
+// use and it is safe for concurrent use, while the parsers themselves are not.
+type ParserPool struct {
+	pools sync.Map // The *sync.Pool of each language, by its pointer.
+	opts  []ParserOption
+}
+
+// NewParserPool creates a parser pool, whose parsers are configured with the
+// given options (e.g. [WithMetrics], [WithSlog] or [WithTimeout]) each time
+// [ParserPool.Get] returns them.
+//
+// With [WithMetrics], the pool also reports the parsers it reuses to the
+// metrics, as [MetricCacheHits].
+func NewParserPool(opts ...ParserOption) *ParserPool {
+	return &ParserPool{opts: opts}
+}
+
+// Get returns a parser for the given language from the pool, or a new one if
+// none is available, configured with the pool's options, failing like
+// [WithLanguage] (or these options) does.
+func (pp *ParserPool) Get(lang *Language) (p *Parser, err error) {
+	reused := false
+
+	if lang != nil && lang.ptr != nil {
+		p, reused = pp.pool(lang.ptr).Get().(*Parser)
+	}
+
+	if !reused {
+		if p, err = NewParserWith(WithLanguage(lang)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, opt := range pp.opts {
+		if err = opt(p); err != nil {
+			return nil, err
+		}
+	}
+
+	if m := p.Metrics(); m != nil && reused {
+		m.Add(MetricCacheHits, 1)
+	}
+
+	return p, nil
+}
+
+// Put returns a parser to the pool, for the language it is set to (parsers
+// without one are dropped). The parser's state and [ParserOptions] are reset
+// first, to its language alone, while its other settings (e.g. its loggers,
+// metrics and dot graphs) are left as they are, until the pool's options
+// set them again. The parser must not be used afterwards.
+func (pp *ParserPool) Put(p *Parser) {
+	if p == nil {
+		return
+	}
+
+	lang := p.Language()
+	if lang == nil {
+		return
+	}
+
+	if err := p.SetOptions(ParserOptions{Language: lang}); err != nil {
+		return
+	}
+
+	pp.pool(lang.ptr).Put(p)
+}
+
+// pool returns the pool of the language at the given pointer.
+func (pp *ParserPool) pool(lang unsafe.Pointer) *sync.Pool {
+	if pool, ok := pp.pools.Load(lang); ok {
+		return pool.(*sync.Pool) //nolint:errcheck,forcetypeassert // we only ever store pools
+	}
+
+	pool, _ := pp.pools.LoadOrStore(lang, &sync.Pool{})
+
+	return pool.(*sync.Pool) //nolint:errcheck,forcetypeassert // we only ever store pools
+}
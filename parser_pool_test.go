@@ -0,0 +1,124 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewParserPool(t *testing.T) {
+	t.Parallel()
+
+	hits := int64(0)
+	pp := NewParserPool(WithMetrics(MetricsFunc(func(name string, delta int64) {
+		if name == MetricCacheHits {
+			hits += delta
+		}
+	})))
+
+	p, err := pp.Get(gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	pp.Put(p)
+
+	p2, err := pp.Get(gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := int64(0) // Unless reused, as the pool may drop the parser meanwhile.
+	if p2 == p {
+		exp = 1
+	}
+
+	if p2.Metrics() == nil || hits != exp {
+		t.Fatalf("Expected the pool's metrics and %d cache hits, got %v and %d", exp, p2.Metrics(), hits)
+	}
+}
+
+func TestParserPoolGet(t *testing.T) {
+	t.Parallel()
+
+	pp := &ParserPool{}
+
+	if _, err := pp.Get(nil); !errors.Is(err, ErrNoLanguage) {
+		t.Fatalf("Expected %v, got %v", ErrNoLanguage, err)
+	}
+
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 8)
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			p, err := pp.Get(gr)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			defer pp.Put(p)
+
+			p.SetTimeout(time.Second)
+
+			if _, err = p.ParseString(context.Background(), nil, []byte("1 + 2")); err != nil {
+				errs <- err
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	p, err := pp.Get(gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if opts := p.Options(); opts.Language == nil || opts.Language.ptr != gr.ptr || opts.TimeoutMicros != 0 {
+		t.Fatalf("Expected a parser reset to its language, got %+v", opts)
+	}
+}
+
+func TestParserPoolPut(t *testing.T) {
+	t.Parallel()
+
+	pp := NewParserPool(WithTimeout(time.Second))
+
+	p, err := pp.Get(gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	m := MetricsFunc(func(string, int64) {})
+	p.SetMetrics(m)
+	p.SetTimeout(time.Minute)
+	pp.Put(p)
+
+	// The pool's options are applied again, the other settings are kept (if
+	// the pool did not drop the parser meanwhile, as it may).
+	p2, err := pp.Get(gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := p2.Options().TimeoutMicros; act != 1e6 || (p2 == p && p2.Metrics() == nil) {
+		t.Fatalf("Expected the pool's timeout and the metrics kept, got %d and %v", act, p2.Metrics())
+	}
+}
+
+func TestParserPoolPool(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
@@ -0,0 +1,63 @@
+package sitter
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// WithSlog sets the parser's structured logger, see [Parser.SetSlog].
+func WithSlog(l *slog.Logger) ParserOption {
+	return func(p *Parser) error {
+		p.SetSlog(l)
+		return nil
+	}
+}
+
+// SetSlog routes the parser's diagnostics to the given structured logger:
+//   - a summary of each parse, with the bytes, duration, incremental and error
+//     attributes (at debug level, or warning level for failed parses);
+//   - the parser's own log messages, with a type attribute (parse or lex), at
+//     debug level, but only if that is enabled when the logger is set, as they
+//     are numerous.
+//
+// Use [slog.Logger.With] to add attributes such as the language or the file
+// name. This replaces any logger set with [Parser.SetLoggerFunc]. Passing nil
+// disables logging.
+func (p *Parser) SetSlog(l *slog.Logger) {
+	p.slog = l
+
+	if l == nil || !l.Enabled(context.Background(), slog.LevelDebug) {
+		p.SetLoggerFunc(nil)
+		return
+	}
+
+	p.SetLoggerFunc(func(t LogType, msg string) {
+		typ := "parse"
+		if t == LogTypeLex {
+			typ = "lex"
+		}
+
+		l.Debug(msg, "type", typ)
+	})
+}
+
+// Slog returns the parser's structured logger, if set.
+func (p *Parser) Slog() *slog.Logger {
+	return p.slog
+}
+
+// logParse logs the summary of a parse of n bytes.
+func (p *Parser) logParse(incremental bool, err error, n uint, d time.Duration) {
+	l := p.slog
+	if l == nil {
+		return
+	}
+
+	if err != nil {
+		l.Warn("parse failed", "incremental", incremental, "duration", d, "error", err)
+		return
+	}
+
+	l.Debug("parsed", "bytes", n, "incremental", incremental, "duration", d)
+}
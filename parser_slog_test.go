@@ -0,0 +1,74 @@
+package sitter
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestParserSetSlog(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("1 + ", 1000) + "1"
+	testCases := []struct {
+		input    string
+		exp      string
+		level    slog.Level
+		cancel   bool
+		messages bool
+	}{
+		{"1 + 2", "level=DEBUG msg=parsed lang=test bytes=5 incremental=false duration=", slog.LevelDebug, false, true},
+		{long, `level=WARN msg="parse failed" lang=test incremental=false duration=`, slog.LevelInfo, true, false},
+		{long, "", slog.LevelInfo, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.exp, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+			l := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: tc.level})).With("lang", "test")
+
+			p, err := NewParserWith(WithLanguage(gr), WithSlog(l))
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			if p.Slog() != l {
+				t.Fatal("Expected the logger to be set")
+			}
+
+			if tc.cancel {
+				*p.CancellationFlag() = 1
+			}
+
+			_, _ = p.ParseString(context.Background(), nil, []byte(tc.input))
+
+			act := buf.String()
+			if (tc.exp == "" && act != "") || !strings.Contains(act, tc.exp) {
+				t.Fatalf("Expected %q in\n%s", tc.exp, act)
+			}
+
+			if hasMessages := strings.Contains(act, "type=lex"); hasMessages != tc.messages {
+				t.Fatalf("Expected parser messages to be logged: %v, got\n%s", tc.messages, act)
+			}
+		})
+	}
+}
+
+func TestParserSlog(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestWithSlog(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestParserLogParse(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
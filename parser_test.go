@@ -1,6 +1,19 @@
 package sitter
 
-import "testing"
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestNewParser(t *testing.T) {
 	t.Parallel()
@@ -29,7 +42,19 @@ func TestParserSetIncludedRanges(t *testing.T) {
 
 func TestParserIncludedRanges(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+
+	if act := p.IncludedRanges(); len(act) != 1 || act[0].EndByte != math.MaxUint32 {
+		t.Fatal("Expected the whole document, got", act)
+	}
+
+	exp := []Range{{StartByte: 2, EndByte: 5, StartPoint: Point{Column: 2}, EndPoint: Point{Column: 5}}}
+	p.SetIncludedRanges(exp)
+
+	if act := p.IncludedRanges(); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
 }
 
 func TestParserParse(t *testing.T) {
@@ -37,14 +62,167 @@ func TestParserParse(t *testing.T) {
 	t.Skip("tested implicitly")
 }
 
+func TestParserParse2(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + 2 + 3")
+	errRead := errors.New("read failed")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	_, err := p.Parse2(context.Background(), nil, Input2{Read: func(offset uint32, _ Point) ([]byte, error) {
+		if offset > 0 {
+			return nil, errRead
+		}
+
+		return input[:2], nil
+	}})
+	if !errors.Is(err, errRead) {
+		t.Fatalf("Expected %v, got %v", errRead, err)
+	}
+
+	tree, err := p.Parse2(context.Background(), nil, Input2{Read: func(offset uint32, _ Point) ([]byte, error) {
+		if int(offset) >= len(input) {
+			return nil, io.EOF
+		}
+
+		return input[offset:], nil
+	}})
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := "(expression (sum left: (expression (sum left: (expression (number)) right: (expression (number)))) right: (expression (number))))" //nolint:lll // ok
+	if act := tree.RootNode().String(); act != exp {
+		t.Fatalf("Expected %s, got %s", exp, act)
+	}
+}
+
+func TestParserParseWithOptions(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	logged := 0
+	p.SetLoggerFunc(func(LogType, string) { logged++ })
+
+	// chunked reads the input in chunks of 4 bytes.
+	chunked := func(input string) Input2 {
+		return Input2{Read: func(offset uint32, _ Point) ([]byte, error) {
+			if int(offset) >= len(input) {
+				return nil, io.EOF
+			}
+
+			return []byte(input[offset:min(int(offset)+4, len(input))]), nil
+		}}
+	}
+
+	var states []ParseState
+
+	record := ParseOptions{OnProgress: func(s ParseState) bool {
+		states = append(states, s)
+		return true
+	}}
+
+	if _, err := p.ParseWithOptions(context.Background(), nil, chunked("1 + 2 + 3"), record); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if len(states) < 3 || states[len(states)-1].HasError || states[len(states)-1].CurrentByteOffset != 9 {
+		t.Fatal("Expected the progress through the whole text and no error, got", states)
+	}
+
+	if logged == 0 || p.LoggerFunc() == nil {
+		t.Fatal("Expected the logger to be called and restored")
+	}
+
+	states = nil
+
+	if _, err := p.ParseWithOptions(context.Background(), nil, chunked("1 + + 2"), record); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if !slices.ContainsFunc(states, func(s ParseState) bool { return s.HasError }) {
+		t.Fatal("Expected the error to be detected, got", states)
+	}
+
+	testCases := []struct {
+		input string
+		halt  func(ParseState) bool
+	}{
+		{"1 + 2 + 3", func(s ParseState) bool { return s.CurrentByteOffset < 4 }},
+		{"1 + + 2", func(s ParseState) bool { return !s.HasError }},
+	}
+
+	for _, tc := range testCases {
+		_, err := p.ParseWithOptions(context.Background(), nil, chunked(tc.input), ParseOptions{OnProgress: tc.halt})
+		if !errors.Is(err, ErrProgressHalted) {
+			t.Fatalf("Expected %v for %q, got %v", ErrProgressHalted, tc.input, err)
+		}
+	}
+
+	if _, err := p.ParseWithOptions(context.Background(), nil, chunked("1 + 2"), ParseOptions{}); err != nil {
+		t.Fatal("Expected the parser to be reusable, got", err)
+	}
+}
+
 func TestParserParseString(t *testing.T) {
 	t.Parallel()
-	t.Skip("tested implicitly")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	var errBusy error
+
+	_, err := p.Parse(context.Background(), nil, Input{Read: func(offset uint32, _ Point) []byte {
+		if offset == 0 {
+			_, errBusy = p.ParseString(context.Background(), nil, []byte("1"))
+			return []byte("1 + 2")
+		}
+
+		return nil
+	}})
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if !errors.Is(errBusy, ErrParserBusy) {
+		t.Fatalf("Expected %v, got %v", ErrParserBusy, errBusy)
+	}
+
+	if _, err = p.ParseString(context.Background(), nil, []byte("1")); err != nil {
+		t.Fatal("Expected parser to be reusable, got", err)
+	}
 }
 
 func TestParserReset(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	cancel := uint64(1)
+	p.SetCancellationFlag(&cancel)
+
+	input := []byte(strings.Repeat("1 + ", 1000) + "1")
+	if _, err := p.ParseString(context.Background(), nil, input); !errors.Is(err, ErrOperationLimit) {
+		t.Fatalf("Expected %v, got %v", ErrOperationLimit, err)
+	}
+
+	cancel = 0
+
+	p.Reset()
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := tree.RootNode().EndByte(); act != 5 {
+		t.Fatal("Expected the new input to be parsed from the start, got", act)
+	}
 }
 
 func TestParserSetTimeoutMicros(t *testing.T) {
@@ -64,7 +242,20 @@ func TestParserSetCancellationFlag(t *testing.T) {
 
 func TestParserCancellationFlag(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+
+	// A flag is set by default, for the context cancellation.
+	if act := p.CancellationFlag(); act == nil || *act != 0 {
+		t.Fatal("Expected a default flag, unset, got", act)
+	}
+
+	cancel := uint64(0)
+	p.SetCancellationFlag(&cancel)
+
+	if act := p.CancellationFlag(); act != &cancel {
+		t.Fatalf("Expected %p, got %p", &cancel, act)
+	}
 }
 
 func TestParserDebug(t *testing.T) {
@@ -79,12 +270,121 @@ func TestParserSetLogger(t *testing.T) {
 
 func TestParserLogger(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	logged := 0
+	p.SetLoggerFunc(func(LogType, string) { logged++ })
+
+	// Given back, the Go logger is kept.
+	p.SetLogger(p.Logger())
+
+	if _, err := p.ParseString(context.Background(), nil, []byte("1")); err != nil || logged == 0 {
+		t.Fatalf("Expected the logger to be called and no error, got %d calls and %v", logged, err)
+	}
+
+	p.SetLogger(NewParser().Logger())
+
+	if p.LoggerFunc() != nil {
+		t.Fatal("Expected no logger once replaced")
+	}
+}
+
+func TestParserLoggerFunc(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	if p.LoggerFunc() != nil {
+		t.Fatal("Expected no logger")
+	}
+
+	p.SetLoggerFunc(func(LogType, string) {})
+
+	if p.LoggerFunc() == nil {
+		t.Fatal("Expected a logger")
+	}
+
+	p.SetLoggerFunc(nil)
+
+	if p.LoggerFunc() != nil {
+		t.Fatal("Expected no logger once unset")
+	}
 }
 
 func TestParserPrintDotGraphs(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	name := filepath.Join(t.TempDir(), "parse.dot")
+	if err := p.PrintDotGraphs(name); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if _, err := p.ParseString(context.Background(), nil, []byte("1 + 2")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act, err := os.ReadFile(name); err != nil || !bytes.Contains(act, []byte("digraph stack {")) {
+		t.Fatalf("Expected the parse graphs and no error, got %q and %v", act, err)
+	}
+
+	if err := p.PrintDotGraphs(t.TempDir()); err == nil {
+		t.Fatal("Expected an error")
+	}
+}
+
+func TestParserWriteDotGraphs(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	buf := &bytes.Buffer{}
+	if err := p.WriteDotGraphs(buf); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if _, err := p.ParseString(context.Background(), nil, []byte("1 + 2")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if err := p.WriteDotGraphs(nil); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := buf.String(); !strings.Contains(act, "digraph stack {") || !strings.Contains(act, "digraph tree {") {
+		t.Fatal("Expected the parser's dot graphs, got", act)
+	}
+
+	n := buf.Len()
+	if _, err := p.ParseString(context.Background(), nil, []byte("1 + 2")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if buf.Len() != n {
+		t.Fatal("Expected no more dot graphs once turned off")
+	}
+
+	errBoom := errors.New("boom")
+	if err := p.WriteDotGraphs(errWriter{errBoom}); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if _, err := p.ParseString(context.Background(), nil, []byte("1 + 2")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if err := p.WriteDotGraphs(nil); !errors.Is(err, errBoom) {
+		t.Fatalf("Expected %v, got %v", errBoom, err)
+	}
+}
+
+func TestCopyPipe(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
 }
 
 func TestParserConvertTSTree(t *testing.T) {
@@ -109,5 +409,262 @@ func TestParserGet(t *testing.T) {
 
 func TestParserCallReadFunc(t *testing.T) {
 	t.Parallel()
+
+	input := []byte("1 + 2 + 3")
+	errBoom := errors.New("boom")
+	testCases := []struct {
+		panicWith any
+		exp       string
+	}{
+		{"boom", "callback panicked: boom"},
+		{errBoom, "callback panicked: boom"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.exp, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewParser()
+			p.SetLanguage(gr)
+
+			_, err := p.Parse(context.Background(), nil, Input{Read: func(offset uint32, _ Point) []byte {
+				if offset > 0 {
+					panic(tc.panicWith)
+				}
+
+				return input[:2]
+			}})
+			if !errors.Is(err, ErrCallbackPanic) || err.Error() != tc.exp {
+				t.Fatalf("Expected %q, got %v", tc.exp, err)
+			}
+
+			if e, ok := tc.panicWith.(error); ok && !errors.Is(err, e) {
+				t.Fatalf("Expected %v to wrap %v", err, e)
+			}
+
+			if *p.CancellationFlag() != 0 {
+				t.Fatal("Expected the cancellation flag to be cleared")
+			}
+
+			tree, err := p.Parse(context.Background(), nil, Input{Read: func(offset uint32, _ Point) []byte {
+				return input[min(int(offset), len(input)):]
+			}})
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			if act := tree.RootNode().String(); !strings.HasPrefix(act, "(expression (sum") {
+				t.Fatal("Expected a new parse to succeed, got", act)
+			}
+		})
+	}
+}
+
+func TestParserOptions(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+	p.SetTimeoutMicros(10)
+
+	ranges := []Range{{StartByte: 2, EndByte: 4, EndPoint: Point{Column: 4}, StartPoint: Point{Column: 2}}}
+	if !p.SetIncludedRanges(ranges) {
+		t.Fatal("Expected ranges to be set")
+	}
+
+	opts := p.Options()
+
+	if opts.Language.ptr != gr.ptr || opts.TimeoutMicros != 10 || !reflect.DeepEqual(opts.IncludedRanges, ranges) {
+		t.Fatalf("Unexpected options %+v", opts)
+	}
+
+	if opts.CancellationFlag != p.CancellationFlag() {
+		t.Fatal("Expected the cancellation flag to be preserved")
+	}
+
+	p2 := NewParser()
+	if err := p2.SetOptions(opts); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := p2.Options(); !reflect.DeepEqual(act, opts) {
+		t.Fatalf("Expected %+v, got %+v", opts, act)
+	}
+}
+
+func TestParserSetOptions(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+	p.SetTimeoutMicros(10)
+
+	if err := p.SetOptions(ParserOptions{}); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	opts := p.Options()
+	if opts.Language != nil || opts.TimeoutMicros != 0 || opts.CancellationFlag == nil {
+		t.Fatalf("Unexpected options %+v", opts)
+	}
+
+	bad := []Range{{StartByte: 4, EndByte: 6}, {StartByte: 0, EndByte: 2}}
+	if err := p.SetOptions(ParserOptions{IncludedRanges: bad}); !errors.Is(err, ErrInvalidRanges) {
+		t.Fatalf("Expected %v, got %v", ErrInvalidRanges, err)
+	}
+}
+
+func TestNewParserWith(t *testing.T) {
+	t.Parallel()
+
+	var msgs []string
+
+	p, err := NewParserWith(
+		WithLanguage(gr),
+		WithTimeout(time.Second),
+		WithLogger(func(_ LogType, msg string) { msgs = append(msgs, msg) }),
+	)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := p.TimeoutMicros(); act != 1_000_000 {
+		t.Fatalf("Expected timeout to be %d, got %d", 1_000_000, act)
+	}
+
+	if _, err = p.ParseString(context.Background(), nil, []byte("1 + 2")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if len(msgs) == 0 || msgs[len(msgs)-1] != "done" {
+		t.Fatalf("Expected log messages ending in %q, got %v", "done", msgs)
+	}
+
+	bad := []Range{{StartByte: 4, EndByte: 6}, {StartByte: 0, EndByte: 2}}
+	if _, err = NewParserWith(WithIncludedRanges(bad)); !errors.Is(err, ErrInvalidRanges) {
+		t.Fatalf("Expected %v, got %v", ErrInvalidRanges, err)
+	}
+
+	if _, err = NewParserWith(WithLanguage(nil)); !errors.Is(err, ErrNoLanguage) {
+		t.Fatalf("Expected %v, got %v", ErrNoLanguage, err)
+	}
+}
+
+func TestParserSetLoggerFunc(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	lexed := 0
+
+	p.SetLoggerFunc(func(typ LogType, _ string) {
+		if typ == LogTypeLex {
+			lexed++
+		}
+	})
+
+	if _, err := p.ParseString(context.Background(), nil, []byte("1 + 2")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if lexed == 0 {
+		t.Fatal("Expected lexing log messages")
+	}
+
+	p.SetLoggerFunc(nil)
+
+	if p.logFn != 0 {
+		t.Fatal("Expected the log func handle to be released")
+	}
+
+	lexed = 0
+
+	if _, err := p.ParseString(context.Background(), nil, []byte("1 + 2")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if lexed != 0 {
+		t.Fatal("Expected no more log messages, got", lexed)
+	}
+
+	p.SetLoggerFunc(func(LogType, string) { panic("boom") })
+
+	if _, err := p.ParseString(context.Background(), nil, []byte("1 + 2")); !errors.Is(err, ErrCallbackPanic) {
+		t.Fatalf("Expected %v, got %v", ErrCallbackPanic, err)
+	}
+
+	p.SetLoggerFunc(nil)
+
+	if _, err := p.ParseString(context.Background(), nil, []byte("1 + 2")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+}
+
+func TestParserArmCallbacks(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestParserCallbackErr(t *testing.T) {
+	t.Parallel()
 	t.Skip("tested implicitly")
 }
+
+func TestCallbackGuardRecover(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestParserSetTimeout(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetTimeout(1500 * time.Microsecond)
+
+	if act := p.TimeoutMicros(); act != 1500 {
+		t.Fatalf("Expected %d, got %d", 1500, act)
+	}
+
+	if act := p.Timeout(); act != 1500*time.Microsecond {
+		t.Fatalf("Expected %s, got %s", 1500*time.Microsecond, act)
+	}
+}
+
+func TestParserTimeout(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestParserApplyDeadline(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+	p.SetTimeout(time.Hour)
+
+	code := []byte(strings.Repeat("1 + ", 100_000) + "1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Microsecond)
+	defer cancel()
+
+	tree, err := p.ParseString(ctx, nil, code)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	if tree != nil {
+		t.Fatal("Expected tree to be nil, got", tree)
+	}
+
+	if act := p.Timeout(); act != time.Hour {
+		t.Fatalf("Expected the timeout to be restored to %s, got %s", time.Hour, act)
+	}
+}
+
+// errWriter fails all writes with its error.
+type errWriter struct{ err error }
+
+func (w errWriter) Write([]byte) (int, error) {
+	return 0, w.err
+}
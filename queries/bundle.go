@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidBundle is returned for bundles not laid out as expected.
+var ErrInvalidBundle = errors.New("invalid query bundle")
+
+// LoadBundle adds the query files of a bundle to the loader, below its own
+// and those of the bundles loaded before, which take precedence, publishing
+// a new generation (with all the queries to be compiled again). It returns
+// the languages the bundle has queries for, to be added to the loader (see
+// [Loader.AddLanguage]).
+//
+// A bundle is a file system (typically an [embed.FS]) laid out like the
+// loader's own: a folder per language, holding its query files (named after
+// their [Kind], with the ".scm" extension). Other files (e.g. a README or a
+// LICENSE) are ignored, but query files anywhere else make the bundle invalid.
+func (l *Loader) LoadBundle(fsys fs.FS) (langs []string, err error) {
+	if langs, err = bundleLanguages(fsys); err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	next := l.gen.Load().next()
+	next.fss = append(next.fss, fsys)
+	clear(next.cache)
+	l.gen.Store(next)
+
+	return
+}
+
+// bundleLanguages checks the layout of the bundle, returning its languages,
+// sorted.
+func bundleLanguages(fsys fs.FS) (langs []string, err error) {
+	err = fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != ".scm" {
+			return err
+		}
+
+		lang, file, ok := strings.Cut(name, "/")
+		if !ok || strings.Contains(file, "/") {
+			return fmt.Errorf("%w: unexpected query file %s", ErrInvalidBundle, name)
+		}
+
+		if !slices.Contains(langs, lang) {
+			langs = append(langs, lang)
+		}
+
+		return nil
+	})
+
+	return langs, err
+}
@@ -0,0 +1,100 @@
+package queries
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoaderLoadBundle(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{"calc/highlights.scm": {Data: []byte("(number) @override")}}
+	bundle := fstest.MapFS{
+		"LICENSE":             {Data: []byte("MIT")},
+		"calc/highlights.scm": {Data: []byte("(number) @number")},
+		"calc/tags.scm":       {Data: []byte("; inherits: calc2\n(sum) @sum")},
+		"calc2/tags.scm":      {Data: []byte("(expression) @expression")},
+	}
+
+	l := NewLoader(fsys)
+	l.AddLanguage("calc", gr)
+
+	if _, err := l.Load("calc", Tags); err == nil {
+		t.Fatal("Expected an error before loading the bundle")
+	}
+
+	langs, err := l.LoadBundle(bundle)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if exp := []string{"calc", "calc2"}; !reflect.DeepEqual(langs, exp) {
+		t.Fatalf("Expected %q, got %q", exp, langs)
+	}
+
+	testCases := []struct {
+		kind Kind
+		exp  []string
+	}{
+		{Highlights, []string{"override"}},
+		{Tags, []string{"expression", "sum"}},
+	}
+
+	for _, tc := range testCases {
+		q, err := l.Load("calc", tc.kind)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if act := q.CaptureNames(); !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %q for %s, got %q", tc.exp, tc.kind, act)
+		}
+	}
+
+	// Files removed from the loader's own fall back to the bundle's.
+	delete(fsys, "calc/highlights.scm")
+
+	exp := []Change{{Lang: "calc", Kind: Highlights, Generation: 3}}
+	if act := l.Refresh(); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+
+	if q, _ := l.Load("calc", Highlights); !reflect.DeepEqual(q.CaptureNames(), []string{"number"}) {
+		t.Fatal("Expected the bundled query, got", q.CaptureNames())
+	}
+
+	_, err = l.LoadBundle(fstest.MapFS{"highlights.scm": {}})
+	if !errors.Is(err, ErrInvalidBundle) || l.Generation().N != 3 {
+		t.Fatal("Expected an invalid bundle error, got", err)
+	}
+}
+
+func TestBundleLanguages(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		fsys fstest.MapFS
+		exp  []string
+		err  error
+	}{
+		{fstest.MapFS{}, nil, nil},
+		{fstest.MapFS{
+			"README.md": {}, "go/highlights.scm": {}, "go/tags.scm": {}, "c/tags.scm": {}, "c/docs/notes.txt": {},
+		}, []string{"c", "go"}, nil},
+		{fstest.MapFS{"highlights.scm": {}}, nil, ErrInvalidBundle},
+		{fstest.MapFS{"go/old/highlights.scm": {}}, nil, ErrInvalidBundle},
+	}
+
+	for _, tc := range testCases {
+		act, err := bundleLanguages(tc.fsys)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("Expected %v, got %v", tc.err, err)
+		}
+
+		if err == nil && !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %q, got %q", tc.exp, act)
+		}
+	}
+}
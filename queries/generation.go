@@ -0,0 +1,165 @@
+package queries
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"slices"
+	"sync"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Generation is a snapshot of the languages and the queries of a [Loader].
+// It never changes, except for compiling the queries lazily, so that the
+// operations using it are unaffected by reloads. Its number (N) increases
+// with each new generation, e.g. for invalidating the caches depending on it.
+type Generation struct {
+	langs map[string]*sitter.Language
+	cache map[key]*entry
+	// fss are the file systems to read the query files from, in order.
+	fss []fs.FS
+	N   uint64
+	mu  sync.Mutex
+}
+
+// Grammar is a language that can change while running, e.g. one loaded from
+// a shared library that may be rebuilt, see [Loader.AddGrammar].
+type Grammar interface {
+	// Load loads the language.
+	Load() (*sitter.Language, error)
+	// Changed reports whether the language changed since last loaded.
+	Changed() bool
+}
+
+// AddGrammar loads the grammar and adds its language to the loader, like
+// [Loader.AddLanguage], reloading it when it changes (see [Loader.Refresh]).
+func (l *Loader) AddGrammar(name string, g Grammar) error {
+	lang, err := g.Load()
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.grammars[name] = g
+	next := l.gen.Load().next()
+	next.setLanguage(name, lang)
+	l.gen.Store(next)
+
+	return nil
+}
+
+// Language returns the named language, if any.
+func (g *Generation) Language(name string) (lang *sitter.Language, ok bool) {
+	lang, ok = g.langs[name]
+	return
+}
+
+// Load returns the compiled query of the given kind, for the named language,
+// see [Loader.Load].
+func (g *Generation) Load(lang string, kind Kind) (*sitter.Query, error) {
+	e, err := g.entry(lang, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.query, nil
+}
+
+// Source returns the source of the query of the given kind, for the named
+// language, see [Loader.Source].
+func (g *Generation) Source(lang string, kind Kind) (string, error) {
+	e, err := g.entry(lang, kind)
+	if err != nil {
+		return "", err
+	}
+
+	return e.source, nil
+}
+
+// newGeneration returns the first generation of a loader, reading the query
+// files from fsys (if not nil).
+func newGeneration(fsys fs.FS) (g *Generation) {
+	g = &Generation{langs: map[string]*sitter.Language{}, cache: map[key]*entry{}}
+	if fsys != nil {
+		g.fss = []fs.FS{fsys}
+	}
+
+	return
+}
+
+// next returns a copy of the generation, numbered after it.
+func (g *Generation) next() *Generation {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return &Generation{langs: maps.Clone(g.langs), cache: maps.Clone(g.cache), fss: slices.Clip(g.fss), N: g.N + 1}
+}
+
+// setLanguage sets a language, dropping its queries (compiled for the
+// previous one, if any). Only for generations not published yet.
+func (g *Generation) setLanguage(name string, lang *sitter.Language) {
+	g.langs[name] = lang
+
+	for k := range g.cache {
+		if k.lang == name {
+			delete(g.cache, k)
+		}
+	}
+}
+
+// entry returns the cached query, building it if needed.
+func (g *Generation) entry(lang string, kind Kind) (e *entry, err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	k := key{lang: lang, kind: kind}
+	if e = g.cache[k]; e != nil {
+		return
+	}
+
+	if e, err = g.build(k); err != nil {
+		return nil, err
+	}
+
+	g.cache[k] = e
+
+	return
+}
+
+// build reads and compiles a query. On errors, the entry is still returned
+// (unless the language is unknown), for the hashes of the files read.
+func (g *Generation) build(k key) (e *entry, err error) {
+	lang, ok := g.langs[k.lang]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLanguage, k.lang)
+	}
+
+	e = &entry{hashes: map[string][sha256.Size]byte{}}
+	if e.source, err = g.read(k.lang, k.kind, false, e.hashes, nil); err != nil {
+		return
+	}
+
+	if e.query, err = sitter.NewQuery(lang, []byte(e.source)); err != nil {
+		err = fmt.Errorf("%s: %w", filePath(k.lang, k.kind), err)
+	}
+
+	return
+}
+
+// readFile reads the named file from the first file system having it.
+func (g *Generation) readFile(name string) (b []byte, err error) {
+	err = fs.ErrNotExist
+
+	for _, fsys := range g.fss {
+		if b, err = fs.ReadFile(fsys, name); !errors.Is(err, fs.ErrNotExist) {
+			return
+		}
+	}
+
+	return
+}
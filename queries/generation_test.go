@@ -0,0 +1,95 @@
+package queries
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+func TestLoaderAddGrammar(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderGeneration()")
+}
+
+func TestGenerationLanguage(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderGeneration()")
+}
+
+func TestGenerationLoad(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderGeneration()")
+}
+
+func TestGenerationSource(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderGeneration()")
+}
+
+func TestNewGeneration(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestGenerationNext(t *testing.T) {
+	t.Parallel()
+
+	g := newGeneration(nil)
+	g.cache[key{lang: "a"}] = &entry{}
+
+	next := g.next()
+	next.cache[key{lang: "b"}] = &entry{}
+	next.langs["b"] = nil
+
+	if next.N != 1 || len(next.cache) != 2 || len(g.cache) != 1 || len(g.langs) != 0 {
+		t.Fatal("Expected an independent copy, numbered after the generation")
+	}
+}
+
+func TestGenerationSetLanguage(t *testing.T) {
+	t.Parallel()
+
+	g := newGeneration(nil)
+	g.cache = map[key]*entry{{lang: "a", kind: Tags}: {}, {lang: "a", kind: Locals}: {}, {lang: "b", kind: Tags}: {}}
+
+	lang := &sitter.Language{}
+	g.setLanguage("a", lang)
+
+	if len(g.cache) != 1 || g.cache[key{lang: "b", kind: Tags}] == nil || g.langs["a"] != lang {
+		t.Fatal("Expected the queries of the language to be dropped")
+	}
+}
+
+func TestGenerationEntry(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderGeneration()")
+}
+
+func TestGenerationBuild(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderGeneration()")
+}
+
+func TestGenerationReadFile(t *testing.T) {
+	t.Parallel()
+
+	g := newGeneration(fstest.MapFS{"a/tags.scm": {Data: []byte("a")}})
+	g.fss = append(g.fss, fstest.MapFS{"a/tags.scm": {Data: []byte("b")}, "b/tags.scm": {Data: []byte("c")}})
+
+	for name, exp := range map[string]string{"a/tags.scm": "a", "b/tags.scm": "c"} {
+		if act, err := g.readFile(name); err != nil || string(act) != exp {
+			t.Fatalf("Expected %q for %s, got %q (%v)", exp, name, act, err)
+		}
+	}
+
+	if _, err := g.readFile("c/tags.scm"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatal("Expected a not exist error, got", err)
+	}
+
+	if _, err := newGeneration(nil).readFile("a/tags.scm"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatal("Expected a not exist error, got", err)
+	}
+}
@@ -0,0 +1,328 @@
+// Package queries loads the query files of languages (highlights, tags,
+// locals, etc.) from a file system, e.g. an [embed.FS], compiling (and thus
+// validating) and caching them, and reloading them when they change.
+//
+// The files are laid out by language, nvim-treesitter style:
+//
+//	go/highlights.scm
+//	go/tags.scm
+//	typescript/highlights.scm
+//
+// A file may start with an "; inherits: lang1,lang2" comment, in which case
+// the queries of the same kind of the listed languages are prepended to its
+// own (the languages listed in parentheses being optional), recursively. The
+// queries inherited more than once are only included once, while cycles are
+// reported as errors (see [ErrInheritanceCycle]).
+//
+// More query files can be layered below the loader's own, from bundles (see
+// [Loader.LoadBundle]), e.g. for shipping default queries with an application.
+//
+// The languages and their queries are held by immutable (but for the lazily
+// compiled queries) [Generation]s: reloading changed files (or grammars, see
+// [Grammar]) publishes a new generation, while the operations in flight keep
+// using the one they started with.
+package queries
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Kind is a kind of query file.
+type Kind string
+
+// The common kinds of query files.
+const (
+	Highlights Kind = "highlights"
+	Tags       Kind = "tags"
+	Injections Kind = "injections"
+	Locals     Kind = "locals"
+	Folds      Kind = "folds"
+	Indents    Kind = "indents"
+)
+
+// Loader loads the query files of languages, see [NewLoader].
+type Loader struct {
+	grammars map[string]Grammar
+	gen      atomic.Pointer[Generation]
+	// mu serializes the publishing of new generations.
+	mu sync.Mutex
+}
+
+// Change is the outcome of reloading a changed query file (or grammar, in
+// which case Kind is empty), see [Loader.Refresh]. If Err is set, the previous
+// query (or grammar) is kept.
+type Change struct {
+	Err  error
+	Lang string
+	Kind Kind
+	// Generation is the number of the generation current after the change.
+	Generation uint64
+}
+
+// key identifies a query.
+type key struct {
+	lang string
+	kind Kind
+}
+
+// entry is a cached query, along with the hashes of the files it was built
+// from (a missing file having a zero hash).
+type entry struct {
+	query  *sitter.Query
+	hashes map[string][sha256.Size]byte
+	source string
+}
+
+// Possible errors.
+var (
+	ErrUnknownLanguage = errors.New("unknown language")
+	// ErrInheritanceCycle is returned for query files inheriting (directly or
+	// not) from themselves.
+	ErrInheritanceCycle = errors.New("inheritance cycle")
+)
+
+const inheritsPrefix = "inherits:"
+
+// NewLoader returns a loader of the query files found in fsys, which can be
+// nil if all the query files come from bundles.
+func NewLoader(fsys fs.FS) (l *Loader) {
+	l = &Loader{grammars: map[string]Grammar{}}
+	l.gen.Store(newGeneration(fsys))
+
+	return
+}
+
+// AddLanguage adds a language to the loader, under the name of its folder,
+// publishing a new generation.
+func (l *Loader) AddLanguage(name string, lang *sitter.Language) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	next := l.gen.Load().next()
+	next.setLanguage(name, lang)
+	l.gen.Store(next)
+}
+
+// Generation returns the current generation.
+func (l *Loader) Generation() *Generation {
+	return l.gen.Load()
+}
+
+// Load returns the compiled query of the given kind, for the named language,
+// from the current generation. The errors of reading or compiling the file(s)
+// are returned, wrapped.
+func (l *Loader) Load(lang string, kind Kind) (*sitter.Query, error) {
+	return l.Generation().Load(lang, kind)
+}
+
+// Source returns the source of the query of the given kind, for the named
+// language (with the inherited queries prepended), once validated, from the
+// current generation. This is useful for the packages taking queries as text
+// (e.g. binding).
+func (l *Loader) Source(lang string, kind Kind) (string, error) {
+	return l.Generation().Source(lang, kind)
+}
+
+// Refresh reloads the changed grammars (see [Grammar]) and the compiled
+// queries whose files changed (in content), publishing a new generation if
+// anything changed (or failed to, for the queries), and returns the changes: the grammars' first, then the
+// queries', sorted by language, then by kind.
+func (l *Loader) Refresh() (changes []Change) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cur := l.gen.Load()
+	next, dirty := cur.next(), false
+
+	for _, name := range slices.Sorted(maps.Keys(l.grammars)) {
+		if !l.grammars[name].Changed() {
+			continue
+		}
+
+		lang, err := l.grammars[name].Load()
+		if err == nil {
+			next.setLanguage(name, lang)
+			dirty = true
+		}
+
+		changes = append(changes, Change{Lang: name, Err: err})
+	}
+
+	for _, k := range slices.SortedFunc(maps.Keys(next.cache), func(a, b key) int {
+		return strings.Compare(a.lang+"/"+string(a.kind), b.lang+"/"+string(b.kind))
+	}) {
+		e := next.cache[k]
+		if !next.changed(e) {
+			continue
+		}
+
+		// On errors, the new hashes are kept, so that the change is reported once.
+		built, err := next.build(k)
+		if err == nil {
+			next.cache[k] = built
+		} else if built != nil {
+			kept := *e
+			kept.hashes = built.hashes
+			next.cache[k] = &kept
+		}
+
+		changes = append(changes, Change{Lang: k.lang, Kind: k.kind, Err: err})
+		dirty = true
+	}
+
+	n := cur.N
+	if dirty {
+		l.gen.Store(next)
+		n = next.N
+	}
+
+	for i := range changes {
+		changes[i].Generation = n
+	}
+
+	return
+}
+
+// Watch refreshes the loader (see [Loader.Refresh]) at the given interval,
+// until the context is done, sending the changes to the returned channel
+// (which is closed then).
+func (l *Loader) Watch(ctx context.Context, interval time.Duration) <-chan Change {
+	ch := make(chan Change)
+
+	go func() {
+		defer close(ch)
+
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+
+			for _, c := range l.Refresh() {
+				select {
+				case ch <- c:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// read reads the query file of a language, with the files it inherits from
+// prepended, recording the hashes of all the files read. Files already read
+// (e.g. inherited twice) are skipped, but inheriting from one of the files of
+// the chain of inheritance leading to it (languages inheriting from lang, in
+// order) is an error.
+func (g *Generation) read(lang string, kind Kind, optional bool, hashes map[string][sha256.Size]byte,
+	chain []string,
+) (string, error) {
+	name := filePath(lang, kind)
+	if slices.Contains(chain, lang) {
+		return "", fmt.Errorf("%w: %s", ErrInheritanceCycle, strings.Join(append(chain, lang), " -> "))
+	}
+
+	if _, ok := hashes[name]; ok {
+		return "", nil
+	}
+
+	b, err := g.readFile(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		hashes[name] = [sha256.Size]byte{}
+	}
+
+	switch {
+	case optional && errors.Is(err, fs.ErrNotExist):
+		return "", nil
+	case err != nil:
+		return "", err
+	}
+
+	hashes[name] = sha256.Sum256(b)
+
+	var source strings.Builder
+
+	for _, parent := range inherits(string(b)) {
+		opt := strings.HasPrefix(parent, "(") && strings.HasSuffix(parent, ")")
+
+		text, err := g.read(strings.Trim(parent, "()"), kind, opt, hashes, append(slices.Clip(chain), lang))
+		if err != nil {
+			return "", err
+		}
+
+		source.WriteString(text)
+	}
+
+	source.Write(b)
+	source.WriteString("\n")
+
+	return source.String(), nil
+}
+
+// changed reports whether any of the files of the entry changed.
+func (g *Generation) changed(e *entry) bool {
+	for name, hash := range e.hashes {
+		b, err := g.readFile(name)
+		if err != nil {
+			if hash != ([sha256.Size]byte{}) || !errors.Is(err, fs.ErrNotExist) {
+				return true
+			}
+
+			continue
+		}
+
+		if sha256.Sum256(b) != hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inherits returns the languages listed by the "; inherits:" comment at the
+// start of the query source, if any.
+func inherits(source string) (langs []string) {
+	sc := bufio.NewScanner(strings.NewReader(source))
+
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if !strings.HasPrefix(line, ";") {
+			return
+		}
+
+		if list, ok := strings.CutPrefix(strings.TrimSpace(strings.TrimLeft(line, ";")), inheritsPrefix); ok {
+			for _, lang := range strings.Split(list, ",") {
+				if lang = strings.TrimSpace(lang); lang != "" {
+					langs = append(langs, lang)
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// filePath returns the path of the query file of a language.
+func filePath(lang string, kind Kind) string {
+	return path.Join(lang, string(kind)+".scm")
+}
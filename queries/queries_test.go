@@ -0,0 +1,294 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestNewLoader(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderLoad()")
+}
+
+func TestLoaderAddLanguage(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderLoad()")
+}
+
+func TestLoaderGeneration(t *testing.T) {
+	t.Parallel()
+
+	l := NewLoader(fstest.MapFS{"calc/highlights.scm": {Data: []byte("(number) @number")}})
+	g := &testGrammar{}
+
+	if err := l.AddGrammar("calc", g); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	gen1 := l.Generation()
+	lang1, _ := gen1.Language("calc")
+
+	q1, err := gen1.Load("calc", Highlights)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	g.changed = true
+
+	exp := []Change{{Lang: "calc", Generation: 2}}
+	if act := l.Refresh(); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+
+	gen2 := l.Generation()
+	if lang2, ok := gen2.Language("calc"); gen2.N != 2 || !ok || lang2 == lang1 {
+		t.Fatal("Expected a new generation, with the reloaded language")
+	}
+
+	// The previous generation is unaffected.
+	if q, _ := gen1.Load("calc", Highlights); q != q1 {
+		t.Fatal("Expected the previous generation to keep its query")
+	}
+
+	if q, _ := gen2.Load("calc", Highlights); q == q1 {
+		t.Fatal("Expected the query to be recompiled")
+	}
+
+	g.changed, g.err = true, errors.New("boom")
+
+	exp = []Change{{Lang: "calc", Err: g.err, Generation: 2}}
+	if act := l.Refresh(); !reflect.DeepEqual(act, exp) || l.Generation() != gen2 {
+		t.Fatalf("Expected %+v and no new generation, got %+v", exp, act)
+	}
+
+	if err = l.AddGrammar("calc3", g); !errors.Is(err, g.err) {
+		t.Fatal("Expected an error, got", err)
+	}
+}
+
+func TestLoaderLoad(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"calc/highlights.scm": {Data: []byte("(number) @number")},
+		"calc/tags.scm":       {Data: []byte("(nope) @nope")},
+		"calc2/highlights.scm": {Data: []byte(
+			"; Calc, with sums.\n; inherits: calc, (missing)\n\n(sum) @sum"),
+		},
+	}
+
+	l := NewLoader(fsys)
+	l.AddLanguage("calc", gr)
+	l.AddLanguage("calc2", gr)
+
+	q, err := l.Load("calc", Highlights)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if q2, _ := l.Load("calc", Highlights); q2 != q {
+		t.Fatal("Expected the query to be cached")
+	}
+
+	var qErr *sitter.QueryError
+
+	_, err = l.Load("calc", Tags)
+	if !errors.As(err, &qErr) || !strings.HasPrefix(err.Error(), "calc/tags.scm: ") {
+		t.Fatal("Expected a query error, got", err)
+	}
+
+	if _, err = l.Load("calc", Locals); err == nil {
+		t.Fatal("Expected an error for a missing file")
+	}
+
+	if _, err = l.Load("go", Highlights); !errors.Is(err, ErrUnknownLanguage) {
+		t.Fatal("Expected an unknown language error, got", err)
+	}
+
+	if q, err = l.Load("calc2", Highlights); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act, exp := q.CaptureNames(), []string{"number", "sum"}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	if src, _ := l.Source("calc2", Highlights); !strings.HasPrefix(src, "(number) @number\n; Calc") {
+		t.Fatalf("Expected the inherited queries first, got %q", src)
+	}
+
+	if act := l.Refresh(); len(act) != 0 {
+		t.Fatal("Expected no changes, got", act)
+	}
+
+	fsys["calc/highlights.scm"] = &fstest.MapFile{Data: []byte("(number) @num")}
+	fsys["missing/highlights.scm"] = &fstest.MapFile{Data: []byte("(comment) @comment")}
+
+	exp := []Change{
+		{Lang: "calc", Kind: Highlights, Generation: 3},
+		{Lang: "calc2", Kind: Highlights, Generation: 3},
+	}
+	if act := l.Refresh(); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+
+	q, _ = l.Load("calc2", Highlights)
+	if act := q.CaptureNames(); !reflect.DeepEqual(act, []string{"num", "comment", "sum"}) {
+		t.Fatal("Expected the query to be reloaded, got", act)
+	}
+
+	fsys["calc/highlights.scm"] = &fstest.MapFile{Data: []byte("(number @num")}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := l.Watch(ctx, time.Millisecond)
+
+	for _, lang := range []string{"calc", "calc2"} {
+		if c := <-changes; c.Lang != lang || c.Err == nil {
+			t.Fatalf("Expected an error for %s, got %+v", lang, c)
+		}
+	}
+
+	if q, _ = l.Load("calc", Highlights); !reflect.DeepEqual(q.CaptureNames(), []string{"num"}) {
+		t.Fatal("Expected the previous query to be kept, got", q.CaptureNames())
+	}
+
+	// The (still broken) files are not reported again.
+	select {
+	case c := <-changes:
+		t.Fatalf("Expected no more changes, got %+v", c)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	cancel()
+
+	if _, ok := <-changes; ok {
+		t.Fatal("Expected the channel to be closed")
+	}
+}
+
+func TestLoaderSource(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderLoad()")
+}
+
+func TestLoaderRefresh(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderLoad()")
+}
+
+func TestLoaderWatch(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderLoad()")
+}
+
+func TestGenerationRead(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderGeneration()")
+}
+
+func TestGenerationChanged(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestLoaderGeneration()")
+}
+
+func TestInherits(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		source string
+		exp    []string
+	}{
+		{"(a) @a", nil},
+		{"; inherits: ecma,jsx\n(a) @a", []string{"ecma", "jsx"}},
+		{";; Comment\n;inherits: ecma, (jsx) ,\n", []string{"ecma", "(jsx)"}},
+		{"(a) @a\n; inherits: ecma", nil},
+		{"; inherits ecma", nil},
+	}
+
+	for _, tc := range testCases {
+		if act := inherits(tc.source); !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %q for %q, got %q", tc.exp, tc.source, act)
+		}
+	}
+}
+
+func TestFilePath(t *testing.T) {
+	t.Parallel()
+
+	if act := filePath("go", Tags); act != "go/tags.scm" {
+		t.Fatal("Expected go/tags.scm, got", act)
+	}
+}
+
+// testGrammar is a [Grammar] changing on demand.
+type testGrammar struct {
+	err     error
+	changed bool
+}
+
+func (g *testGrammar) Load() (*sitter.Language, error) {
+	g.changed = false
+
+	if g.err != nil {
+		return nil, g.err
+	}
+
+	return gr.Copy(), nil
+}
+
+func (g *testGrammar) Changed() bool {
+	return g.changed
+}
+
+func TestLoaderInheritance(t *testing.T) {
+	t.Parallel()
+
+	l := NewLoader(fstest.MapFS{
+		"ecma/highlights.scm":       {Data: []byte("; inherits: base\n(sum) @sum")},
+		"jsx/highlights.scm":        {Data: []byte("; inherits: base\n(expression) @expression")},
+		"typescript/highlights.scm": {Data: []byte("; inherits: ecma,jsx\n(number) @ts")},
+		"base/highlights.scm":       {Data: []byte("(number) @number")},
+		"a/highlights.scm":          {Data: []byte("; inherits: b\n(number) @a")},
+		"b/highlights.scm":          {Data: []byte("; inherits: (c)\n(number) @b")},
+		"c/highlights.scm":          {Data: []byte("; inherits: a\n(number) @c")},
+	})
+
+	for _, lang := range []string{"typescript", "a", "b"} {
+		l.AddLanguage(lang, gr)
+	}
+
+	q, err := l.Load("typescript", Highlights)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// The base queries, inherited twice, are only included once.
+	if act, exp := q.CaptureNames(), []string{"number", "sum", "expression", "ts"}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	testCases := []struct {
+		lang, exp string
+	}{
+		{"a", "inheritance cycle: a -> b -> c -> a"},
+		{"b", "inheritance cycle: b -> c -> a -> b"},
+	}
+
+	for _, tc := range testCases {
+		_, err = l.Load(tc.lang, Highlights)
+		if !errors.Is(err, ErrInheritanceCycle) || err.Error() != tc.exp {
+			t.Fatalf("Expected %q, got %v", tc.exp, err)
+		}
+	}
+}
@@ -5,12 +5,19 @@ import "C"
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"iter"
+	"maps"
 	"regexp"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 )
 
@@ -22,17 +29,55 @@ type Query struct {
 
 	captureNames       []string
 	captureQuantifiers [][]CaptureQuantifier
+	// TextPredicates are the text predicates of each pattern. They may be
+	// changed after the query is created (though not while it is executed),
+	// see newEqFilters.
 	TextPredicates     [][]TextPredicateCapture
 	propertySettings   [][]QueryProperty
 	propertyPredicates [][]PropertyPredicate
 	generalPredicates  [][]QueryPredicate
+	matchPredicates    [][]MatchPredicate
+	patternStarts      []Point
+	predicators        map[string]ContextPredicator
+	scopedCaptures     map[string]bool
+	lang               *Language
+	// eqFilters are the #eq? string predicates, checked in C (see nextMatch),
+	// as held by eqFilterSpecs (per pattern).
+	eqFilters     *C.GoEqFilters
+	eqFilterSpecs [][]eqFilter
 
 	once sync.Once
 }
 
+// QueryOption configures a [Query] at creation time.
+type QueryOption func(*Query)
+
 type Predicator func(_ *Query, _ QueryPredicateSteps, op string, row uint,
 	strVal, cptVal func(int) func() string) (any, error)
 
+// ContextPredicator is like [Predicator], except that it receives all the
+// details about the predicate being processed in a [PredicateContext].
+type ContextPredicator func(PredicateContext) (any, error)
+
+// PredicateContext holds the details about a predicate, passed to a
+// [ContextPredicator] at query creation time.
+type PredicateContext struct {
+	Query    *Query
+	Language *Language
+	// Op is the predicate's name (e.g. "eq?").
+	Op string
+	// Steps are the predicate's steps, the first one being the name.
+	Steps QueryPredicateSteps
+	// StartByte and EndByte are the offsets of the pattern in the query source.
+	StartByte    uint
+	EndByte      uint
+	PatternIndex uint
+	// Start is the position of the pattern in the query source.
+	Start Point
+
+	stringValues []string
+}
+
 type CaptureQuantifier = C.TSQuantifier
 
 type TextPredicateCapture struct {
@@ -41,6 +86,9 @@ type TextPredicateCapture struct {
 	CaptureID     uint
 	Positive      bool
 	MatchAllNodes bool
+	// Scoped predicates only drop the captured nodes they fail for, rather than
+	// the whole match (see [WherePredicatesApplyTo]).
+	Scoped bool
 }
 
 type TextPredicateType int
@@ -67,18 +115,39 @@ type PropertyPredicate struct {
 	Positive bool
 }
 
+// MatchPredicate is a predicate checked against each match of its pattern, the
+// match being dropped unless it holds. Predicators can return one to implement
+// custom predicates (e.g. #has-parent? or #kind-of?), which can look at the
+// captured nodes, not just at their text. Since the captures of the match are
+// reused by the cursor, they must not be kept.
+type MatchPredicate func(m *QueryMatch, text []byte) bool
+
 // QueryCursor is a stateful struct used to execute a query on a tree.
 type QueryCursor struct {
 	c    *C.TSQueryCursor
 	once sync.Once
+	// maxMatchLimit is the limit up to which the match limit is raised, see
+	// [WithAdaptiveMatchLimit].
+	maxMatchLimit uint32
 }
 
+// QueryCursorOption configures a [QueryCursor] created with [NewQueryCursorWith].
+type QueryCursorOption func(*QueryCursor)
+
 // QueryCapture is a captured node by a query with an index.
 type QueryCapture struct {
 	Node  Node
 	Index uint32
 }
 
+// CaptureSummary is the summary of a captured node, see
+// [QueryMatch.CaptureSummaries].
+type CaptureSummary struct {
+	Range  Range
+	Index  uint32
+	Symbol Symbol
+}
+
 // QueryMatch allows you to iterate over the matches.
 type QueryMatch struct {
 	cursor       *QueryCursor
@@ -89,9 +158,28 @@ type QueryMatch struct {
 
 // QueryMatches holds a sequence of [QueryMatch]es associated with a given [QueryCursor].
 type QueryMatches struct {
-	cursor *QueryCursor
-	query  *Query
-	text   []byte
+	haltAt time.Time
+	ctx    context.Context //nolint:containedctx // only used to check for cancellation
+	// status is shared by the copies of the matches (e.g. the one iterated
+	// over by [QueryMatches.All]), as is the cursor.
+	status     *matchesStatus
+	cursor     *QueryCursor
+	query      *Query
+	text       []byte
+	byDeadline bool
+	node       Node
+	progress   func(offset uint32) bool
+	// seen holds the keys of the matches returned so far, for skipping them
+	// when the execution is retried with a higher match limit.
+	seen map[string]struct{}
+	// eqFilters are the query's ones, if still valid (see Query.newEqFilters).
+	eqFilters *C.GoEqFilters
+}
+
+// matchesStatus is the state of an execution, see [QueryMatches].
+type matchesStatus struct {
+	err    error
+	offset uint32
 }
 
 // QueryCaptures holds a sequence of [QueryCapture]s associated with a given [QueryCursor].
@@ -191,34 +279,44 @@ var (
 	ErrPredicateWrongType      = fmt.Errorf("%w: invalid type", ErrPredicateBase)
 	ErrPredicateRegex          = fmt.Errorf("%w: invalid regex", ErrPredicateBase)
 
+	ErrQueryTruncated = errors.New("query execution halted before completion")
+	// ErrProgressHalted is returned (possibly wrapped) when a progress callback
+	// halts a query execution or a parse, see [QueryCursorOptions] and
+	// [ParseOptions].
+	ErrProgressHalted = errors.New("halted by the progress callback")
+
 	ErrPredicateFnBase     = errors.New("predicate fn error")
 	ErrPredicateFnWrongRet = fmt.Errorf("%w: invalid return type", ErrPredicateFnBase)
 	ErrPredicateFnMissing  = fmt.Errorf("%w: none registered", ErrPredicateFnBase)
+	// ErrPredicateUnsupported is returned for the predicates that have no
+	// predicator registered (and no "default" one either).
+	ErrPredicateUnsupported = ErrPredicateFnMissing
 )
 
-// TODO: This gives us the opportunity to allow end users to register their
-// own predicates.
-//
-//nolint:godox // ok
-var predicators = map[string]Predicator{ //nolint:gochecknoglobals // ok
-	"eq?":            assertPredEq,
-	"not-eq?":        assertPredEq,
-	"any-eq?":        assertPredEq,
-	"any-not-eq?":    assertPredEq,
-	"match?":         assertPredMatch,
-	"not-match?":     assertPredMatch,
-	"any-match?":     assertPredMatch,
-	"any-not-match?": assertPredMatch,
-	"any-of?":        assertPredAny,
-	"not-any-of?":    assertPredAny,
-	"set!":           assertPredSet,
-	"is?":            assertPredIs,
-	"is-not?":        assertPredIs,
-	catchall:         assertPredDefault,
+// defaultPredicators are the builtin predicators, available to all queries
+// (unless overridden via [WithPredicators]). It must never be mutated.
+var defaultPredicators = map[string]ContextPredicator{ //nolint:gochecknoglobals // ok
+	"eq?":            AdaptPredicator(assertPredEq),
+	"not-eq?":        AdaptPredicator(assertPredEq),
+	"any-eq?":        AdaptPredicator(assertPredEq),
+	"any-not-eq?":    AdaptPredicator(assertPredEq),
+	"match?":         AdaptPredicator(assertPredMatch),
+	"not-match?":     AdaptPredicator(assertPredMatch),
+	"any-match?":     AdaptPredicator(assertPredMatch),
+	"any-not-match?": AdaptPredicator(assertPredMatch),
+	"any-of?":        AdaptPredicator(assertPredAny),
+	"not-any-of?":    AdaptPredicator(assertPredAny),
+	"set!":           AdaptPredicator(assertPredSet),
+	"is?":            AdaptPredicator(assertPredIs),
+	"is-not?":        AdaptPredicator(assertPredIs),
+	catchall:         AdaptPredicator(assertPredDefault),
 }
 
 var voidPoint = Point{Row: uint(maxUint32), Column: uint(maxUint32)} //nolint:gochecknoglobals // ok
 
+// defaultMatchLimit is the match limit of new query cursors, see [SetDefaultMatchLimit].
+var defaultMatchLimit atomic.Uint32 //nolint:gochecknoglobals // ok
+
 // NewQuery creates a new query from a string containing one or more S-expression
 // patterns. The query is associated with a particular language, and can
 // only be run on syntax nodes parsed with that language.
@@ -229,14 +327,23 @@ var voidPoint = Point{Row: uint(maxUint32), Column: uint(maxUint32)} //nolint:go
 //  1. The byte offset of the error is written to the `error_offset` parameter.
 //  2. The type of error is written to the `error_type` parameter.
 //
+// Any options passed are applied before the patterns' predicates are processed.
+//
 //nolint:nakedret // ok
-func NewQuery(lang *Language, pattern []byte) (q *Query, err error) {
+func NewQuery(lang *Language, pattern []byte, opts ...QueryOption) (q *Query, err error) {
 	var (
 		errOfs   C.uint32_t
 		errType  QueryErrorKind
 		bytesPtr *C.char
 	)
 
+	switch {
+	case lang == nil:
+		return nil, ErrNoLanguage
+	case lang.ptr == nil:
+		return nil, ErrClosed
+	}
+
 	if len(pattern) > 0 {
 		bytesPtr = (*C.char)(unsafe.Pointer(&pattern[0]))
 	}
@@ -246,7 +353,11 @@ func NewQuery(lang *Language, pattern []byte) (q *Query, err error) {
 		return nil, newQueryError(lang, pattern, errType, errOfs)
 	}
 
-	q = &Query{c: c}
+	q = &Query{c: c, predicators: defaultPredicators, lang: lang}
+	for _, opt := range opts {
+		opt(q)
+	}
+
 	pc := q.PatternCount()
 
 	q.captureNames = make([]string, 0, q.CaptureCount())
@@ -255,12 +366,16 @@ func NewQuery(lang *Language, pattern []byte) (q *Query, err error) {
 	q.propertyPredicates = make([][]PropertyPredicate, 0, pc)
 	q.propertySettings = make([][]QueryProperty, 0, pc)
 	q.generalPredicates = make([][]QueryPredicate, 0, pc)
+	q.matchPredicates = make([][]MatchPredicate, 0, pc)
+	q.patternStarts = make([]Point, 0, pc)
 
 	q, err = fromRawParts(q, pattern)
 	if err != nil {
 		return
 	}
 
+	q.eqFilters, q.eqFilterSpecs = q.newEqFilters()
+
 	runtime.SetFinalizer(q, (*Query).close)
 
 	return
@@ -299,22 +414,29 @@ func fromRawParts(q *Query, pattern []byte) (_ *Query, err error) { //nolint:fun
 	for i := range q.PatternCount() {
 		predicateSteps := q.PredicatesForPattern(i)
 		byteOffset := q.StartByteForPattern(int(i))
-		row := uint(0)
+		patternIndex := i
+		row, col := uint(0), uint(0)
 
 		for i, c := range pattern {
 			if i >= int(byteOffset) {
 				break
 			}
 
+			col++
+
 			if c == '\n' {
 				row++
+				col = 0
 			}
 		}
 
+		q.patternStarts = append(q.patternStarts, Point{Row: row, Column: col})
+
 		textPredicates := []TextPredicateCapture{}
 		propertyPredicates := []PropertyPredicate{}
 		propertySettings := []QueryProperty{}
 		generalPredicates := []QueryPredicate{}
+		matchPredicates := []MatchPredicate{}
 
 		for _, steps := range predicateSteps {
 			if len(steps) == 0 {
@@ -325,17 +447,11 @@ func fromRawParts(q *Query, pattern []byte) (_ *Query, err error) { //nolint:fun
 				return nil, pErr(ErrPredicateWrongStart, row, "got @"+q.captureNames[steps[0].ValueID])
 			}
 
-			strVal, cptVal := func(i int) func() string {
-				return func() string { return stringValues[steps[i].ValueID] }
-			}, func(i int) func() string {
-				return func() string { return "@" + q.captureNames[steps[i].ValueID] }
-			}
-
 			op := stringValues[steps[0].ValueID]
 
-			fn := predicators[op]
+			fn := q.predicators[op]
 			if fn == nil {
-				fn = predicators[catchall]
+				fn = q.predicators[catchall]
 			}
 
 			if fn == nil {
@@ -344,7 +460,17 @@ func fromRawParts(q *Query, pattern []byte) (_ *Query, err error) { //nolint:fun
 
 			var x any
 
-			x, err = fn(q, steps, op, row, strVal, cptVal)
+			x, err = fn(PredicateContext{
+				Query:        q,
+				Language:     q.lang,
+				Op:           op,
+				Steps:        steps,
+				StartByte:    uint(byteOffset),
+				EndByte:      uint(q.EndByteForPattern(int(patternIndex))),
+				PatternIndex: uint(patternIndex),
+				Start:        q.patternStarts[patternIndex],
+				stringValues: stringValues,
+			})
 			if err != nil {
 				return
 			}
@@ -352,6 +478,7 @@ func fromRawParts(q *Query, pattern []byte) (_ *Query, err error) { //nolint:fun
 			// Build a predicate for each of the known predicate function names.
 			switch v := x.(type) {
 			case TextPredicateCapture:
+				v.Scoped = q.scopedCaptures[q.captureNames[v.CaptureID]]
 				textPredicates = append(textPredicates, v)
 			case PropertyPredicate:
 				propertyPredicates = append(propertyPredicates, v)
@@ -359,6 +486,8 @@ func fromRawParts(q *Query, pattern []byte) (_ *Query, err error) { //nolint:fun
 				propertySettings = append(propertySettings, v)
 			case QueryPredicate:
 				generalPredicates = append(generalPredicates, v)
+			case MatchPredicate:
+				matchPredicates = append(matchPredicates, v)
 			default:
 				return nil, pErr(ErrPredicateFnWrongRet, row,
 					fmt.Sprintf("predicator function for %s has an invalid type %T", op, v))
@@ -369,6 +498,7 @@ func fromRawParts(q *Query, pattern []byte) (_ *Query, err error) { //nolint:fun
 		q.propertyPredicates = append(q.propertyPredicates, propertyPredicates)
 		q.propertySettings = append(q.propertySettings, propertySettings)
 		q.generalPredicates = append(q.generalPredicates, generalPredicates)
+		q.matchPredicates = append(q.matchPredicates, matchPredicates)
 	}
 
 	return q, nil
@@ -457,7 +587,10 @@ func newQueryError(lang *Language, pattern []byte, kind QueryErrorKind, errOfs C
 // As the constructor in go-tree-sitter would set this func call through runtime.SetFinalizer,
 // parser.close() will be called by Go's garbage collector and users need not call this manually.
 func (q *Query) close() {
-	q.once.Do(func() { C.ts_query_delete(q.c) })
+	q.once.Do(func() {
+		C.ts_query_delete(q.c)
+		C.go_eq_filters_delete(q.eqFilters)
+	})
 }
 
 // PatternCount returns the number of patterns in the query.
@@ -661,6 +794,10 @@ func (q *Query) DisablePattern(patIdx uint32) {
 func NewQueryCursor() (qc *QueryCursor) {
 	qc = &QueryCursor{c: C.ts_query_cursor_new()}
 
+	if limit := defaultMatchLimit.Load(); limit > 0 {
+		qc.SetMatchLimit(limit)
+	}
+
 	runtime.SetFinalizer(qc, (*QueryCursor).close)
 
 	return
@@ -698,7 +835,64 @@ func (c *QueryCursor) close() {
 // captures from a previous match.
 func (qc *QueryCursor) Matches(q *Query, n Node, text []byte) (qm QueryMatches) {
 	qc.exec(q, n)
-	return QueryMatches{cursor: qc, query: q, text: text}
+
+	qm = QueryMatches{cursor: qc, query: q, text: text, node: n, status: &matchesStatus{}}
+	qm.eqFilters = q.validEqFilters()
+	if qc.maxMatchLimit > 0 {
+		qm.seen = map[string]struct{}{}
+	}
+
+	if timeout := qc.TimeoutDuration(); timeout > 0 {
+		qm.haltAt = time.Now().Add(timeout)
+	}
+
+	return
+}
+
+// MatchesCtx is like [QueryCursor.Matches], but the execution is bounded by
+// the context: its deadline (if any) caps the cursor's timeout for this
+// execution and its cancellation is checked before fetching each match.
+//
+// If the execution stops early, [QueryMatches.Err] reports why.
+func (qc *QueryCursor) MatchesCtx(ctx context.Context, q *Query, n Node, text []byte) (qm QueryMatches) {
+	byDeadline := false
+
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := qc.TimeoutDuration()
+		if d := max(time.Until(deadline), time.Microsecond); timeout == 0 || d < timeout {
+			// The timeout is only read by exec(), so we can restore it right after.
+			qc.SetTimeoutDuration(d)
+			defer qc.SetTimeoutDuration(timeout)
+
+			byDeadline = true
+		}
+	}
+
+	qm = qc.Matches(q, n, text)
+	qm.ctx, qm.byDeadline = ctx, byDeadline
+
+	return
+}
+
+// QueryCursorOptions are the options of a query cursor's execution, see
+// [QueryCursor.MatchesWithOptions].
+type QueryCursorOptions struct {
+	// Progress, if set, is called with the byte offset the execution reached
+	// (i.e. the start of the match found, never going back), for each match
+	// found, before it is returned, the execution halting if it returns false.
+	Progress func(offset uint32) bool
+}
+
+// MatchesWithOptions is like [QueryCursor.Matches], with the given execution
+// options. If the execution halts early, [QueryMatches.Err] reports why.
+//
+// The progress is the one of the matches found, rather than of the nodes
+// walked.
+func (qc *QueryCursor) MatchesWithOptions(q *Query, n Node, text []byte, opts QueryCursorOptions) (qm QueryMatches) {
+	qm = qc.Matches(q, n, text)
+	qm.progress = opts.Progress
+
+	return
 }
 
 // Captures iterates over all of the individual captures in the order that they
@@ -731,6 +925,8 @@ func (c *QueryCursor) exec(q *Query, n Node) {
 // needed as the query is executed.
 
 // DidExceedMatchLimit see above.
+//
+// See [WithAdaptiveMatchLimit] for raising the limit as needed instead.
 func (c *QueryCursor) DidExceedMatchLimit() bool {
 	return bool(C.ts_query_cursor_did_exceed_match_limit(c.c))
 }
@@ -761,6 +957,19 @@ func (c *QueryCursor) Timeout() (micros int) {
 	return int(C.ts_query_cursor_timeout_micros(c.c))
 }
 
+// SetTimeoutDuration sets the maximum duration that query execution should be
+// allowed to take before halting. Zero means no limit.
+//
+// It is the [time.Duration] based counterpart of [QueryCursor.SetTimeout].
+func (c *QueryCursor) SetTimeoutDuration(d time.Duration) {
+	c.SetTimeout(int(d.Microseconds()))
+}
+
+// TimeoutDuration returns the duration that query execution is allowed to take.
+func (c *QueryCursor) TimeoutDuration() time.Duration {
+	return time.Duration(c.Timeout()) * time.Microsecond
+}
+
 // SetByteRange sets the range of bytes in which the query will be executed.
 func (c *QueryCursor) SetByteRange(start, end uint32) {
 	C.ts_query_cursor_set_byte_range(c.c, C.uint(start), C.uint(end))
@@ -772,6 +981,10 @@ func (c *QueryCursor) SetPointRange(start, end Point) {
 }
 
 func (c *QueryCursor) NextMatch() (_ *QueryMatch) {
+	if CgoProfiling {
+		defer cgoCall(CgoQueryNext)()
+	}
+
 	m := (*C.TSQueryMatch)(C.malloc(C.sizeof_TSQueryMatch))
 	defer C.free(unsafe.Pointer(m))
 
@@ -783,6 +996,10 @@ func (c *QueryCursor) NextMatch() (_ *QueryMatch) {
 }
 
 func (c *QueryCursor) NextCapture() (_ *QueryMatch, i uint) {
+	if CgoProfiling {
+		defer cgoCall(CgoQueryNext)()
+	}
+
 	m := (*C.TSQueryMatch)(C.malloc(C.sizeof_TSQueryMatch))
 	defer C.free(unsafe.Pointer(m))
 
@@ -815,7 +1032,7 @@ func (qm *QueryMatch) NodesForCaptureIndex(captureIndex uint) []Node {
 }
 
 func (qm *QueryMatch) satisfiesTextPredicate(q *Query, text []byte) (ok bool) { //nolint:funlen,gocognit,cyclop,lll // ok
-	condition := func(predicate TextPredicateCapture) bool {
+	condition := func(qm *QueryMatch, predicate TextPredicateCapture) bool {
 		switch predicate.Type {
 		case TextPredicateTypeEqCapture:
 			i := predicate.CaptureID
@@ -827,7 +1044,7 @@ func (qm *QueryMatch) satisfiesTextPredicate(q *Query, text []byte) (ok bool) {
 				node1 := nodes1[0]
 				node2 := nodes2[0]
 
-				isPositiveMatch := bytes.Equal(text[node1.StartByte():node1.EndByte()], text[node2.StartByte():node2.EndByte()])
+				isPositiveMatch := bytes.Equal(node1.bytes(text), node2.bytes(text))
 				if isPositiveMatch != predicate.Positive && predicate.MatchAllNodes {
 					return false
 				}
@@ -847,9 +1064,9 @@ func (qm *QueryMatch) satisfiesTextPredicate(q *Query, text []byte) (ok bool) {
 
 			nodes := qm.NodesForCaptureIndex(i)
 			for _, node := range nodes {
-				nodeText := text[node.StartByte():node.EndByte()]
+				nodeText := node.bytes(text)
 
-				isPositiveMatch := bytes.Equal(nodeText, []byte(s))
+				isPositiveMatch := string(nodeText) == s
 				if isPositiveMatch != predicate.Positive && predicate.MatchAllNodes {
 					return false
 				}
@@ -866,7 +1083,7 @@ func (qm *QueryMatch) satisfiesTextPredicate(q *Query, text []byte) (ok bool) {
 
 			nodes := qm.NodesForCaptureIndex(i)
 			for _, node := range nodes {
-				nodeText := text[node.StartByte():node.EndByte()]
+				nodeText := node.bytes(text)
 
 				isPositiveMatch := r.Match(nodeText)
 				if isPositiveMatch != predicate.Positive && predicate.MatchAllNodes {
@@ -885,11 +1102,11 @@ func (qm *QueryMatch) satisfiesTextPredicate(q *Query, text []byte) (ok bool) {
 
 			nodes := qm.NodesForCaptureIndex(i)
 			for _, node := range nodes {
-				nodeText := text[node.StartByte():node.EndByte()]
+				nodeText := node.bytes(text)
 				isPositiveMatch := false
 
 				for _, s := range v {
-					if bytes.Equal(nodeText, []byte(s)) {
+					if string(nodeText) == s {
 						isPositiveMatch = true
 						break
 					}
@@ -907,7 +1124,18 @@ func (qm *QueryMatch) satisfiesTextPredicate(q *Query, text []byte) (ok bool) {
 	}
 
 	for _, predicate := range q.TextPredicates[qm.PatternIndex] {
-		if !condition(predicate) {
+		if predicate.Scoped {
+			qm.Captures = qm.scopedCaptures(predicate, condition)
+			continue
+		}
+
+		if !condition(qm, predicate) {
+			return false
+		}
+	}
+
+	for _, predicate := range q.matchPredicates[qm.PatternIndex] {
+		if !predicate(qm, text) {
 			return false
 		}
 	}
@@ -915,6 +1143,61 @@ func (qm *QueryMatch) satisfiesTextPredicate(q *Query, text []byte) (ok bool) {
 	return true
 }
 
+// scopedCaptures returns the match's captures, minus the nodes of the
+// predicate's capture for which the predicate does not hold, each node being
+// checked on its own. The match's captures are only copied if needed, as they
+// are owned by the cursor.
+func (qm *QueryMatch) scopedCaptures(predicate TextPredicateCapture,
+	condition func(*QueryMatch, TextPredicateCapture) bool,
+) []QueryCapture {
+	var others []QueryCapture
+
+	if predicate.Type == TextPredicateTypeEqCapture {
+		for _, c := range qm.Captures {
+			if uint(c.Index) == predicate.Value.(uint) { //nolint:errcheck,forcetypeassert // TODO
+				others = append(others, c)
+			}
+		}
+	}
+
+	var kept []QueryCapture
+
+	predicate.MatchAllNodes = true
+
+	for i, c := range qm.Captures {
+		keep := uint(c.Index) != predicate.CaptureID ||
+			condition(&QueryMatch{Captures: append([]QueryCapture{c}, others...)}, predicate)
+
+		switch {
+		case keep && kept != nil:
+			kept = append(kept, c)
+		case !keep && kept == nil:
+			kept = append(make([]QueryCapture, 0, len(qm.Captures)-1), qm.Captures[:i]...)
+		}
+	}
+
+	if kept == nil {
+		return qm.Captures
+	}
+
+	return kept
+}
+
+// captureIndex returns the index of the given capture in the match.
+func (qm *QueryMatch) captureIndex(capture QueryCapture, hint uint) (uint, bool) {
+	if hint < uint(len(qm.Captures)) && qm.Captures[hint] == capture {
+		return hint, true
+	}
+
+	for i, c := range qm.Captures {
+		if c == capture {
+			return uint(i), true
+		}
+	}
+
+	return 0, false
+}
+
 func NewQueryProperty(key string, value *string, captureID *uint) QueryProperty {
 	return QueryProperty{
 		Key:       key,
@@ -934,16 +1217,92 @@ func NewQueryProperty(key string, value *string, captureID *uint) QueryProperty
 // If there are no more matches, it will return nil.
 func (qm *QueryMatches) Next() *QueryMatch {
 	for {
-		if result := qm.cursor.NextMatch(); result != nil {
-			if result.satisfiesTextPredicate(qm.query, qm.text) {
+		if qm.ctx != nil {
+			if err := qm.ctx.Err(); err != nil {
+				qm.status.err = fmt.Errorf("%w: %w", ErrQueryTruncated, err)
+				return nil
+			}
+		}
+
+		if result := qm.cursor.nextMatch(qm.eqFilters, qm.text); result != nil {
+			if !qm.reportProgress(result) {
+				qm.status.err = fmt.Errorf("%w: %w", ErrQueryTruncated, ErrProgressHalted)
+				return nil
+			}
+
+			if result.satisfiesTextPredicate(qm.query, qm.text) && qm.firstSeen(result) {
 				return result
 			}
 		} else {
+			if qm.retry() {
+				continue
+			}
+
+			qm.checkHalted()
 			return nil
 		}
 	}
 }
 
+// Err returns the reason the matches were truncated, if the execution
+// halted early due to a timeout or the context being done; nil otherwise.
+func (qm *QueryMatches) Err() error {
+	if qm.status == nil {
+		return nil
+	}
+
+	return qm.status.err
+}
+
+// All returns an iterator over the remaining matches (see [QueryMatches.Next]).
+// Unlike the ones returned by Next, the matches yielded own their captures, so
+// they remain valid after the iteration moves on. Check [QueryMatches.Err]
+// afterwards, to find out whether the matches were truncated.
+//
+// It iterates over a copy of the matches, which shares their state, so that
+// it can be called on the matches as returned, e.g.:
+//
+//	for m := range qc.Matches(q, root, src).All() {
+//		// ...
+//	}
+func (qm QueryMatches) All() iter.Seq[*QueryMatch] {
+	return func(yield func(*QueryMatch) bool) {
+		for m := qm.Next(); m != nil; m = qm.Next() {
+			m.Captures = slices.Clone(m.Captures)
+			if !yield(m) {
+				return
+			}
+		}
+	}
+}
+
+// reportProgress calls the progress callback (if any) for the match found,
+// returning whether to go on.
+func (qm *QueryMatches) reportProgress(m *QueryMatch) bool {
+	if qm.progress == nil {
+		return true
+	}
+
+	for _, c := range m.Captures {
+		qm.status.offset = max(qm.status.offset, uint32(c.Node.StartByte())) //nolint:gosec // ok
+	}
+
+	return qm.progress(qm.status.offset)
+}
+
+func (qm *QueryMatches) checkHalted() {
+	if qm.haltAt.IsZero() || time.Now().Before(qm.haltAt) {
+		return
+	}
+
+	switch {
+	case qm.byDeadline:
+		qm.status.err = fmt.Errorf("%w: %w", ErrQueryTruncated, context.DeadlineExceeded)
+	default:
+		qm.status.err = ErrQueryTruncated
+	}
+}
+
 // Next will return the next match in the sequence of matches, as well as the
 // index of the capture.
 //
@@ -957,8 +1316,16 @@ func (qm *QueryMatches) Next() *QueryMatch {
 func (qc *QueryCaptures) Next() (m *QueryMatch, index uint) {
 	for {
 		if m, index = qc.cursor.NextCapture(); m != nil {
+			capture := m.Captures[index]
+
 			if m.satisfiesTextPredicate(qc.query, qc.text) {
-				return
+				// Scoped predicates may have dropped (this or other) captures.
+				var ok bool
+				if index, ok = m.captureIndex(capture, index); ok {
+					return
+				}
+
+				continue
 			}
 
 			m.Remove()
@@ -968,6 +1335,22 @@ func (qc *QueryCaptures) Next() (m *QueryMatch, index uint) {
 	}
 }
 
+// All returns an iterator over the remaining captures (see
+// [QueryCaptures.Next]), yielding each match along with the index of the
+// capture. Unlike the ones returned by Next, the matches yielded own their
+// captures, so they remain valid after the iteration moves on. As with
+// [QueryMatches.All], it can be called on the captures as returned.
+func (qc QueryCaptures) All() iter.Seq2[*QueryMatch, uint] {
+	return func(yield func(*QueryMatch, uint) bool) {
+		for m, i := qc.Next(); m != nil; m, i = qc.Next() {
+			m.Captures = slices.Clone(m.Captures)
+			if !yield(m, i) {
+				return
+			}
+		}
+	}
+}
+
 // SetMaxStartDepth sets the maximum start depth for a query cursor.
 //
 // This prevents cursors from exploring children nodes at a certain depth.
@@ -986,6 +1369,379 @@ func (c *QueryCursor) SetMaxStartDepth(maxStartDepth uint32) {
 
 // Non API.
 
+// NewQueryCursorWith creates a new [QueryCursor] and configures it with the
+// given options.
+func NewQueryCursorWith(opts ...QueryCursorOption) (qc *QueryCursor) {
+	qc = NewQueryCursor()
+
+	for _, opt := range opts {
+		opt(qc)
+	}
+
+	return
+}
+
+// WithMatchLimit sets the cursor's match limit (see [QueryCursor.SetMatchLimit]).
+func WithMatchLimit(limit uint32) QueryCursorOption {
+	return func(qc *QueryCursor) {
+		qc.SetMatchLimit(limit)
+	}
+}
+
+// WithAdaptiveMatchLimit makes [QueryCursor.Matches] (and MatchesCtx) raise
+// the match limit and retry, instead of silently dropping matches, whenever
+// the limit is exceeded (see [QueryCursor.DidExceedMatchLimit]). The limit is
+// doubled on each retry, up to max. It starts at the current match limit, so
+// it should be combined with [WithMatchLimit] (or [SetDefaultMatchLimit]).
+//
+// The matches found by a retry that were not returned already are returned
+// after the ones found so far, so the order is no longer guaranteed then.
+func WithAdaptiveMatchLimit(max uint32) QueryCursorOption { //nolint:predeclared // ok
+	return func(qc *QueryCursor) {
+		qc.maxMatchLimit = max
+	}
+}
+
+// SetDefaultMatchLimit sets the match limit of all the query cursors created
+// afterwards. Zero (the default) leaves tree-sitter's own default in place,
+// which is practically unlimited.
+func SetDefaultMatchLimit(limit uint32) {
+	defaultMatchLimit.Store(limit)
+}
+
+// retry re-executes the query with a doubled match limit, if the limit was
+// exceeded and the adaptive match limit allows it.
+func (qm *QueryMatches) retry() bool {
+	c := qm.cursor
+
+	limit := c.MatchLimit()
+	if c.maxMatchLimit == 0 || limit >= c.maxMatchLimit || !c.DidExceedMatchLimit() {
+		return false
+	}
+
+	c.SetMatchLimit(min(max(limit, 1)*2, c.maxMatchLimit)) //nolint:mnd // ok
+	c.exec(qm.query, qm.node)
+
+	return true
+}
+
+// firstSeen reports whether the match was not returned before (always true,
+// unless the adaptive match limit is on).
+func (qm *QueryMatches) firstSeen(m *QueryMatch) bool {
+	if qm.seen == nil {
+		return true
+	}
+
+	key := binary.AppendUvarint(nil, uint64(m.PatternIndex))
+	for _, c := range m.Captures {
+		key = binary.AppendUvarint(key, uint64(c.Index))
+		key = binary.AppendUvarint(key, uint64(uintptr(c.Node.c.id)))
+	}
+
+	if _, ok := qm.seen[string(key)]; ok {
+		return false
+	}
+
+	qm.seen[string(key)] = struct{}{}
+
+	return true
+}
+
+// WithPredicators adds the given predicators to the query, on top of the
+// builtin ones, which can be overridden by name (a nil predicator removes the
+// one with the same name). The "default" entry is used for all the predicates
+// that have no predicator registered.
+//
+// The predicators only apply to the query being created, so independent
+// users of the package can each use their own predicate sets.
+func WithPredicators(fns map[string]Predicator) QueryOption {
+	cfns := make(map[string]ContextPredicator, len(fns))
+	for name, fn := range fns {
+		cfns[name] = AdaptPredicator(fn)
+	}
+
+	return WithContextPredicators(cfns)
+}
+
+// WherePredicatesApplyTo makes the text predicates (eq?, match?, any-of?, etc.)
+// of the given captures scoped to them: when such a predicate fails for one of
+// the capture's nodes, only that node is dropped from the match, rather than
+// the whole match. That allows attaching checks to specific captures of large
+// patterns, without splitting them. Unknown capture names are ignored.
+func WherePredicatesApplyTo(captures ...string) QueryOption {
+	return func(q *Query) {
+		q.scopedCaptures = maps.Clone(q.scopedCaptures)
+		if q.scopedCaptures == nil {
+			q.scopedCaptures = make(map[string]bool, len(captures))
+		}
+
+		for _, name := range captures {
+			q.scopedCaptures[strings.TrimPrefix(name, "@")] = true
+		}
+	}
+}
+
+// WithContextPredicators is like [WithPredicators], for [ContextPredicator]s.
+func WithContextPredicators(fns map[string]ContextPredicator) QueryOption {
+	return func(q *Query) {
+		q.predicators = maps.Clone(q.predicators)
+
+		for name, fn := range fns {
+			if fn == nil {
+				delete(q.predicators, name)
+			} else {
+				q.predicators[name] = fn
+			}
+		}
+	}
+}
+
+// AdaptPredicator converts a [Predicator] into a [ContextPredicator].
+// A nil predicator is converted into a nil one.
+func AdaptPredicator(fn Predicator) ContextPredicator {
+	if fn == nil {
+		return nil
+	}
+
+	return func(pc PredicateContext) (any, error) {
+		strVal, cptVal := func(i int) func() string {
+			return func() string { return pc.stringValues[pc.Steps[i].ValueID] }
+		}, func(i int) func() string {
+			return func() string { return "@" + pc.Query.captureNames[pc.Steps[i].ValueID] }
+		}
+
+		return fn(pc.Query, pc.Steps, pc.Op, pc.Start.Row, strVal, cptVal)
+	}
+}
+
+// Arg returns the value of the i-th argument of the predicate (the predicate's
+// name being argument 0): the string literal, or the capture name prefixed
+// by "@".
+func (pc PredicateContext) Arg(i int) string {
+	if step := pc.Steps[i]; step.Type == QueryPredicateStepTypeCapture {
+		return "@" + pc.Query.captureNames[step.ValueID]
+	}
+
+	return pc.stringValues[pc.Steps[i].ValueID]
+}
+
+// Error returns a [QueryError] wrapping err, located at the pattern's start.
+func (pc PredicateContext) Error(err error, msg string) error {
+	e := pErr(err, pc.Start.Row, msg)
+	e.Column, e.Offset = pc.Start.Column, pc.StartByte
+
+	return e
+}
+
+// StartPointForPattern returns the row/column position where the given pattern
+// starts in the query's source.
+func (q *Query) StartPointForPattern(i int) Point {
+	return q.patternStarts[i]
+}
+
+// CapturesForPattern returns the names of the captures the given pattern can
+// produce (i.e. whose quantifier is not zero), in capture ID order.
+func (q *Query) CapturesForPattern(i uint) (names []string) {
+	for id, quant := range q.captureQuantifiers[i] {
+		if quant != CaptureQuantifierZero {
+			names = append(names, q.captureNames[id])
+		}
+	}
+
+	return
+}
+
+// PropertyFor returns the first property set (via `#set!`) by the given pattern
+// under the given key, if any.
+func (q *Query) PropertyFor(patternIdx uint, key string) (_ QueryProperty, ok bool) {
+	for _, prop := range q.propertySettings[patternIdx] {
+		if prop.Key == key {
+			return prop, true
+		}
+	}
+
+	return
+}
+
+// Property returns the first property set (via `#set!`) under the given key by
+// the pattern the match is for, if any.
+func (qm *QueryMatch) Property(q *Query, key string) (QueryProperty, bool) {
+	return q.PropertyFor(qm.PatternIndex, key)
+}
+
+// Properties returns the effective properties set (via `#set!`) by the pattern
+// the match is for, by key, the values left out being empty. The properties
+// scoped to a capture (i.e. `(#set! @capture key value)`) are only included if
+// the capture is in the match (e.g. not for an optional one, missing), taking
+// precedence over the pattern-wide ones. Otherwise, the first one set wins.
+func (qm *QueryMatch) Properties(q *Query) map[string]string {
+	props := map[string]string{}
+	scoped := map[string]bool{}
+
+	for _, prop := range q.PropertySettings(qm.PatternIndex) {
+		if prop.CaptureID != nil {
+			if scoped[prop.Key] || !slices.ContainsFunc(qm.Captures, func(c QueryCapture) bool {
+				return uint(c.Index) == *prop.CaptureID
+			}) {
+				continue
+			}
+
+			scoped[prop.Key] = true
+		} else if _, ok := props[prop.Key]; ok {
+			continue
+		}
+
+		props[prop.Key] = ""
+		if prop.Value != nil {
+			props[prop.Key] = *prop.Value
+		}
+	}
+
+	return props
+}
+
+// CaptureSummaries returns the range, symbol and capture index of each of the
+// captured nodes, fetched all at once, for the callers that only need these
+// (e.g. highlighting), rather than crossing into C for each node and field.
+func (qm *QueryMatch) CaptureSummaries() []CaptureSummary {
+	if len(qm.Captures) == 0 {
+		return nil
+	}
+
+	cs := make([]C.GoCaptureSummary, len(qm.Captures))
+	C.go_capture_summaries((*C.TSQueryCapture)(unsafe.Pointer(&qm.Captures[0])), C.uint32_t(len(cs)), &cs[0])
+
+	out := make([]CaptureSummary, len(cs))
+	for i, c := range cs {
+		out[i] = CaptureSummary{Range: mkRange(c._range), Index: uint32(c.index), Symbol: c.symbol}
+	}
+
+	return out
+}
+
+// eqFilter is a string #eq? (or #not-eq?) predicate, as checked in C.
+type eqFilter struct {
+	value     string
+	captureID uint
+	positive  bool
+}
+
+// newEqFilters collects the string #eq? (and #not-eq?) predicates that must
+// hold for all the captured nodes, for the cursor to check them in C, without
+// crossing into Go (and allocating) for the matches they reject. Returns nil if
+// there are none, along with the filters collected, per pattern.
+//
+// The filters are only built once, when the query is created, so they are
+// only used for as long as TextPredicates still holds them (see
+// validEqFilters), the predicates being checked in Go (again) anyway.
+func (q *Query) newEqFilters() (_ *C.GoEqFilters, specs [][]eqFilter) {
+	var (
+		filters []C.GoEqFilter
+		values  []byte
+	)
+
+	starts := make([]C.uint32_t, 0, len(q.TextPredicates)+1)
+	specs = make([][]eqFilter, len(q.TextPredicates))
+
+	for i, predicates := range q.TextPredicates {
+		starts = append(starts, C.uint32_t(len(filters)))
+
+		for _, p := range predicates {
+			f, ok := eqFilterOf(p)
+			if !ok {
+				continue
+			}
+
+			filters = append(filters, C.GoEqFilter{
+				capture_id: C.uint32_t(f.captureID),
+				offset:     C.uint32_t(len(values)),
+				length:     C.uint32_t(len(f.value)),
+				positive:   C.bool(f.positive),
+			})
+			values = append(values, f.value...)
+			specs[i] = append(specs[i], f)
+		}
+	}
+
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	starts = append(starts, C.uint32_t(len(filters)))
+	values = append(values, 0) // So that it is never empty.
+
+	return C.go_eq_filters_new(&filters[0], &starts[0], C.uint32_t(len(q.TextPredicates)),
+		(*C.char)(unsafe.Pointer(&values[0])), C.uint32_t(len(values)-1)), specs
+}
+
+// validEqFilters returns the query's eqFilters, unless TextPredicates no longer
+// holds exactly the filters they were built from, in which case it returns nil,
+// so that the matches are only checked in Go.
+func (q *Query) validEqFilters() *C.GoEqFilters {
+	if q.eqFilters == nil || len(q.TextPredicates) != len(q.eqFilterSpecs) {
+		return nil
+	}
+
+	for i, predicates := range q.TextPredicates {
+		specs, j := q.eqFilterSpecs[i], 0
+
+		for _, p := range predicates {
+			f, ok := eqFilterOf(p)
+			if !ok {
+				continue
+			}
+
+			if j == len(specs) || specs[j] != f {
+				return nil
+			}
+
+			j++
+		}
+
+		if j != len(specs) {
+			return nil
+		}
+	}
+
+	return q.eqFilters
+}
+
+// eqFilterOf returns the filter of the predicate, if it can be checked in C.
+func eqFilterOf(p TextPredicateCapture) (eqFilter, bool) {
+	s, ok := p.Value.(string)
+	if !ok || p.Type != TextPredicateTypeEqString || !p.MatchAllNodes || p.Scoped {
+		return eqFilter{}, false
+	}
+
+	return eqFilter{value: s, captureID: p.CaptureID, positive: p.Positive}, true
+}
+
+// nextMatch is like [QueryCursor.NextMatch], but skips (in C) the matches
+// rejected by the given eqFilters (if any).
+func (c *QueryCursor) nextMatch(filters *C.GoEqFilters, text []byte) (_ *QueryMatch) {
+	if filters == nil {
+		return c.NextMatch()
+	}
+
+	if CgoProfiling {
+		defer cgoCall(CgoQueryNext)()
+	}
+
+	m := (*C.TSQueryMatch)(C.malloc(C.sizeof_TSQueryMatch))
+	defer C.free(unsafe.Pointer(m))
+
+	var textPtr *C.char
+	if len(text) > 0 {
+		textPtr = (*C.char)(unsafe.Pointer(&text[0]))
+	}
+
+	if C.go_query_cursor_next_match(c.c, m, filters, textPtr, C.uint32_t(len(text))) {
+		return newQueryMatch(m, c)
+	}
+
+	return
+}
+
 func (steps QueryPredicateSteps) split() (out []QueryPredicateSteps) {
 	var curr QueryPredicateSteps
 
@@ -0,0 +1,248 @@
+package sitter
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+)
+
+// QueryCache caches the matches of queries over the documents of a workspace,
+// by document path and query, keeping them up to date as the documents are
+// edited (see [QueryCache.ApplyEdit]): only the matches touching the edited or
+// changed ranges are dropped (the others being shifted along), and the query
+// runs again over these ranges only, the next time its matches are asked for.
+//
+// Query caches are safe for concurrent use, the documents are not.
+type QueryCache struct {
+	entries map[queryCacheKey]*queryCacheEntry
+	metrics Metrics
+	mu      sync.Mutex
+}
+
+// CachedMatch is a query match cached by a [QueryCache]. It holds the summaries
+// of its captures rather than the captured nodes, as these do not outlive the
+// tree they belong to.
+type CachedMatch struct {
+	Captures     []CaptureSummary
+	PatternIndex uint
+}
+
+type queryCacheKey struct {
+	query *Query
+	path  string
+}
+
+// queryCacheEntry holds the matches of a query over a document, along with the
+// (byte) spans of the document whose matches are to be computed again.
+type queryCacheEntry struct {
+	matches []CachedMatch
+	dirty   []byteSpan
+}
+
+// byteSpan is a byte range, whose end is inclusive, as the spans touching it (i.e.
+// ending right where it starts, or starting right where it ends) are affected.
+type byteSpan struct {
+	start, end uint
+}
+
+// NewQueryCache creates an empty query cache.
+func NewQueryCache() *QueryCache {
+	return &QueryCache{entries: map[queryCacheKey]*queryCacheEntry{}}
+}
+
+// Matches returns the matches of the query over the document at path (text
+// predicates applied, as with [QueryCursor.Matches]), in the order they start,
+// from the cache where they are still valid. The matches without captures
+// are left out, as they cannot be told apart.
+func (c *QueryCache) Matches(path string, q *Query, doc *Document) []CachedMatch {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := queryCacheKey{query: q, path: path}
+
+	e, ok := c.entries[key]
+	if ok && c.metrics != nil {
+		c.metrics.Add(MetricCacheHits, 1)
+	}
+
+	if !ok {
+		e = &queryCacheEntry{matches: runQuery(q, doc, nil)}
+		c.entries[key] = e
+	} else if e.dirty != nil {
+		for _, m := range runQuery(q, doc, e.dirty) {
+			if !slices.ContainsFunc(e.matches, m.equal) {
+				e.matches = append(e.matches, m)
+			}
+		}
+
+		slices.SortStableFunc(e.matches, compareMatches)
+		e.dirty = nil
+	}
+
+	return slices.Clone(e.matches)
+}
+
+// SetMetrics sets where the cache reports the matches it serves (at least in
+// part) from the cache to, as [MetricCacheHits]. Passing nil disables reporting.
+func (c *QueryCache) SetMetrics(m Metrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.metrics = m
+}
+
+// ApplyEdit is like [Document.ApplyEdit], for the document at path, also
+// invalidating the matches it has cached which the edit may affect.
+func (c *QueryCache) ApplyEdit(ctx context.Context, path string, doc *Document, start, oldEnd uint,
+	newText []byte,
+) ([]Range, error) {
+	changed, err := doc.ApplyEdit(ctx, start, oldEnd, newText)
+	if errors.Is(err, ErrInvalidEdit) {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, e := range c.entries {
+		if key.path != path {
+			continue
+		}
+
+		if err != nil { // The text changed, but not the tree, so start over.
+			delete(c.entries, key)
+			continue
+		}
+
+		e.edit(doc, start, oldEnd, start+uint(len(newText)), changed)
+	}
+
+	return changed, err
+}
+
+// Remove drops the matches cached for the document at path, e.g. once closed.
+func (c *QueryCache) Remove(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.path == path {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// edit applies an edit (replacing the text from start to oldEnd with the one
+// ending at newEnd) to the entry: the dirty spans and the matches after the
+// edit are shifted, the edited span and the changed ranges become dirty, and
+// the matches touching any dirty span are dropped.
+func (e *queryCacheEntry) edit(doc *Document, start, oldEnd, newEnd uint, changed []Range) {
+	shift := func(b uint) uint { return b - oldEnd + newEnd }
+	edited := byteSpan{start, newEnd}
+
+	dirty := make([]byteSpan, 0, len(e.dirty)+len(changed)+1)
+
+	for _, s := range e.dirty {
+		switch {
+		case s.end < start:
+			dirty = append(dirty, s)
+		case s.start > oldEnd:
+			dirty = append(dirty, byteSpan{shift(s.start), shift(s.end)})
+		default:
+			edited.start = min(edited.start, s.start)
+			edited.end = max(edited.end, newEnd, shift(max(s.end, oldEnd)))
+		}
+	}
+
+	dirty = append(dirty, edited)
+	for _, r := range changed {
+		dirty = append(dirty, byteSpan{r.StartByte, r.EndByte})
+	}
+
+	e.dirty = dirty
+	kept := e.matches[:0]
+
+	for _, m := range e.matches {
+		s := m.span()
+		if s.end >= start && s.start <= oldEnd {
+			continue
+		}
+
+		if s.start > oldEnd { // Shifted on a copy, as the callers may hold the match.
+			caps := make([]CaptureSummary, len(m.Captures))
+			for i, c := range m.Captures {
+				caps[i] = c
+				caps[i].Range = doc.byteRange(shift(c.Range.StartByte), shift(c.Range.EndByte))
+			}
+
+			m.Captures = caps
+			s = m.span()
+		}
+
+		if !slices.ContainsFunc(dirty, s.touches) {
+			kept = append(kept, m)
+		}
+	}
+
+	e.matches = kept
+}
+
+// runQuery returns the matches (with captures) of the query over the document,
+// sorted, only those touching the given spans, if any.
+func runQuery(q *Query, doc *Document, spans []byteSpan) (matches []CachedMatch) {
+	qc := NewQueryCursor()
+	root, text := doc.RootNode(), doc.Text()
+
+	if spans == nil {
+		spans = []byteSpan{{0, uint(len(text))}}
+	}
+
+	for _, s := range spans {
+		seen := matches // The matches found over the previous spans.
+
+		// The query cursor only returns the nodes intersecting the range, not
+		// the ones touching it, hence the extra byte on each side.
+		qc.SetByteRange(uint32(max(s.start, 1)-1), uint32(s.end+1)) //nolint:gosec // ok
+
+		ms := qc.Matches(q, root, text)
+		for m := ms.Next(); m != nil; m = ms.Next() {
+			cm := CachedMatch{Captures: m.CaptureSummaries(), PatternIndex: m.PatternIndex}
+			if len(cm.Captures) > 0 && !slices.ContainsFunc(seen, cm.equal) {
+				matches = append(matches, cm)
+			}
+		}
+	}
+
+	slices.SortStableFunc(matches, compareMatches)
+
+	return
+}
+
+// span returns the span of the match's captures.
+func (m CachedMatch) span() (s byteSpan) {
+	s = byteSpan{m.Captures[0].Range.StartByte, m.Captures[0].Range.EndByte}
+	for _, c := range m.Captures[1:] {
+		s.start, s.end = min(s.start, c.Range.StartByte), max(s.end, c.Range.EndByte)
+	}
+
+	return
+}
+
+func (m CachedMatch) equal(other CachedMatch) bool {
+	return m.PatternIndex == other.PatternIndex && slices.Equal(m.Captures, other.Captures)
+}
+
+func (s byteSpan) touches(other byteSpan) bool {
+	return s.end >= other.start && s.start <= other.end
+}
+
+// compareMatches orders the matches by their start, then their end (longest
+// first), then their pattern.
+func compareMatches(a, b CachedMatch) int {
+	sa, sb := a.span(), b.span()
+
+	return cmp.Or(cmp.Compare(sa.start, sb.start), cmp.Compare(sb.end, sa.end),
+		cmp.Compare(a.PatternIndex, b.PatternIndex))
+}
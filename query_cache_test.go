@@ -0,0 +1,190 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"reflect"
+	"testing"
+)
+
+func TestNewQueryCache(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestQueryCacheMatches(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	doc, err := NewDocument(ctx, gr, []byte("1 + 2 // two\n+ 3"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(number) @n (sum left: (_) @l right: (_) @r) (comment) @c"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewQueryCache()
+	if act := c.Matches("a.calc", q, doc); len(act) != 6 {
+		t.Fatalf("Expected 6 matches, got %d: %+v", len(act), act)
+	}
+
+	testCases := []struct {
+		name          string
+		start, oldEnd uint
+		newText       string
+		skipMatches   bool // Edit again before asking for the matches.
+	}{
+		{"replace", 4, 5, "20", false},
+		{"prepend", 0, 0, "7 + ", false},
+		{"append", 21, 21, " + 4", true},
+		{"delete", 24, 25, "", false},
+		{"remove comment", 11, 18, "", false},
+		{"break", 2, 3, "", true},
+		{"fix", 2, 2, "+", false},
+		{"remove all", 0, 17, "", false},
+		{"add back", 0, 0, "5 + 6", false},
+	}
+
+	for _, tc := range testCases {
+		if _, err = c.ApplyEdit(ctx, "a.calc", doc, tc.start, tc.oldEnd, []byte(tc.newText)); err != nil {
+			t.Fatalf("%s: expected no error, got %v", tc.name, err)
+		}
+
+		if tc.skipMatches {
+			continue
+		}
+
+		exp := NewQueryCache().Matches("a.calc", q, doc)
+		if act := c.Matches("a.calc", q, doc); len(act)+len(exp) > 0 && !reflect.DeepEqual(act, exp) {
+			t.Fatalf("%s: expected\n%+v\ngot\n%+v", tc.name, exp, act)
+		}
+	}
+}
+
+func TestQueryCacheSetMetrics(t *testing.T) {
+	t.Parallel()
+
+	doc, err := NewDocument(context.Background(), gr, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(number) @n"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	m := new(expvar.Map).Init()
+	c := NewQueryCache()
+	c.SetMetrics(m)
+
+	for range 3 {
+		c.Matches("a.calc", q, doc)
+	}
+
+	if act := m.Get(MetricCacheHits); act == nil || act.String() != "2" {
+		t.Fatal("Expected 2 cache hits, got", act)
+	}
+}
+
+func TestQueryCacheApplyEdit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	doc, err := NewDocument(ctx, gr, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(number) @n"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewQueryCache()
+	exp := c.Matches("a.calc", q, doc)
+
+	if _, err = c.ApplyEdit(ctx, "a.calc", doc, 4, 9, nil); !errors.Is(err, ErrInvalidEdit) {
+		t.Fatalf("Expected %v, got %v", ErrInvalidEdit, err)
+	}
+
+	if act := c.Matches("a.calc", q, doc); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+
+}
+
+func TestQueryCacheRemove(t *testing.T) {
+	t.Parallel()
+
+	doc, err := NewDocument(context.Background(), gr, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(number) @n"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewQueryCache()
+	c.Matches("a.calc", q, doc)
+	c.Matches("b.calc", q, doc)
+	c.Remove("a.calc")
+
+	if len(c.entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(c.entries))
+	}
+}
+
+func TestQueryCacheEntryEdit(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRunQuery(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestCachedMatchSpan(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestCachedMatchEqual(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestByteSpanTouches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		a, b byteSpan
+		exp  bool
+	}{
+		{byteSpan{0, 1}, byteSpan{1, 2}, true},
+		{byteSpan{2, 3}, byteSpan{0, 2}, true},
+		{byteSpan{0, 1}, byteSpan{2, 3}, false},
+		{byteSpan{3, 3}, byteSpan{0, 2}, false},
+		{byteSpan{1, 1}, byteSpan{0, 2}, true},
+	}
+
+	for _, tc := range testCases {
+		if act := tc.a.touches(tc.b); act != tc.exp {
+			t.Fatalf("Expected %v touching %v to be %v, got %v", tc.a, tc.b, tc.exp, act)
+		}
+	}
+}
+
+func TestCompareMatches(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
@@ -0,0 +1,53 @@
+package sitter
+
+import (
+	"context"
+	"slices"
+)
+
+// OwnedMatch is a query match sent by [QueryCursor.MatchesChan]. Unlike
+// [QueryMatch], it owns its captures, so it can safely cross goroutines.
+type OwnedMatch struct {
+	// Err is set (on the last value sent) if the query execution was
+	// truncated, e.g. by a timeout; no other field is set then.
+	Err          error
+	Captures     []QueryCapture
+	PatternIndex uint
+	ID           uint
+}
+
+// MatchesChan runs the query in a new goroutine, sending the matches (text
+// predicates applied, as with [QueryCursor.Matches]) over the returned channel,
+// which has the given buffer size and is closed once done.
+//
+// The consumer must either drain the channel or cancel the context, otherwise
+// the goroutine leaks. Once the context is done, no further matches are sent
+// (nor the resulting error, see the context's Err instead). The cursor must not
+// be used until the channel is closed.
+func (qc *QueryCursor) MatchesChan(ctx context.Context, q *Query, n Node, text []byte, buffer int) <-chan *OwnedMatch {
+	ch := make(chan *OwnedMatch, max(buffer, 0))
+
+	go func() {
+		defer close(ch)
+
+		matches := qc.MatchesCtx(ctx, q, n, text)
+		for m := matches.Next(); m != nil; m = matches.Next() {
+			om := &OwnedMatch{Captures: slices.Clone(m.Captures), PatternIndex: m.PatternIndex, ID: m.ID}
+
+			select {
+			case ch <- om:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err := matches.Err(); err != nil {
+			select {
+			case ch <- &OwnedMatch{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return ch
+}
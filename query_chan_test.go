@@ -0,0 +1,55 @@
+package sitter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestQueryCursorMatchesChan(t *testing.T) {
+	t.Parallel()
+
+	content := []byte("1 + 2 + 3 + 4")
+
+	root, err := Parse(context.Background(), content, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte(`((number) @n (#not-eq? @n "3"))`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	act, exp := []string{}, []string{"1", "2", "4"}
+
+	for m := range NewQueryCursor().MatchesChan(context.Background(), q, root, content, 1) {
+		if m.Err != nil {
+			t.Fatal("Expected no error, got", m.Err)
+		}
+
+		act = append(act, m.Captures[0].Node.Content(content))
+	}
+
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := NewQueryCursor().MatchesChan(ctx, q, root, content, 0)
+
+	if m := <-ch; m == nil || m.Captures[0].Node.Content(content) != "1" {
+		t.Fatal("Expected the first match, got", m)
+	}
+
+	cancel()
+
+	n := 0
+	for range ch {
+		n++
+	}
+
+	if n > 1 {
+		t.Fatal("Expected at most one more match after cancellation, got", n)
+	}
+}
@@ -0,0 +1,143 @@
+package sitter
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"slices"
+	"sync"
+)
+
+// ParsedFile is a source file, already parsed, to run a query over with
+// [RunQueryOverFiles].
+type ParsedFile struct {
+	Root    Node
+	Path    string
+	Content []byte
+	// Truncated tells whether only the start of the file was parsed, see
+	// [SizeTruncate].
+	Truncated bool
+}
+
+// FileMatch is a query match found by [RunQueryOverFiles]. Unlike [QueryMatch],
+// it owns its captures, so it remains valid after the iteration moves on.
+type FileMatch struct {
+	// Err is set (on the last value yielded) if the query execution was
+	// truncated, e.g. by the context being done; no other field is set then,
+	// except for File.
+	Err          error
+	File         *ParsedFile
+	Captures     []QueryCapture
+	PatternIndex uint
+}
+
+// RunQueryOverFiles runs the query over all the files, using the given number
+// of workers (GOMAXPROCS if not positive), each with its own [QueryCursor].
+// The text predicates are applied, as with [QueryCursor.Matches].
+//
+// The matches are yielded file by file, in the order of the files, and in the
+// order they were found within each file. Stopping the iteration early stops
+// the workers as well.
+//
+// Each file is matched within a "sitter.Query" trace region, with the "file"
+// pprof label set to its path (on top of the labels of ctx, if any).
+func RunQueryOverFiles(ctx context.Context, q *Query, files []ParsedFile, workers int) iter.Seq[FileMatch] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	workers = max(min(workers, len(files)), 1)
+
+	return func(yield func(FileMatch) bool) {
+		runCtx, cancel := context.WithCancel(ctx)
+
+		var wg sync.WaitGroup
+
+		defer wg.Wait()
+		defer cancel()
+
+		jobs := make(chan int)
+		// Bounds how far ahead of the consumer the workers can get.
+		window := make(chan struct{}, 2*workers) //nolint:mnd // ok
+		results := make([]chan []FileMatch, len(files))
+
+		for i := range results {
+			results[i] = make(chan []FileMatch, 1)
+		}
+
+		wg.Add(workers + 1)
+
+		go func() {
+			defer wg.Done()
+			defer close(jobs)
+
+			for i := range files {
+				select {
+				case window <- struct{}{}:
+				case <-runCtx.Done():
+					return
+				}
+
+				select {
+				case jobs <- i:
+				case <-runCtx.Done():
+					return
+				}
+			}
+		}()
+
+		for range workers {
+			go func() {
+				defer wg.Done()
+
+				qc := NewQueryCursor()
+				for i := range jobs {
+					pprof.Do(runCtx, pprof.Labels("file", files[i].Path), func(ctx context.Context) {
+						results[i] <- matchFile(ctx, qc, q, &files[i])
+					})
+				}
+			}()
+		}
+
+		for i := range files {
+			var fileMatches []FileMatch
+
+			select {
+			case fileMatches = <-results[i]:
+			case <-runCtx.Done():
+				yield(FileMatch{File: &files[i], Err: runCtx.Err()})
+				return
+			}
+
+			for _, fm := range fileMatches {
+				if !yield(fm) || fm.Err != nil {
+					return
+				}
+			}
+
+			<-window
+		}
+	}
+}
+
+// matchFile collects all the matches of the query in the given file.
+func matchFile(ctx context.Context, qc *QueryCursor, q *Query, file *ParsedFile) (fileMatches []FileMatch) {
+	defer trace.StartRegion(ctx, "sitter.Query").End()
+
+	matches := qc.MatchesCtx(ctx, q, file.Root, file.Content)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		fileMatches = append(fileMatches, FileMatch{
+			File:         file,
+			Captures:     slices.Clone(m.Captures),
+			PatternIndex: m.PatternIndex,
+		})
+	}
+
+	if err := matches.Err(); err != nil {
+		fileMatches = append(fileMatches, FileMatch{File: file, Err: err})
+	}
+
+	return
+}
@@ -0,0 +1,86 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRunQueryOverFiles(t *testing.T) {
+	t.Parallel()
+
+	q, err := NewQuery(gr, []byte(`((number) @n (#not-eq? @n "0"))`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	files := make([]ParsedFile, 20)
+	exp := []string{}
+
+	var root Node
+
+	for i := range files {
+		content := []byte(fmt.Sprintf("%d + 0 + %d", i, i+1))
+
+		root, err = Parse(context.Background(), content, gr)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		files[i] = ParsedFile{Root: root, Path: fmt.Sprint("file", i), Content: content}
+
+		if i > 0 {
+			exp = append(exp, fmt.Sprintf("file%d:%d", i, i))
+		}
+
+		exp = append(exp, fmt.Sprintf("file%d:%d", i, i+1))
+	}
+
+	for _, workers := range []int{0, 1, 3, 100} {
+		t.Run(fmt.Sprint(workers), func(t *testing.T) {
+			t.Parallel()
+
+			act := []string{}
+
+			for fm := range RunQueryOverFiles(context.Background(), q, files, workers) {
+				if fm.Err != nil {
+					t.Fatal("Expected no error, got", fm.Err)
+				}
+
+				act = append(act, fm.File.Path+":"+fm.Captures[0].Node.Content(fm.File.Content))
+			}
+
+			if !reflect.DeepEqual(act, exp) {
+				t.Fatalf("Expected\n%s\ngot\n%s", strings.Join(exp, " "), strings.Join(act, " "))
+			}
+
+			// Stopping early.
+			n := 0
+			for range RunQueryOverFiles(context.Background(), q, files, workers) {
+				if n++; n == 5 {
+					break
+				}
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var last FileMatch
+	for fm := range RunQueryOverFiles(ctx, q, files, 2) {
+		last = fm
+	}
+
+	if !errors.Is(last.Err, context.Canceled) {
+		t.Fatalf("Expected %v, got %v", context.Canceled, last.Err)
+	}
+}
+
+func TestMatchFile(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
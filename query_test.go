@@ -3,8 +3,11 @@ package sitter
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewQuery(t *testing.T) {
@@ -186,22 +189,55 @@ func TestQueryPatternCount(t *testing.T) {
 
 func TestQueryCaptureCount(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	q, err := NewQuery(gr, []byte("(sum left: (_) @left right: (_) @right) (number) @left"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := q.CaptureCount(); act != 2 {
+		t.Fatal("Expected 2, got", act)
+	}
 }
 
 func TestQueryStringCount(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	q, err := NewQuery(gr, []byte(`((comment) @c (#eq? @c "// a")) ((comment) @d (#match? @d "b"))`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// The predicates' names count as strings.
+	if act := q.StringCount(); act != 4 {
+		t.Fatal("Expected 4, got", act)
+	}
 }
 
 func TestQueryStartByteForPattern(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	q, err := NewQuery(gr, []byte("(number) @n\n(sum) @s"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act0, act1 := q.StartByteForPattern(0), q.StartByteForPattern(1); act0 != 0 || act1 != 12 {
+		t.Fatalf("Expected 0 and 12, got %d and %d", act0, act1)
+	}
 }
 
 func TestQueryEndByteForPattern(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	q, err := NewQuery(gr, []byte("(number) @n\n(sum) @s"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act0, act1 := q.EndByteForPattern(0), q.EndByteForPattern(1); act0 != 12 || act1 != 20 {
+		t.Fatalf("Expected 12 and 20, got %d and %d", act0, act1)
+	}
 }
 
 func TestQueryPredicatesForPattern(t *testing.T) {
@@ -211,17 +247,45 @@ func TestQueryPredicatesForPattern(t *testing.T) {
 
 func TestQueryIsPatternRooted(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	q, err := NewQuery(gr, []byte("(number) @n\n((number) @a (comment) @b)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if !q.IsPatternRooted(0) || q.IsPatternRooted(1) {
+		t.Fatal("Expected only the first pattern to be rooted")
+	}
 }
 
 func TestQueryIsPatternNonLocal(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	// Only the sibling patterns which can match in a repetition are non-local.
+	q, err := NewQuery(grSuper, []byte("(number) @n\n((number) @a (variable) @b)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if q.IsPatternNonLocal(0) || !q.IsPatternNonLocal(1) {
+		t.Fatal("Expected only the second pattern to be non-local")
+	}
 }
 
 func TestQueryIsPatternGuaranteedAtStep(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	src := "(sum left: (expression (number) @x) right: (_) @y)"
+
+	q, err := NewQuery(gr, []byte(src))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// The right operand is there as soon as the left one is.
+	if q.IsPatternGuaranteedAtStep(0) || !q.IsPatternGuaranteedAtStep(uint32(strings.Index(src, "right"))) {
+		t.Fatal("Expected the pattern to be guaranteed at its right operand only")
+	}
 }
 
 func TestQueryCaptureNameForID(t *testing.T) {
@@ -231,7 +295,22 @@ func TestQueryCaptureNameForID(t *testing.T) {
 
 func TestQueryCaptureQuantifierForID(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	q, err := NewQuery(gr, []byte("(sum (expression)* @e) (expression (number)? @n) (sum left: (_) @l)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for i, exp := range []CaptureQuantifier{
+		CaptureQuantifierZeroOrMore, CaptureQuantifierZero, CaptureQuantifierZero,
+		CaptureQuantifierZero, CaptureQuantifierZeroOrOne, CaptureQuantifierZero,
+		CaptureQuantifierZero, CaptureQuantifierZero, CaptureQuantifierOne,
+	} {
+		pattern, capture := uint32(i/3), uint32(i%3) //nolint:gosec // ok
+		if act := q.CaptureQuantifierForID(pattern, capture); act != exp {
+			t.Fatalf("Expected %d for capture %d of pattern %d, got %d", exp, capture, pattern, act)
+		}
+	}
 }
 
 func TestQueryStringValueForID(t *testing.T) {
@@ -241,12 +320,50 @@ func TestQueryStringValueForID(t *testing.T) {
 
 func TestQueryDisableCapture(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(sum left: (_) @left right: (_) @right)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q.DisableCapture("right")
+
+	matches := NewQueryCursor().Matches(q, root, input)
+	if m := matches.Next(); m == nil || len(m.Captures) != 1 || q.CaptureNameForID(m.Captures[0].Index) != "left" {
+		t.Fatalf("Expected only the left capture, got %+v", m)
+	}
 }
 
 func TestQueryDisablePattern(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(sum) @sum (number) @number"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q.DisablePattern(0)
+
+	matches := NewQueryCursor().Matches(q, root, input)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		if m.PatternIndex != 1 {
+			t.Fatalf("Expected no match of the disabled pattern, got %+v", m)
+		}
+	}
 }
 
 func TestNewQueryCursor(t *testing.T) {
@@ -271,37 +388,129 @@ func TestQueryCursorExec(t *testing.T) {
 
 func TestQueryCursorDidExceedMatchLimit(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 + 3 + 4 + 5 + 6")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(sum left: (_) @left right: (_) @right)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	qc := NewQueryCursor()
+	qc.SetMatchLimit(1)
+
+	// The nested sums are all in progress at once, so all but one are dropped.
+	count, matches := 0, qc.Matches(q, root, input)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		count++
+	}
+
+	if count != 1 || !qc.DidExceedMatchLimit() {
+		t.Fatalf("Expected 1 match, exceeding the limit, got %d, %v", count, qc.DidExceedMatchLimit())
+	}
 }
 
 func TestQueryCursorMatchLimit(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	if act := NewQueryCursor().MatchLimit(); act != maxUint32 {
+		t.Fatal("Expected no limit, got", act)
+	}
 }
 
 func TestQueryCursorSetMatchLimit(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	qc := NewQueryCursor()
+	qc.SetMatchLimit(3)
+
+	if act := qc.MatchLimit(); act != 3 {
+		t.Fatal("Expected 3, got", act)
+	}
 }
 
 func TestQueryCursorSetTimeout(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	qc := NewQueryCursor()
+	qc.SetTimeout(1000)
+
+	if act := qc.Timeout(); act != 1000 {
+		t.Fatal("Expected 1000, got", act)
+	}
 }
 
 func TestQueryCursorTimeout(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	if act := NewQueryCursor().Timeout(); act != 0 {
+		t.Fatal("Expected no timeout, got", act)
+	}
 }
 
 func TestQueryCursorSetByteRange(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + (2 + 3)")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(number) @number"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	qc := NewQueryCursor()
+	qc.SetByteRange(4, 11)
+
+	var act []string
+
+	matches := qc.Matches(q, root, input)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		act = append(act, m.Captures[0].Node.Content(input))
+	}
+
+	if exp := []string{"2", "3"}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
 }
 
 func TestQueryCursorSetPointRange(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + (2 + 3)")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(number) @number"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	qc := NewQueryCursor()
+	qc.SetPointRange(Point{Column: 4}, Point{Column: 11})
+
+	var act []string
+
+	matches := qc.Matches(q, root, input)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		act = append(act, m.Captures[0].Node.Content(input))
+	}
+
+	if exp := []string{"2", "3"}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
 }
 
 func TestQueryCursorNextMatch(t *testing.T) {
@@ -311,17 +520,96 @@ func TestQueryCursorNextMatch(t *testing.T) {
 
 func TestQueryCursorRemoveMatch(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(sum left: (_) @left right: (_) @right)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	qc := NewQueryCursor()
+	captures := qc.Captures(q, root, input)
+
+	m, i := captures.Next()
+	if m == nil || m.Captures[i].Node.Content(input) != "1" {
+		t.Fatalf("Expected the left capture, got %+v", m)
+	}
+
+	// The rest of its captures go with it.
+	qc.RemoveMatch(m.ID)
+
+	if m, _ = captures.Next(); m != nil {
+		t.Fatalf("Expected no more captures, got %+v", m)
+	}
 }
 
 func TestQueryCursorNextCapture(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + (2 + 3) // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(sum left: (_) @left right: (_) @right) (comment) @comment"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	var act []string
+
+	captures := NewQueryCursor().Captures(q, root, input)
+	for m, i := captures.Next(); m != nil; m, i = captures.Next() {
+		c := m.Captures[i]
+		act = append(act, q.CaptureNameForID(c.Index)+" "+c.Node.Content(input))
+	}
+
+	// In the order of the nodes, rather than of the matches.
+	exp := []string{"left 1", "right (2 + 3)", "left 2", "right 3", "comment // c"}
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
 }
 
 func TestQueryCursorSetMaxStartDepth(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	input := []byte("1 + 2 + 3")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(expression) @e"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	qc := NewQueryCursor()
+
+	for depth, exp := range map[uint32][]string{0: {"1 + 2 + 3"}, 2: {"1 + 2 + 3", "1 + 2", "3"}} {
+		qc.SetMaxStartDepth(depth)
+
+		var act []string
+
+		matches := qc.Matches(q, root, input)
+		for m := matches.Next(); m != nil; m = matches.Next() {
+			act = append(act, m.Captures[0].Node.Content(input))
+		}
+
+		if !reflect.DeepEqual(act, exp) {
+			t.Fatalf("Expected %q at depth %d, got %q", exp, depth, act)
+		}
+	}
 }
 
 func TestQueryCursorCopy(t *testing.T) {
@@ -424,3 +712,756 @@ func TestQueryPredicateStepsAssertStepType(t *testing.T) {
 	t.Parallel()
 	t.Skip("tested implicitly")
 }
+
+func TestWithPredicators(t *testing.T) {
+	t.Parallel()
+
+	errOdd := errors.New("odd")
+	pattern := []byte(`((number) @n (#odd? @n)) ((number) @m (#eq? @m "1"))`)
+	odd := func(_ *Query, _ QueryPredicateSteps, op string, _ uint, _, _ func(int) func() string) (any, error) {
+		return nil, fmt.Errorf("%w: %s", errOdd, op)
+	}
+
+	if _, err := NewQuery(gr, pattern, WithPredicators(map[string]Predicator{"odd?": odd})); !errors.Is(err, errOdd) {
+		t.Fatalf("Expected %v, got %v", errOdd, err)
+	}
+
+	// Other queries are not affected.
+	q, err := NewQuery(gr, pattern)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := len(q.TextPredicates[1]); act != 1 {
+		t.Fatal("Expected 1 text predicate, got", act)
+	}
+
+	// Removing a builtin predicator makes it fall back to the default one.
+	if q, err = NewQuery(gr, pattern, WithPredicators(map[string]Predicator{"eq?": nil})); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := len(q.TextPredicates[1]); act != 0 {
+		t.Fatal("Expected no text predicates, got", act)
+	}
+
+	if _, ok := defaultPredicators["eq?"]; !ok {
+		t.Fatal("Expected the builtin predicators to not be modified")
+	}
+}
+
+func TestWithContextPredicators(t *testing.T) {
+	t.Parallel()
+
+	errOdd := errors.New("odd")
+	pattern := []byte("(sum) @s\n((number) @n (#odd? @n \"x\"))")
+
+	var act PredicateContext
+
+	odd := func(pc PredicateContext) (any, error) {
+		act = pc
+		return nil, pc.Error(errOdd, pc.Arg(0)+" "+pc.Arg(1)+" "+pc.Arg(2))
+	}
+
+	_, err := NewQuery(gr, pattern, WithContextPredicators(map[string]ContextPredicator{"odd?": odd}))
+	if !errors.Is(err, errOdd) {
+		t.Fatalf("Expected %v, got %v", errOdd, err)
+	}
+
+	if exp := `odd for odd? @n x at 2:1`; err.Error() != exp {
+		t.Fatalf("Expected %q, got %q", exp, err)
+	}
+
+	if act.PatternIndex != 1 || act.StartByte != 9 || act.EndByte != uint(len(pattern)) ||
+		act.Start != (Point{Row: 1}) || act.Language != gr || act.Op != "odd?" || len(act.Steps) != 3 {
+		t.Fatalf("Unexpected predicate context %+v", act)
+	}
+}
+
+func TestMatchPredicate(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + (2) + 3")
+	pattern := []byte(`((expression (number)) @e (#has-parent? @e "sum"))`)
+
+	hasParent := func(pc PredicateContext) (any, error) {
+		id, typ := pc.Steps[1].ValueID, pc.Arg(2)
+
+		return MatchPredicate(func(m *QueryMatch, _ []byte) bool {
+			for _, n := range m.NodesForCaptureIndex(uint(id)) {
+				if p := n.Parent(); p.IsNull() || p.Type() != typ {
+					return false
+				}
+			}
+
+			return true
+		}), nil
+	}
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, pattern, WithContextPredicators(map[string]ContextPredicator{"has-parent?": hasParent}))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	var act []string
+
+	matches := NewQueryCursor().Matches(q, root, input)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		for _, c := range m.Captures {
+			act = append(act, c.Node.Content(input))
+		}
+	}
+
+	if exp := []string{"1", "3"}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestWherePredicatesApplyTo(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + 2")
+	pattern := []byte(`(sum left: (expression (number) @n) right: (expression (number) @n) (#eq? @n "1")) @s`)
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		opts []QueryOption
+		exp  []string
+	}{
+		{nil, nil},
+		{[]QueryOption{WherePredicatesApplyTo("@n")}, []string{"s:1 + 2", "n:1"}},
+		{[]QueryOption{WherePredicatesApplyTo("s")}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.exp), func(t *testing.T) {
+			t.Parallel()
+
+			q, err := NewQuery(gr, pattern, tc.opts...)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			var fromMatches, fromCaptures []string
+
+			matches := NewQueryCursor().Matches(q, root, input)
+			for m := matches.Next(); m != nil; m = matches.Next() {
+				for _, c := range m.Captures {
+					fromMatches = append(fromMatches, q.CaptureNames()[c.Index]+":"+c.Node.Content(input))
+				}
+			}
+
+			captures := NewQueryCursor().Captures(q, root, input)
+			for m, i := captures.Next(); m != nil; m, i = captures.Next() {
+				c := m.Captures[i]
+				fromCaptures = append(fromCaptures, q.CaptureNames()[c.Index]+":"+c.Node.Content(input))
+			}
+
+			if !reflect.DeepEqual(fromMatches, tc.exp) || !reflect.DeepEqual(fromCaptures, tc.exp) {
+				t.Fatalf("Expected %q, got %q (matches) and %q (captures)", tc.exp, fromMatches, fromCaptures)
+			}
+		})
+	}
+}
+
+func TestQueryMatchScopedCaptures(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestQueryMatchCaptureIndex(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestAdaptPredicator(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestPredicateContextArg(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestPredicateContextError(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestQueryStartPointForPattern(t *testing.T) {
+	t.Parallel()
+
+	q, err := NewQuery(gr, []byte("(sum)\n  (number)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := Point{Row: 1, Column: 2}
+	if act := q.StartPointForPattern(1); act != exp {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+}
+
+func TestQueryCapturesForPattern(t *testing.T) {
+	t.Parallel()
+
+	q, err := NewQuery(gr, []byte("(sum left: (_) @left right: (_)? @right) @sum\n(number) @number\n(comment)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := [][]string{{"left", "right", "sum"}, {"number"}, nil}
+	for i, exp := range exp {
+		if act := q.CapturesForPattern(uint(i)); !reflect.DeepEqual(act, exp) {
+			t.Fatalf("Expected %q for pattern #%d, got %q", exp, i, act)
+		}
+	}
+}
+
+func TestQueryPropertyFor(t *testing.T) {
+	t.Parallel()
+
+	pattern := []byte(`((number) @n (#set! injection.language "js") (#set! @n conceal "#"))  ((sum) @s)`)
+
+	q, err := NewQuery(gr, pattern)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		pattern uint
+		key     string
+		exp     string
+		capture bool
+		ok      bool
+	}{
+		{0, "injection.language", "js", false, true},
+		{0, "conceal", "#", true, true},
+		{0, "foo", "", false, false},
+		{1, "conceal", "", false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.key, func(t *testing.T) {
+			t.Parallel()
+
+			act, ok := q.PropertyFor(tc.pattern, tc.key)
+			if ok != tc.ok {
+				t.Fatalf("Expected ok to be %v, got %v", tc.ok, ok)
+			}
+
+			if !ok {
+				return
+			}
+
+			if *act.Value != tc.exp || (act.CaptureID != nil) != tc.capture {
+				t.Fatalf("Expected %q (capture: %v), got %+v", tc.exp, tc.capture, act)
+			}
+		})
+	}
+}
+
+func TestQueryMatchProperty(t *testing.T) {
+	t.Parallel()
+
+	q, err := NewQuery(gr, []byte(`((number) @n (#set! conceal "#"))`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	root, err := Parse(context.Background(), []byte("1"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	matches := NewQueryCursor().Matches(q, root, []byte("1"))
+
+	m := matches.Next()
+	if m == nil {
+		t.Fatal("Expected a match")
+	}
+
+	if act, ok := m.Property(q, "conceal"); !ok || *act.Value != "#" {
+		t.Fatalf("Expected the conceal property, got %+v, %v", act, ok)
+	}
+}
+
+func TestQueryMatchProperties(t *testing.T) {
+	t.Parallel()
+
+	q, err := NewQuery(gr, []byte(`((expression (number)? @n) @e
+		(#set! kind "expression") (#set! @n kind "number") (#set! @e flag) (#set! kind "ignored"))`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	input := []byte("1 + 2")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	var act []map[string]string
+
+	matches := NewQueryCursor().Matches(q, root, input)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		act = append(act, m.Properties(q))
+	}
+
+	exp := []map[string]string{
+		{"kind": "expression", "flag": ""},
+		{"kind": "number", "flag": ""},
+		{"kind": "number", "flag": ""},
+	}
+
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+}
+
+func TestQueryMatchCaptureSummaries(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 +\n(2)")
+
+	q, err := NewQuery(gr, []byte(`(sum left: (_) @l right: (_) @r) @s`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	matches := NewQueryCursor().Matches(q, root, input)
+
+	m := matches.Next()
+	if m == nil {
+		t.Fatal("Expected a match")
+	}
+
+	var exp []CaptureSummary
+	for _, c := range m.Captures {
+		exp = append(exp, CaptureSummary{Range: c.Node.Range(), Index: c.Index, Symbol: c.Node.Symbol()})
+	}
+
+	if act := m.CaptureSummaries(); len(act) != 3 || !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+
+	if act := (&QueryMatch{}).CaptureSummaries(); act != nil {
+		t.Fatalf("Expected no summaries, got %+v", act)
+	}
+}
+
+func TestQueryCursorSetTimeoutDuration(t *testing.T) {
+	t.Parallel()
+
+	qc := NewQueryCursor()
+	qc.SetTimeoutDuration(2 * time.Millisecond)
+
+	if act := qc.Timeout(); act != 2000 {
+		t.Fatalf("Expected %d, got %d", 2000, act)
+	}
+
+	if act := qc.TimeoutDuration(); act != 2*time.Millisecond {
+		t.Fatalf("Expected %s, got %s", 2*time.Millisecond, act)
+	}
+}
+
+func TestQueryCursorTimeoutDuration(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestQueryCursorMatchesCtx(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + 2")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte("(number) @number"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	qc := NewQueryCursor()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	matches, count := qc.MatchesCtx(ctx, q, root, input), 0
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		count++
+	}
+
+	if count != 2 || matches.Err() != nil {
+		t.Fatalf("Expected 2 matches and no error, got %d and %v", count, matches.Err())
+	}
+
+	if act := qc.TimeoutDuration(); act != 0 {
+		t.Fatalf("Expected timeout to be restored, got %s", act)
+	}
+
+	cancel()
+
+	matches = qc.MatchesCtx(ctx, q, root, input)
+	if m := matches.Next(); m != nil {
+		t.Fatal("Expected no match, got", m)
+	}
+
+	if err = matches.Err(); !errors.Is(err, ErrQueryTruncated) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected %v and %v, got %v", ErrQueryTruncated, context.Canceled, err)
+	}
+}
+
+func TestQueryCursorMatchesWithOptions(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + 22 + 333")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte(`((number) @number (#not-eq? @number "22"))`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	var offsets []uint32
+
+	matches := NewQueryCursor().MatchesWithOptions(q, root, input, QueryCursorOptions{Progress: func(offset uint32) bool {
+		offsets = append(offsets, offset)
+		return true
+	}})
+
+	count := 0
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		count++
+	}
+
+	if exp := []uint32{0, 9}; count != 2 || matches.Err() != nil || !reflect.DeepEqual(offsets, exp) {
+		t.Fatalf("Expected 2 matches, no error and %v, got %d, %v and %v", exp, count, matches.Err(), offsets)
+	}
+
+	matches = NewQueryCursor().MatchesWithOptions(q, root, input, QueryCursorOptions{Progress: func(offset uint32) bool {
+		return offset < 9
+	}})
+
+	count = 0
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		count++
+	}
+
+	if err = matches.Err(); count != 1 || !errors.Is(err, ErrQueryTruncated) || !errors.Is(err, ErrProgressHalted) {
+		t.Fatalf("Expected 1 match and %v, got %d and %v", ErrProgressHalted, count, err)
+	}
+}
+
+func TestQueryMatchesReportProgress(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestQueryMatchesErr(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestQueryMatchesAll(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + 2 + 3")
+	pattern := []byte(`(sum left: (expression) @l right: (expression) @r)`)
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, pattern)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	var all []*QueryMatch
+
+	matches := NewQueryCursor().Matches(q, root, input)
+	for m := range matches.All() {
+		all = append(all, m)
+	}
+
+	var act []string
+
+	for _, m := range all {
+		for _, c := range m.Captures {
+			act = append(act, c.Node.Content(input))
+		}
+	}
+
+	if exp := []string{"1", "2", "1 + 2", "3"}; !reflect.DeepEqual(act, exp) || matches.Err() != nil {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	matches = NewQueryCursor().Matches(q, root, input)
+	for range matches.All() {
+		break
+	}
+
+	if m := matches.Next(); m == nil {
+		t.Fatal("Expected the iteration to stop early")
+	}
+
+	count := 0
+	for range NewQueryCursor().Matches(q, root, input).All() {
+		count++
+	}
+
+	if count != 2 {
+		t.Fatalf("Expected 2 matches, got %d", count)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The copy iterated over shares the state of the matches.
+	matches = NewQueryCursor().MatchesCtx(ctx, q, root, input)
+	for range matches.All() {
+		t.Fatal("Expected no matches")
+	}
+
+	if err = matches.Err(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestQueryCapturesAll(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + 2 + 3")
+	pattern := []byte(`(sum left: (expression) @l right: (expression) @r)`)
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, pattern)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	var all []QueryCapture
+
+	captures := NewQueryCursor().Captures(q, root, input)
+	for m, i := range captures.All() {
+		all = append(all, m.Captures[i])
+	}
+
+	var act []string
+
+	for _, c := range all {
+		act = append(act, q.CaptureNames()[c.Index]+":"+c.Node.Content(input))
+	}
+
+	if exp := []string{"l:1 + 2", "l:1", "r:2", "r:3"}; !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	count := 0
+	for range NewQueryCursor().Captures(q, root, input).All() {
+		count++
+	}
+
+	if count != len(all) {
+		t.Fatalf("Expected %d captures, got %d", len(all), count)
+	}
+}
+
+func TestQueryNewEqFilters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		query string
+		exp   bool
+	}{
+		{`(number) @n`, false},
+		{`((number) @n (#match? @n "^1"))`, false},
+		{`((number) @n (#any-eq? @n "1"))`, false},
+		{`((number) @n (#eq? @n "1"))`, true},
+		{`((number) @n (#not-eq? @n ""))`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			t.Parallel()
+
+			q, err := NewQuery(gr, []byte(tc.query))
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			if act := q.eqFilters != nil; act != tc.exp {
+				t.Fatalf("Expected C filters to be %v, got %v", tc.exp, act)
+			}
+		})
+	}
+
+	// Scoped predicates can't reject the whole match, so they are left to Go.
+	q, err := NewQuery(gr, []byte(`((number) @n (#eq? @n "1"))`), WherePredicatesApplyTo("n"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if q.eqFilters != nil {
+		t.Fatal("Expected no C filters for scoped predicates")
+	}
+}
+
+func TestQueryValidEqFilters(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte(`((number) @n (#eq? @n "1"))`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	count := func() (n int) {
+		for range NewQueryCursor().Matches(q, root, []byte("1 + 2")).All() {
+			n++
+		}
+
+		return
+	}
+
+	pred := q.TextPredicates[0][0]
+
+	pred2 := pred
+	pred2.Value = "2"
+
+	// Once changed, the predicates are only checked in Go.
+	for _, tc := range []struct {
+		predicates []TextPredicateCapture
+		exp        int
+		inC        bool
+	}{
+		{[]TextPredicateCapture{pred}, 1, true},
+		{nil, 2, false},
+		{[]TextPredicateCapture{pred2}, 1, false},
+		{[]TextPredicateCapture{pred, pred2}, 0, false},
+		{[]TextPredicateCapture{pred}, 1, true},
+	} {
+		q.TextPredicates[0] = tc.predicates
+
+		if act := count(); act != tc.exp {
+			t.Fatalf("Expected %d matches for %v, got %d", tc.exp, tc.predicates, act)
+		}
+
+		if act := q.validEqFilters() != nil; act != tc.inC {
+			t.Fatalf("Expected the C filters to be used to be %v, got %v", tc.inC, act)
+		}
+	}
+}
+
+func BenchmarkQueryCursorMatchesEq(b *testing.B) {
+	input := []byte(strings.Repeat("1 + 2 + ", 1000) + "3")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		b.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte(`((number) @n (#eq? @n "3"))`))
+	if err != nil {
+		b.Fatal("Expected no error, got", err)
+	}
+
+	qc := NewQueryCursor()
+
+	b.ResetTimer()
+
+	for range b.N {
+		matches, count := qc.Matches(q, root, input), 0
+		for m := matches.Next(); m != nil; m = matches.Next() {
+			count++
+		}
+
+		if count != 1 {
+			b.Fatal("Expected 1 match, got", count)
+		}
+	}
+}
+
+func TestNewQueryCursorWith(t *testing.T) {
+	t.Parallel()
+
+	input := []byte(strings.Repeat("(", 20) + "1" + strings.Repeat(" + 1)", 20))
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	q, err := NewQuery(gr, []byte(`(sum left: (_) @l right: (_) @r)`))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	count := func(qc *QueryCursor) (n int) {
+		matches := qc.Matches(q, root, input)
+		for m := matches.Next(); m != nil; m = matches.Next() {
+			n++
+		}
+
+		return
+	}
+
+	exp := count(NewQueryCursor())
+
+	qc := NewQueryCursorWith(WithMatchLimit(1))
+	if act := count(qc); act >= exp || !qc.DidExceedMatchLimit() {
+		t.Fatalf("Expected less than %d matches and the limit exceeded, got %d", exp, act)
+	}
+
+	qc = NewQueryCursorWith(WithMatchLimit(1), WithAdaptiveMatchLimit(1024))
+	if act := count(qc); act != exp {
+		t.Fatalf("Expected %d matches, got %d", exp, act)
+	}
+
+	if qc.MatchLimit() <= 1 {
+		t.Fatal("Expected the match limit to be raised, got", qc.MatchLimit())
+	}
+}
+
+//nolint:paralleltest // the default is global
+func TestSetDefaultMatchLimit(t *testing.T) {
+	SetDefaultMatchLimit(42)
+	defer SetDefaultMatchLimit(0)
+
+	if act := NewQueryCursor().MatchLimit(); act != 42 {
+		t.Fatal("Expected match limit 42, got", act)
+	}
+}
@@ -0,0 +1,115 @@
+package sitter
+
+// #include "sitter.h"
+import "C"
+
+import (
+	"math"
+	"sort"
+)
+
+// RangeIndex is a table of the byte ranges and symbols of the nodes of a tree,
+// by their descendant (preorder) index (see [TreeCursor.GotoDescendant]), built
+// in one walk (see [Tree.BuildRangeIndex]). It answers the "node at offset"
+// and interval queries by binary search, without crossing into C, which makes
+// it a fit for the editors looking up nodes often (e.g. on hover).
+//
+// The index is a snapshot: it is not updated when the tree is edited.
+type RangeIndex struct {
+	root    Node
+	starts  []uint32
+	ends    []uint32
+	symbols []Symbol
+	parents []uint32
+	sizes   []uint32 // The number of nodes in each subtree.
+}
+
+// BuildRangeIndex builds the [RangeIndex] of the tree.
+func (t *Tree) BuildRangeIndex() *RangeIndex {
+	root := t.RootNode()
+	n := root.DescendantCount()
+
+	ri := &RangeIndex{
+		root:    root,
+		starts:  make([]uint32, n),
+		ends:    make([]uint32, n),
+		symbols: make([]Symbol, n),
+		parents: make([]uint32, n),
+		sizes:   make([]uint32, n),
+	}
+
+	C.go_range_index(root.c, (*C.uint32_t)(&ri.starts[0]), (*C.uint32_t)(&ri.ends[0]), &ri.symbols[0],
+		(*C.uint32_t)(&ri.parents[0]), (*C.uint32_t)(&ri.sizes[0]))
+
+	return ri
+}
+
+// Len returns the number of nodes indexed.
+func (ri *RangeIndex) Len() int {
+	return len(ri.starts)
+}
+
+// StartByte returns the start byte of the node with the given index.
+func (ri *RangeIndex) StartByte(i int) uint {
+	return uint(ri.starts[i])
+}
+
+// EndByte returns the end byte of the node with the given index.
+func (ri *RangeIndex) EndByte(i int) uint {
+	return uint(ri.ends[i])
+}
+
+// Symbol returns the symbol of the node with the given index.
+func (ri *RangeIndex) Symbol(i int) Symbol {
+	return ri.symbols[i]
+}
+
+// Parent returns the index of the parent of the node with the given index,
+// or -1 for the root.
+func (ri *RangeIndex) Parent(i int) int {
+	if p := ri.parents[i]; p != math.MaxUint32 {
+		return int(p)
+	}
+
+	return -1
+}
+
+// Node returns the node with the given index.
+func (ri *RangeIndex) Node(i int) Node {
+	c := AcquireCursor(ri.root)
+	defer ReleaseCursor(c)
+
+	c.GotoDescendant(uint32(i)) //nolint:gosec // ok
+
+	return c.CurrentNode()
+}
+
+// At returns the index of the smallest node containing the byte at the given
+// offset, or -1 if there is none.
+func (ri *RangeIndex) At(offset uint) int {
+	// As the nodes start in order, the last one starting at (or before) the
+	// offset is either the one containing it, or a descendant of it.
+	i := sort.Search(len(ri.starts), func(i int) bool { return uint(ri.starts[i]) > offset }) - 1
+
+	for i >= 0 && uint(ri.ends[i]) <= offset {
+		i = ri.Parent(i)
+	}
+
+	return i
+}
+
+// Overlapping returns the indexes of the nodes overlapping the given byte
+// range, in order, skipping the subtrees ending before it.
+func (ri *RangeIndex) Overlapping(start, end uint) (out []int) {
+	for i := 0; i < len(ri.starts) && uint(ri.starts[i]) < end; {
+		if uint(ri.ends[i]) <= start {
+			i += int(ri.sizes[i])
+			continue
+		}
+
+		out = append(out, i)
+		i++
+	}
+
+	return
+}
@@ -0,0 +1,117 @@
+package sitter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestTreeBuildRangeIndex(t *testing.T) {
+	t.Parallel()
+
+	ri := testRangeIndex(t)
+
+	if act, exp := ri.Len(), int(ri.root.DescendantCount()); act != exp {
+		t.Fatalf("Expected %d nodes, got %d", exp, act)
+	}
+
+	c := NewTreeCursor(ri.root)
+	defer c.close()
+
+	for i := range ri.Len() {
+		c.GotoDescendant(uint32(i)) //nolint:gosec // ok
+		n := c.CurrentNode()
+
+		if ri.StartByte(i) != n.StartByte() || ri.EndByte(i) != n.EndByte() || ri.Symbol(i) != n.Symbol() {
+			t.Fatalf("Expected node #%d to be %s [%d-%d], got %d [%d-%d]", i, n.Type(), n.StartByte(), n.EndByte(),
+				ri.Symbol(i), ri.StartByte(i), ri.EndByte(i))
+		}
+
+		if p := ri.Parent(i); p < 0 && i > 0 || p >= 0 && !ri.Node(p).Equal(n.Parent()) {
+			t.Fatalf("Expected the parent of node #%d to be %s, got #%d", i, n.Parent(), p)
+		}
+	}
+}
+
+func TestRangeIndexLen(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRangeIndexStartByte(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRangeIndexEndByte(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRangeIndexSymbol(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRangeIndexParent(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRangeIndexNode(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRangeIndexAt(t *testing.T) {
+	t.Parallel()
+
+	ri := testRangeIndex(t)
+
+	for offset := range uint(len(testRangeIndexSrc)) {
+		exp := ri.root.DescendantForByteRange(uint32(offset), uint32(offset+1)) //nolint:gosec // ok
+		if act := ri.Node(ri.At(offset)); !act.Equal(exp) {
+			t.Fatalf("Expected %s at %d, got %s", exp, offset, act)
+		}
+	}
+
+	if act := ri.At(99); act != -1 {
+		t.Fatal("Expected no node past the end, got", act)
+	}
+}
+
+func TestRangeIndexOverlapping(t *testing.T) {
+	t.Parallel()
+
+	ri := testRangeIndex(t)
+
+	for _, rng := range [][2]uint{{0, 1}, {2, 5}, {5, 6}, {6, 12}, {8, 99}, {3, 3}} {
+		var exp []int
+
+		for i := range ri.Len() {
+			if ri.StartByte(i) < rng[1] && ri.EndByte(i) > rng[0] {
+				exp = append(exp, i)
+			}
+		}
+
+		if act := ri.Overlapping(rng[0], rng[1]); !reflect.DeepEqual(act, exp) {
+			t.Fatalf("Expected %v for %v, got %v", exp, rng, act)
+		}
+	}
+}
+
+const testRangeIndexSrc = "1 + (2 +  3)\n// c"
+
+func testRangeIndex(t *testing.T) *RangeIndex {
+	t.Helper()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte(testRangeIndexSrc))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	return tree.BuildRangeIndex()
+}
@@ -0,0 +1,150 @@
+package scan
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// rule is an ignore rule, i.e. a line of an ignore file, or an include or
+// exclude glob.
+type rule struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// rules are the rules of an ignore file, whose patterns are relative to dir
+// (slash separated, relative to the root, "" for the root itself).
+type rules struct {
+	dir   string
+	rules []rule
+}
+
+// parseRule parses a rule, in the .gitignore syntax.
+func parseRule(pattern string) (r rule, err error) {
+	if r.negate = strings.HasPrefix(pattern, "!"); r.negate {
+		pattern = pattern[1:]
+	}
+
+	if r.dirOnly = strings.HasSuffix(pattern, "/"); r.dirOnly {
+		pattern = strings.TrimRight(pattern, "/")
+	}
+
+	// Patterns with a slash (other than a trailing one) are relative to the
+	// directory of the ignore file; the others match at any depth.
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	if pattern == "" {
+		return r, fmt.Errorf("%w: %q", ErrInvalidPattern, pattern)
+	}
+
+	expr := globExpr(pattern)
+	if !anchored {
+		expr = "(?:.*/)?" + expr
+	}
+
+	if r.re, err = regexp.Compile("^" + expr + "$"); err != nil {
+		return r, fmt.Errorf("%w: %q: %w", ErrInvalidPattern, pattern, err)
+	}
+
+	return
+}
+
+// globExpr converts a glob to a regular expression: "*" and "?" match within
+// a path segment, "**" across them, and "[...]" is a character class.
+func globExpr(glob string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; {
+		case strings.HasPrefix(glob[i:], "**/") && (i == 0 || glob[i-1] == '/'):
+			sb.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case c == '*':
+			sb.WriteString("[^/]*")
+		case c == '?':
+			sb.WriteString("[^/]")
+		case c == '\\' && i+1 < len(glob):
+			i++
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(`\[`)
+				continue
+			}
+
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			sb.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		default:
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+
+	return sb.String()
+}
+
+// readRules reads the ignore file at path, for the directory dir, skipping
+// its invalid lines. A missing file has no rules.
+func readRules(path, dir string) (rs rules, err error) {
+	rs.dir = dir
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+
+		return
+	}
+
+	lines := bufio.NewScanner(bytes.NewReader(b))
+	for lines.Scan() {
+		line := strings.TrimRight(lines.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if r, err := parseRule(line); err == nil {
+			rs.rules = append(rs.rules, r)
+		}
+	}
+
+	return rs, lines.Err()
+}
+
+// ignored tells whether the path (slash separated, relative to the root) is
+// ignored by the rules: the last rule matching it decides.
+func ignored(sets []rules, rel string, isDir bool) (ignore bool) {
+	for _, rs := range sets {
+		sub := rel
+
+		if rs.dir != "" {
+			var ok bool
+			if sub, ok = strings.CutPrefix(rel, rs.dir+"/"); !ok {
+				continue
+			}
+		}
+
+		for _, r := range rs.rules {
+			if (!r.dirOnly || isDir) && r.re.MatchString(sub) {
+				ignore = !r.negate
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,103 @@
+package scan
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern, path string
+		isDir, exp    bool
+	}{
+		{"*.go", "a.go", false, true},
+		{"*.go", "a/b/c.go", false, true},
+		{"*.go", "a.gox", false, false},
+		{"/a.go", "a.go", false, true},
+		{"/a.go", "b/a.go", false, false},
+		{"a/*.go", "a/b.go", false, true},
+		{"a/*.go", "a/b/c.go", false, false},
+		{"a/**/*.go", "a/b/c/d.go", false, true},
+		{"a/**/*.go", "a/d.go", false, true},
+		{"**/b", "a/b", true, true},
+		{"a/**", "a/b/c", false, true},
+		{"vendor/", "x/vendor", true, true},
+		{"vendor/", "x/vendor", false, false},
+		{"?.go", "ab.go", false, false},
+		{"[a-c].go", "b.go", false, true},
+		{"[!a-c].go", "b.go", false, false},
+		{`\#a`, "#a", false, true},
+		{"a[", "a[", false, true},
+	}
+
+	for _, tc := range testCases {
+		r, err := parseRule(tc.pattern)
+		if err != nil {
+			t.Fatalf("Expected no error for %q, got %v", tc.pattern, err)
+		}
+
+		if act := ignored([]rules{{rules: []rule{r}}}, tc.path, tc.isDir); act != tc.exp {
+			t.Fatalf("Expected %v for %q and %q, got %v", tc.exp, tc.pattern, tc.path, act)
+		}
+	}
+
+	for _, pattern := range []string{"/", "!"} {
+		if _, err := parseRule(pattern); !errors.Is(err, ErrInvalidPattern) {
+			t.Fatalf("Expected %v for %q, got %v", ErrInvalidPattern, pattern, err)
+		}
+	}
+}
+
+func TestGlobExpr(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestReadRules(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), ".gitignore")
+	if err := os.WriteFile(path, []byte("# comment\n\n*.log  \n!keep.log\n/\n"), 0o600); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	rs, err := readRules(path, "sub")
+	if err != nil || rs.dir != "sub" || len(rs.rules) != 2 {
+		t.Fatalf("Expected 2 rules for sub, got %+v (%v)", rs, err)
+	}
+
+	if rs, err = readRules(path+".nope", ""); err != nil || rs.rules != nil {
+		t.Fatalf("Expected no rules, got %+v (%v)", rs, err)
+	}
+}
+
+func TestIgnored(t *testing.T) {
+	t.Parallel()
+
+	parse := func(patterns ...string) (rs []rule) {
+		for _, p := range patterns {
+			r, err := parseRule(p)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			rs = append(rs, r)
+		}
+
+		return
+	}
+
+	sets := []rules{{rules: parse("*.log", "b/")}, {dir: "a", rules: parse("!keep.log", "/c")}}
+
+	for path, exp := range map[string]bool{
+		"x.log": true, "a/keep.log": false, "b/keep.log": true, "a/c": true, "c": false, "a/b": true, "a/d/c": false,
+	} {
+		if act := ignored(sets, path, path == "a/b"); act != exp {
+			t.Fatalf("Expected %v for %q, got %v", exp, path, act)
+		}
+	}
+}
@@ -0,0 +1,332 @@
+// Package scan finds the source files of a workspace, for indexing or
+// searching them, skipping the ones that do not belong there:
+//
+//	s, err := scan.New(scan.Include("*.go"), scan.Exclude("vendor/"), scan.BuildTags("linux", "amd64"))
+//	// ...
+//	paths, err := s.Files(root)
+//
+// By default, the scanner skips:
+//
+//   - the files and folders ignored by the .gitignore and .ignore files found
+//     along the way (in the .gitignore syntax, see [IgnoreFiles]);
+//   - the hidden files and folders (see [Hidden]);
+//   - the binary files, i.e. those with a NUL byte in their first 8000 bytes,
+//     as git tells them (see [Binary]).
+//
+// Optionally, it also skips the files larger than a given size (see [MaxSize]),
+// e.g. the generated ones, which would otherwise dominate the indexing time.
+//
+// The paths given explicitly (i.e. the roots which are files) are only
+// subject to the include globs, size limit, binary sniffing and build tags.
+// The files and folders found under the roots which cannot be read (e.g. for
+// lack of permissions, or as they were removed meanwhile) are skipped, only
+// the roots which cannot be read failing the scan.
+package scan
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"go/build/constraint"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/alexaandru/go-tree-sitter-bare/batch"
+)
+
+// Option configures a [Scanner].
+type Option func(*Scanner) error
+
+// Scanner finds files, see [New].
+type Scanner struct {
+	include     []rule
+	exclude     rules
+	ignoreFiles []string
+	hidden      bool
+	binary      bool
+	tags        map[string]bool
+	maxSize     int64
+	skipped     func(path string, size int64)
+}
+
+// ErrInvalidPattern is returned for the invalid globs.
+var ErrInvalidPattern = errors.New("invalid pattern")
+
+// sniffLen is how many bytes are read from the files, to tell the binary ones
+// apart, as git does.
+const sniffLen = 8000
+
+// New creates a scanner with the given options.
+func New(opts ...Option) (*Scanner, error) {
+	s := &Scanner{ignoreFiles: []string{".gitignore", ".ignore"}}
+
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Include only keeps the files matching any of the globs (in the .gitignore
+// syntax, relative to the roots, e.g. "*.go" or "/cmd/**/*.go").
+func Include(globs ...string) Option {
+	return func(s *Scanner) error {
+		for _, glob := range globs {
+			r, err := parseRule(glob)
+			if err != nil {
+				return err
+			}
+
+			s.include = append(s.include, r)
+		}
+
+		return nil
+	}
+}
+
+// Exclude skips the files and folders matching any of the globs (in the
+// .gitignore syntax, relative to the roots, e.g. "vendor/" or "*_test.go"),
+// as if they were listed in an ignore file of the roots, taking precedence
+// over the others.
+func Exclude(globs ...string) Option {
+	return func(s *Scanner) error {
+		for _, glob := range globs {
+			r, err := parseRule(glob)
+			if err != nil {
+				return err
+			}
+
+			s.exclude.rules = append(s.exclude.rules, r)
+		}
+
+		return nil
+	}
+}
+
+// IgnoreFiles sets the names of the ignore files (".gitignore" and ".ignore"
+// by default), whose rules apply to the folder they are in. Passing none
+// disables them. As with git, the files in ignored folders cannot be included
+// back by the ignore files of their own.
+func IgnoreFiles(names ...string) Option {
+	return func(s *Scanner) error {
+		s.ignoreFiles = names
+		return nil
+	}
+}
+
+// Hidden keeps the hidden files and folders (those whose name starts with a
+// dot), except for the .git folders.
+func Hidden() Option {
+	return func(s *Scanner) error {
+		s.hidden = true
+		return nil
+	}
+}
+
+// Binary keeps the binary files.
+func Binary() Option {
+	return func(s *Scanner) error {
+		s.binary = true
+		return nil
+	}
+}
+
+// BuildTags skips the Go files whose build constraints (//go:build lines) are
+// not satisfied by the given tags (e.g. the GOOS, GOARCH and custom ones).
+func BuildTags(tags ...string) Option {
+	return func(s *Scanner) error {
+		s.tags = map[string]bool{}
+		for _, tag := range tags {
+			s.tags[tag] = true
+		}
+
+		return nil
+	}
+}
+
+// MaxSize skips the files larger than n bytes, reporting each of them (with
+// its size) to skipped, if not nil. See Parser.ParseFile, of the root package,
+// for parsing them partially instead.
+func MaxSize(n int64, skipped func(path string, size int64)) Option {
+	return func(s *Scanner) error {
+		s.maxSize, s.skipped = n, skipped
+		return nil
+	}
+}
+
+// Files returns the paths of the files found under roots (files or folders,
+// walked recursively, in lexical order).
+func (s *Scanner) Files(roots ...string) ([]string, error) {
+	return s.FilesCtx(context.Background(), roots...)
+}
+
+// FilesCtx is like [Scanner.Files], stopping once ctx is done, in which case
+// the paths found so far are returned, along with a [batch.Partial] error.
+func (s *Scanner) FilesCtx(ctx context.Context, roots ...string) ([]string, error) {
+	paths := &batch.Results[string]{}
+
+	for _, root := range roots {
+		if err := s.scan(ctx, paths, root); err != nil {
+			return paths.Done(ctx, err)
+		}
+	}
+
+	return paths.Done(ctx, nil)
+}
+
+// scan adds the paths of the files found under root to paths.
+func (s *Scanner) scan(ctx context.Context, paths *batch.Results[string], root string) error {
+	info, err := os.Stat(root)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		return s.walk(ctx, paths, root, "", nil)
+	}
+
+	if ok, err := s.keep(root, filepath.Base(root)); err != nil || !ok {
+		return err
+	}
+
+	paths.Add(root)
+
+	return nil
+}
+
+// walk adds the paths of the files found under the folder at dir (rel,
+// relative to root) to paths, the rules of the ignore files of its parent
+// folders being given.
+func (s *Scanner) walk(ctx context.Context, paths *batch.Results[string], dir, rel string, sets []rules) error {
+	for _, name := range s.ignoreFiles {
+		rs, err := readRules(filepath.Join(dir, name), rel)
+		if err != nil {
+			return err
+		}
+
+		if len(rs.rules) > 0 {
+			sets = append(sets[:len(sets):len(sets)], rs)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		name, isDir := e.Name(), e.IsDir()
+		if name == ".git" || (!s.hidden && strings.HasPrefix(name, ".")) {
+			continue
+		}
+
+		p, r := filepath.Join(dir, name), path.Join(rel, name)
+		if ignored(sets, r, isDir) || ignored([]rules{s.exclude}, r, isDir) {
+			continue
+		}
+
+		// The folders and files which cannot be read are skipped.
+		switch {
+		case isDir:
+			if err = s.walk(ctx, paths, p, r, sets); err != nil && !errors.As(err, new(*fs.PathError)) {
+				return err
+			}
+		case e.Type().IsRegular():
+			if ok, err := s.keep(p, r); err == nil && ok {
+				paths.Add(p)
+			}
+		}
+	}
+
+	return nil
+}
+
+// keep tells whether to keep the file at p (rel, relative to the root), as
+// far as the include globs, size limit, binary sniffing and build tags are
+// concerned.
+func (s *Scanner) keep(p, rel string) (bool, error) {
+	if s.include != nil && !ignored([]rules{{rules: s.include}}, rel, false) {
+		return false, nil
+	}
+
+	if s.maxSize > 0 {
+		info, err := os.Stat(p)
+		if err != nil {
+			return false, err
+		}
+
+		if size := info.Size(); size > s.maxSize {
+			if s.skipped != nil {
+				s.skipped(p, size)
+			}
+
+			return false, nil
+		}
+	}
+
+	checkTags := s.tags != nil && strings.HasSuffix(p, ".go")
+	if s.binary && !checkTags {
+		return true, nil
+	}
+
+	head, err := readHead(p)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.binary && bytes.IndexByte(head, 0) >= 0 {
+		return false, nil
+	}
+
+	return !checkTags || s.satisfied(head), nil
+}
+
+// satisfied tells whether the build constraints of the Go source text (of
+// which head is the start) are satisfied by the tags.
+func (s *Scanner) satisfied(head []byte) bool {
+	lines := bufio.NewScanner(bytes.NewReader(head))
+	for lines.Scan() {
+		line := strings.TrimSpace(lines.Text())
+
+		switch {
+		case line == "":
+		case constraint.IsGoBuild(line):
+			expr, err := constraint.Parse(line)
+			return err != nil || expr.Eval(func(tag string) bool { return s.tags[tag] })
+		case !strings.HasPrefix(line, "//"):
+			return true // The constraints must come before the package clause.
+		}
+	}
+
+	return true
+}
+
+// readHead reads the first bytes of the named file, see sniffLen.
+func readHead(name string) ([]byte, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck // ok, read only
+
+	head := make([]byte, sniffLen)
+
+	n, err := io.ReadFull(f, head)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		err = nil
+	}
+
+	return head[:n], err
+}
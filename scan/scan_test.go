@@ -0,0 +1,260 @@
+package scan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/alexaandru/go-tree-sitter-bare/batch"
+)
+
+// writeFiles writes the files (by path, relative to dir) with their contents.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for path, content := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	for _, opt := range []Option{Include("/"), Exclude("!")} {
+		if _, err := New(opt); !errors.Is(err, ErrInvalidPattern) {
+			t.Fatalf("Expected %v, got %v", ErrInvalidPattern, err)
+		}
+	}
+}
+
+func TestInclude(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestExclude(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestIgnoreFiles(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestHidden(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestBinary(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestBuildTags(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestMaxSize(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"a.go": "package a", "gen.go": "package a // generated"})
+
+	var skipped []string
+
+	s, err := New(MaxSize(10, func(path string, size int64) {
+		skipped = append(skipped, fmt.Sprint(filepath.Base(path), ":", size))
+	}))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	act, err := s.Files(dir)
+	if exp := []string{filepath.Join(dir, "a.go")}; err != nil || !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v (%v)", exp, act, err)
+	}
+
+	if exp := []string{"gen.go:22"}; !reflect.DeepEqual(skipped, exp) {
+		t.Fatalf("Expected %v, got %v", exp, skipped)
+	}
+}
+
+func TestScannerFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".gitignore":            "node_modules/\n*.log\n",
+		".hidden/a.go":          "package a",
+		".git/config":           "",
+		"a.go":                  "package a",
+		"a_linux.go":            "// Copyright.\n\n//go:build linux\n\npackage a",
+		"a_windows.go":          "//go:build windows\n\npackage a",
+		"bin.dat":               "\x00\x01",
+		"debug.log":             "",
+		"node_modules/x/x.js":   "",
+		"sub/.ignore":           "!keep.log\n/gen/\n",
+		"sub/b.go":              "package b",
+		"sub/gen/c.go":          "package gen",
+		"sub/keep.log":          "",
+		"vendor/v/v.go":         "package v",
+		"vendor/v/modules.txt":  "",
+		"sub/node_modules/y.js": "",
+	})
+
+	rel := func(paths []string) []string {
+		for i, p := range paths {
+			paths[i], _ = filepath.Rel(dir, p)
+			paths[i] = filepath.ToSlash(paths[i])
+		}
+
+		return paths
+	}
+
+	testCases := []struct {
+		opts []Option
+		exp  []string
+	}{
+		{nil, []string{
+			"a.go", "a_linux.go", "a_windows.go", "sub/b.go", "sub/keep.log", "vendor/v/modules.txt", "vendor/v/v.go",
+		}},
+		{[]Option{Include("*.go"), Exclude("vendor/"), BuildTags("linux")}, []string{"a.go", "a_linux.go", "sub/b.go"}},
+		{[]Option{Include("/*.go")}, []string{"a.go", "a_linux.go", "a_windows.go"}},
+		{[]Option{Include("a.go"), Hidden()}, []string{".hidden/a.go", "a.go"}},
+		{[]Option{Include("*.log", "*.dat"), IgnoreFiles(), Binary()}, []string{"bin.dat", "debug.log", "sub/keep.log"}},
+	}
+
+	for _, tc := range testCases {
+		s, err := New(tc.opts...)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		act, err := s.Files(dir)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if act = rel(act); !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %v, got %v", tc.exp, act)
+		}
+	}
+
+	s, err := New(BuildTags("windows"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// The explicit paths are not subject to the ignore files.
+	act, err := s.Files(filepath.Join(dir, "debug.log"), filepath.Join(dir, "a_linux.go"), filepath.Join(dir, "a.go"))
+	if exp := []string{"debug.log", "a.go"}; err != nil || !reflect.DeepEqual(rel(act), exp) {
+		t.Fatalf("Expected %v, got %v (%v)", exp, act, err)
+	}
+
+	if _, err = s.Files(filepath.Join(dir, "nope")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected %v, got %v", os.ErrNotExist, err)
+	}
+}
+
+func TestScannerFilesUnreadable(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"a.go": "package a", "b.go": "package a", "sub/c.go": "package sub"})
+
+	for _, name := range []string{"b.go", "sub"} {
+		p := filepath.Join(dir, name)
+		if err := os.Chmod(p, 0); err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		t.Cleanup(func() { _ = os.Chmod(p, 0o700) })
+	}
+
+	if f, err := os.Open(filepath.Join(dir, "b.go")); err == nil {
+		f.Close()
+		t.Skip("Permissions are not enforced (e.g. running as root)")
+	}
+
+	s, err := New()
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	act, err := s.Files(dir)
+	if exp := []string{filepath.Join(dir, "a.go")}; err != nil || !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v (%v)", exp, act, err)
+	}
+
+	// Unless given explicitly.
+	if _, err = s.Files(filepath.Join(dir, "b.go")); !errors.Is(err, os.ErrPermission) {
+		t.Fatalf("Expected %v, got %v", os.ErrPermission, err)
+	}
+}
+
+func TestScannerFilesCtx(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"a.go": "package a", "b.go": "package a"})
+
+	s, err := New()
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var partial *batch.Partial
+
+	if act, err := s.FilesCtx(ctx, dir); !errors.As(err, &partial) || !errors.Is(err, context.Canceled) || act != nil {
+		t.Fatalf("Expected a partial error and no paths, got %v and %v", err, act)
+	}
+
+	// The explicit paths are not walked.
+	act, err := s.FilesCtx(ctx, filepath.Join(dir, "a.go"))
+	if exp := []string{filepath.Join(dir, "a.go")}; !errors.As(err, &partial) || !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected a partial error and %v, got %v and %v", exp, err, act)
+	}
+}
+
+func TestScannerScan(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestScannerWalk(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestScannerKeep(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestScannerSatisfied(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestReadHead(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
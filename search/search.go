@@ -0,0 +1,346 @@
+// Package search implements searching source files with tree-sitter queries
+// (a "grep with queries"), on top of a small registry of languages.
+//
+// The package ships no grammars: register the languages you need, then either
+// call [Run] or build your own command line tool around [Main]:
+//
+//	func main() {
+//		search.Register("go", sitter.NewLanguage(golang.GetLanguage()), ".go")
+//		os.Exit(search.Main(os.Args[1:], os.Stdout, os.Stderr))
+//	}
+package search
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/batch"
+	scanner "github.com/alexaandru/go-tree-sitter-bare/scan"
+)
+
+// Language is a language registered with [Register].
+type Language struct {
+	Language *sitter.Language
+	Name     string
+	// Extensions are the file name extensions (e.g. ".go") searched for the
+	// language. If empty, all files are searched.
+	Extensions []string
+}
+
+// Result is a captured node found by [Run].
+type Result struct {
+	Path    string
+	Capture string
+	Text    string
+	Range   sitter.Range
+	// PatternIndex is the index of the query pattern that matched.
+	PatternIndex uint
+}
+
+// Possible errors.
+var (
+	ErrUnknownLanguage = errors.New("unknown language")
+	ErrUsage           = errors.New("usage: [-l language] pattern path...")
+)
+
+var (
+	registry   = map[string]Language{} //nolint:gochecknoglobals // ok
+	registryMu sync.RWMutex            //nolint:gochecknoglobals // ok
+
+	logger atomic.Pointer[slog.Logger] //nolint:gochecknoglobals // ok
+)
+
+// Register registers the language under the given name, for the files with
+// the given extensions. Registering a name again replaces the language.
+func Register(name string, lang *sitter.Language, exts ...string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry[name] = Language{Language: lang, Name: name, Extensions: exts}
+}
+
+// SetLogger sets the structured logger used for diagnostics: the parse of each
+// file (see [sitter.Parser.SetSlog]), with the file and language attributes, and
+// a summary of each search. Passing nil disables logging.
+func SetLogger(l *slog.Logger) {
+	logger.Store(l)
+}
+
+// Lookup returns the language registered under the given name, if any.
+func Lookup(name string) (lang Language, ok bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	lang, ok = registry[name]
+
+	return
+}
+
+// Languages returns the names of the registered languages, sorted.
+func Languages() (names []string) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	for name := range registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return
+}
+
+// Run searches the files (of the given language) found under roots (files
+// or folders, walked recursively, skipping the hidden, ignored and binary
+// files, see the scan package) with the query pattern, returning the captured
+// nodes, file by file, in match order.
+//
+// The files are parsed and searched with the "language" and "file" pprof
+// labels set, so that the CPU time can be attributed in profiles.
+func Run(pattern, langName string, roots []string) ([]Result, error) {
+	return RunCtx(context.Background(), pattern, langName, roots)
+}
+
+// RunCtx is like [Run], stopping once ctx is done, in which case the results
+// found so far are returned, along with a [batch.Partial] error.
+func RunCtx(ctx context.Context, pattern, langName string, roots []string) (results []Result, err error) {
+	if l := logger.Load(); l != nil {
+		defer func(start time.Time) {
+			l.Debug("search done", "language", langName, "pattern", pattern, "results", len(results),
+				"duration", time.Since(start), "error", err)
+		}(time.Now())
+	}
+
+	lang, ok := Lookup(langName)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLanguage, langName)
+	}
+
+	q, err := sitter.NewQuery(lang.Language, []byte(pattern))
+	if err != nil {
+		return
+	}
+
+	paths, err := scan(ctx, roots, lang.Extensions)
+	if err != nil {
+		return
+	}
+
+	pprof.Do(ctx, pprof.Labels("language", lang.Name), func(ctx context.Context) {
+		results, err = search(ctx, q, paths, lang)
+	})
+
+	return
+}
+
+// Main is the command line front end of [Run], to be called with the command
+// line arguments (without the program name) by a command that registered the
+// languages it supports. It prints one result per line (path:row:column:
+// @capture text) and returns the exit code: 0 if anything was found, 1 if
+// nothing was found, 2 on errors.
+//
+// With -C n, the [Snippets] with n lines of context are printed instead, grep
+// style (path:row:line for lines with results, path-row-line for context, and
+// "--" between snippets). With -rank, the files are listed by [Rank].
+//
+// If only one language is registered, the -l flag can be omitted.
+func Main(args []string, stdout, stderr io.Writer) int {
+	fset := flag.NewFlagSet("search", flag.ContinueOnError)
+	fset.SetOutput(stderr)
+	langName := fset.String("l", "", "the language to search ("+strings.Join(Languages(), ", ")+")")
+	contextLines := fset.Int("C", -1, "print snippets with this many lines of context")
+	rank := fset.Bool("rank", false, "order the files by match density")
+
+	if err := fset.Parse(args); err != nil {
+		return 2 //nolint:mnd // ok
+	}
+
+	if *langName == "" {
+		if langs := Languages(); len(langs) == 1 {
+			*langName = langs[0]
+		}
+	}
+
+	if fset.NArg() < 2 { //nolint:mnd // ok
+		fmt.Fprintln(stderr, ErrUsage)
+		return 2 //nolint:mnd // ok
+	}
+
+	results, err := Run(fset.Arg(0), *langName, fset.Args()[1:])
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2 //nolint:mnd // ok
+	}
+
+	if *rank {
+		if results, err = byRank(results); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2 //nolint:mnd // ok
+		}
+	}
+
+	if *contextLines >= 0 {
+		if err = printSnippets(stdout, results, uint(*contextLines)); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2 //nolint:mnd // ok
+		}
+	} else {
+		for _, r := range results {
+			text, _, _ := strings.Cut(r.Text, "\n")
+			fmt.Fprintf(stdout, "%s:%d:%d: @%s %s\n", r.Path,
+				r.Range.StartPoint.Row+1, r.Range.StartPoint.Column+1, r.Capture, text)
+		}
+	}
+
+	if len(results) == 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// byRank reorders the results file by file, by the files' [Rank].
+func byRank(results []Result) (_ []Result, err error) {
+	ranks, err := Rank(results)
+	if err != nil {
+		return
+	}
+
+	_, groups := groupByPath(results)
+	ranked := make([]Result, 0, len(results))
+
+	for _, rank := range ranks {
+		ranked = append(ranked, groups[rank.Path]...)
+	}
+
+	return ranked, nil
+}
+
+// printSnippets prints the results' snippets, grep style.
+func printSnippets(w io.Writer, results []Result, n uint) error {
+	snippets, err := Snippets(results, n)
+	if err != nil {
+		return err
+	}
+
+	for i, s := range snippets {
+		if i > 0 {
+			fmt.Fprintln(w, "--")
+		}
+
+		for j, line := range s.Lines {
+			row, sep := s.StartRow+uint(j), '-'
+			if s.Covers(row) {
+				sep = ':'
+			}
+
+			fmt.Fprintf(w, "%s%c%d%c%s\n", s.Path, sep, row+1, sep, line)
+		}
+	}
+
+	return nil
+}
+
+// scan returns the paths of the files found under roots, having one of the
+// given extensions (any, if none given), see [scanner.Scanner].
+func scan(ctx context.Context, roots, exts []string) ([]string, error) {
+	var opts []scanner.Option
+
+	if len(exts) > 0 {
+		globs := make([]string, len(exts))
+		for i, ext := range exts {
+			globs[i] = "*" + ext
+		}
+
+		opts = append(opts, scanner.Include(globs...))
+	}
+
+	s, err := scanner.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.FilesCtx(ctx, roots...)
+}
+
+// search parses the files at the given paths and runs the query over them.
+func search(ctx context.Context, q *sitter.Query, paths []string, lang Language) ([]Result, error) {
+	results := &batch.Results[Result]{}
+
+	files, err := parseFiles(ctx, paths, lang)
+	if err != nil {
+		return results.Done(ctx, err)
+	}
+
+	names := q.CaptureNames()
+
+	for fm := range sitter.RunQueryOverFiles(ctx, q, files, 0) {
+		if fm.Err != nil {
+			return results.Done(ctx, fm.Err)
+		}
+
+		for _, c := range fm.Captures {
+			results.Add(Result{
+				Path:         fm.File.Path,
+				Capture:      names[c.Index],
+				Text:         c.Node.Content(fm.File.Content),
+				Range:        c.Node.Range(),
+				PatternIndex: fm.PatternIndex,
+			})
+		}
+	}
+
+	return results.Done(ctx, nil)
+}
+
+// parseFiles reads and parses the files at the given paths.
+func parseFiles(ctx context.Context, paths []string, lang Language) (files []sitter.ParsedFile, err error) {
+	files = make([]sitter.ParsedFile, 0, len(paths))
+	l := logger.Load()
+
+	for _, path := range paths {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		file := sitter.ParsedFile{Path: path}
+
+		if file.Content, err = os.ReadFile(path); err != nil {
+			return
+		}
+
+		p := sitter.NewParser()
+		p.SetLanguage(lang.Language)
+
+		if l != nil {
+			p.SetSlog(l.With("file", path, "language", lang.Name))
+		}
+
+		var tree *sitter.Tree
+
+		pprof.Do(ctx, pprof.Labels("file", path), func(ctx context.Context) {
+			tree, err = p.ParseString(ctx, nil, file.Content)
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %s: %w", path, err)
+		}
+
+		file.Root = tree.RootNode()
+		files = append(files, file)
+	}
+
+	return
+}
@@ -0,0 +1,168 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/alexaandru/go-tree-sitter-bare/batch"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestSetLogger(t *testing.T) {
+	t.Parallel()
+
+	Register("calc", gr, ".calc")
+
+	testCases := []struct {
+		args []string
+		exp  string
+		code int
+	}{
+		{
+			[]string{"-l", "calc", "(sum left: (_) @left) (comment) @comment", "testdata/search"},
+			"testdata/search/a.calc:1:1: @left 1\n" +
+				"testdata/search/a.calc:1:7: @comment // one\n" +
+				"testdata/search/sub/b.calc:1:2: @left 3\n",
+			0,
+		},
+		{[]string{"-l", "calc", "(variable) @v", "testdata/search"}, "", 1},
+		{
+			[]string{"-l", "calc", "-C", "1", "-rank", "(number) @n", "testdata/snippets"},
+			"testdata/snippets/f.calc:1:1 + 2\n--\n" +
+				"testdata/snippets/e.calc:1:1\ntestdata/snippets/e.calc:2:+ 2\ntestdata/snippets/e.calc-3-\n--\n" +
+				"testdata/snippets/e.calc-7-// x\ntestdata/snippets/e.calc:8:3 + 4\n",
+			0,
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	SetLogger(slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	defer SetLogger(nil)
+
+	for _, tc := range testCases {
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		if code := Main(tc.args, stdout, stderr); code != tc.code || stdout.String() != tc.exp {
+			t.Fatalf("Expected %d,\n%s\ngot %d,\n%s%s", tc.code, tc.exp, code, stdout, stderr)
+		}
+	}
+
+	for _, exp := range []string{
+		"msg=parsed file=testdata/search/a.calc language=calc bytes=13",
+		`msg="search done" language=calc pattern="(variable) @v" results=0`,
+	} {
+		if !strings.Contains(buf.String(), exp) {
+			t.Fatalf("Expected %q in the logs, got\n%s", exp, buf)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	Register("lookup-test", nil, ".lt")
+
+	lang, ok := Lookup("lookup-test")
+	if !ok || lang.Name != "lookup-test" || !reflect.DeepEqual(lang.Extensions, []string{".lt"}) {
+		t.Fatalf("Expected the registered language, got %+v, %v", lang, ok)
+	}
+
+	if _, ok = Lookup("nope"); ok {
+		t.Fatal("Expected unknown language to not be found")
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Run("(x)", "nope", nil); !errors.Is(err, ErrUnknownLanguage) {
+		t.Fatalf("Expected %v, got %v", ErrUnknownLanguage, err)
+	}
+}
+
+func TestRunCtx(t *testing.T) {
+	t.Parallel()
+
+	Register("calc", gr, ".calc")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var partial *batch.Partial
+
+	results, err := RunCtx(ctx, "(number) @n", "calc", []string{"testdata/search"})
+	if !errors.As(err, &partial) || !errors.Is(err, context.Canceled) || results != nil {
+		t.Fatalf("Expected a partial error and no results, got %v and %+v", err, results)
+	}
+}
+
+func TestMainFunc(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		args []string
+		exp  string
+		code int
+	}{
+		{[]string{"(x)"}, "usage: [-l language] pattern path...\n", 2},
+		{[]string{"-l", "nope", "(x)", "."}, "unknown language: \"nope\"\n", 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.exp, func(t *testing.T) {
+			t.Parallel()
+
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			if code := Main(tc.args, stdout, stderr); code != tc.code || stderr.String() != tc.exp {
+				t.Fatalf("Expected %d, %q, got %d, %q", tc.code, tc.exp, code, stderr)
+			}
+		})
+	}
+}
+
+func TestByRank(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestPrintSnippets(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	act, err := scan(context.Background(), []string{"testdata/search"}, []string{".calc"})
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := []string{"testdata/search/a.calc", "testdata/search/sub/b.calc"}
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestParseFiles(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
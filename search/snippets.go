@@ -0,0 +1,139 @@
+package search
+
+import (
+	"bytes"
+	"cmp"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Snippet is a range of lines of a file, holding one or more results, along
+// with some lines of context around them.
+type Snippet struct {
+	Path    string
+	Lines   []string
+	Results []Result
+	// StartRow is the (zero based) row of the first line.
+	StartRow uint
+}
+
+// FileRank holds the ranking of a file, as computed by [Rank].
+type FileRank struct {
+	Path string
+	// Density is the number of results per line of the file.
+	Density float64
+	Results uint
+	Lines   uint
+}
+
+// Snippets returns the snippets of the results, with (up to) n lines of context
+// before and after each result. Overlapping (or adjacent) snippets of the same
+// file are merged. The snippets are returned in the order the files first
+// appear in results, and in line order within a file.
+//
+// The lines are read from the files on disk.
+func Snippets(results []Result, n uint) (snippets []Snippet, err error) {
+	paths, groups := groupByPath(results)
+
+	for _, path := range paths {
+		var lines []string
+
+		if lines, err = readLines(path); err != nil {
+			return
+		}
+
+		snippets = append(snippets, fileSnippets(path, lines, groups[path], n)...)
+	}
+
+	return
+}
+
+// Rank ranks the files of the results by their match density (results per
+// line), densest first. Ties are broken by the number of results, then by path.
+func Rank(results []Result) (ranks []FileRank, err error) {
+	paths, groups := groupByPath(results)
+
+	for _, path := range paths {
+		var lines []string
+
+		if lines, err = readLines(path); err != nil {
+			return
+		}
+
+		rank := FileRank{Path: path, Results: uint(len(groups[path])), Lines: uint(len(lines))}
+		rank.Density = float64(rank.Results) / float64(rank.Lines)
+		ranks = append(ranks, rank)
+	}
+
+	slices.SortStableFunc(ranks, func(a, b FileRank) int {
+		return cmp.Or(cmp.Compare(b.Density, a.Density), cmp.Compare(b.Results, a.Results), cmp.Compare(a.Path, b.Path))
+	})
+
+	return
+}
+
+// Covers reports whether any of the snippet's results spans the given row.
+func (s Snippet) Covers(row uint) bool {
+	return slices.ContainsFunc(s.Results, func(r Result) bool {
+		return r.Range.StartPoint.Row <= row && row <= r.Range.EndPoint.Row
+	})
+}
+
+// fileSnippets builds the (merged) snippets of the results of one file.
+func fileSnippets(path string, lines []string, results []Result, n uint) (snippets []Snippet) {
+	results = slices.Clone(results)
+	slices.SortStableFunc(results, func(a, b Result) int {
+		return cmp.Compare(a.Range.StartPoint.Row, b.Range.StartPoint.Row)
+	})
+
+	last, ends := uint(len(lines)-1), []uint{}
+
+	for _, r := range results {
+		from := min(r.Range.StartPoint.Row-min(n, r.Range.StartPoint.Row), last)
+		to := min(r.Range.EndPoint.Row+n, last)
+
+		if k := len(snippets) - 1; k >= 0 && from <= ends[k]+1 {
+			snippets[k].Results = append(snippets[k].Results, r)
+			ends[k] = max(ends[k], to)
+
+			continue
+		}
+
+		snippets = append(snippets, Snippet{Path: path, StartRow: from, Results: []Result{r}})
+		ends = append(ends, to)
+	}
+
+	for i := range snippets {
+		snippets[i].Lines = lines[snippets[i].StartRow : ends[i]+1]
+	}
+
+	return
+}
+
+// groupByPath groups the results by their file, returning the distinct paths
+// in order of appearance.
+func groupByPath(results []Result) (paths []string, groups map[string][]Result) {
+	groups = map[string][]Result{}
+
+	for _, r := range results {
+		if _, ok := groups[r.Path]; !ok {
+			paths = append(paths, r.Path)
+		}
+
+		groups[r.Path] = append(groups[r.Path], r)
+	}
+
+	return
+}
+
+// readLines reads the lines of the file at path (without the line endings).
+// There is always at least one (possibly empty) line.
+func readLines(path string) ([]string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(string(bytes.TrimSuffix(b, []byte("\n"))), "\n"), nil
+}
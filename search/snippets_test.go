@@ -0,0 +1,91 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+func result(path string, startRow, endRow uint) Result {
+	return Result{Path: path, Range: sitter.Range{
+		StartPoint: sitter.Point{Row: startRow}, EndPoint: sitter.Point{Row: endRow},
+	}}
+}
+
+func TestSnippets(t *testing.T) {
+	t.Parallel()
+
+	e, f := "testdata/snippets/e.calc", "testdata/snippets/f.calc"
+	results := []Result{result(e, 7, 7), result(f, 0, 0), result(e, 0, 0), result(e, 1, 1)}
+
+	testCases := []struct {
+		exp []Snippet
+		n   uint
+	}{
+		{[]Snippet{
+			{Path: e, Lines: []string{"1", "+ 2"}, Results: []Result{results[2], results[3]}},
+			{Path: e, Lines: []string{"3 + 4"}, Results: []Result{results[0]}, StartRow: 7},
+			{Path: f, Lines: []string{"1 + 2"}, Results: []Result{results[1]}},
+		}, 0},
+		{[]Snippet{
+			{Path: e, Lines: []string{"1", "+ 2", "", ""}, Results: []Result{results[2], results[3]}},
+			{Path: e, Lines: []string{"", "// x", "3 + 4"}, Results: []Result{results[0]}, StartRow: 5},
+			{Path: f, Lines: []string{"1 + 2"}, Results: []Result{results[1]}},
+		}, 2},
+		{[]Snippet{
+			{
+				Path: e, Lines: []string{"1", "+ 2", "", "", "", "", "// x", "3 + 4"},
+				Results: []Result{results[2], results[3], results[0]},
+			},
+			{Path: f, Lines: []string{"1 + 2"}, Results: []Result{results[1]}},
+		}, 3},
+	}
+
+	for _, tc := range testCases {
+		act, err := Snippets(results, tc.n)
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected for %d\n%+v\ngot\n%+v", tc.n, tc.exp, act)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	t.Parallel()
+
+	e, f := "testdata/snippets/e.calc", "testdata/snippets/f.calc"
+
+	act, err := Rank([]Result{result(e, 0, 0), result(e, 1, 1), result(f, 0, 0)})
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := []FileRank{{Path: f, Density: 1, Results: 1, Lines: 1}, {Path: e, Density: 0.25, Results: 2, Lines: 8}}
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+}
+
+func TestSnippetCovers(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestFileSnippets(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestGroupByPath(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestReadLines(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
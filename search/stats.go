@@ -0,0 +1,110 @@
+package search
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/batch"
+)
+
+// LanguageStats are the statistics of the files of a language, as reported by
+// [WorkspaceStats].
+type LanguageStats struct {
+	Language string
+	Files    int
+	Lines    int
+	// Errors is the number of files with syntax errors, see [LanguageStats.ErrorRate].
+	Errors int
+	// Slowest are the files which took the longest to parse, slowest first.
+	Slowest []FileTiming
+}
+
+// FileTiming is the time it took to parse a file.
+type FileTiming struct {
+	Path     string
+	Duration time.Duration
+}
+
+// WorkspaceStats summarizes the files of each registered language found under
+// roots (see [Run]), sorted by language, listing (at most) the n slowest files
+// to parse of each. The languages without files are left out.
+//
+// Once ctx is done, it stops, returning the stats of the languages completed
+// so far, along with a [batch.Partial] error.
+func WorkspaceStats(ctx context.Context, roots []string, n int) ([]LanguageStats, error) {
+	stats := &batch.Results[LanguageStats]{}
+
+	for _, name := range Languages() {
+		lang, _ := Lookup(name)
+
+		s, err := languageStats(ctx, roots, n, lang)
+		if err != nil {
+			return stats.Done(ctx, err)
+		}
+
+		if s.Files > 0 {
+			stats.Add(s)
+		}
+	}
+
+	return stats.Done(ctx, nil)
+}
+
+// languageStats returns the stats of the files of the language found under
+// roots, see [WorkspaceStats].
+func languageStats(ctx context.Context, roots []string, n int, lang Language) (s LanguageStats, err error) {
+	paths, err := scan(ctx, roots, lang.Extensions)
+	if err != nil {
+		return
+	}
+
+	s = LanguageStats{Language: lang.Name, Files: len(paths)}
+	p := sitter.NewParser()
+	p.SetLanguage(lang.Language)
+
+	for _, path := range paths {
+		start := time.Now()
+
+		var pf sitter.ParsedFile
+
+		if pf, err = p.ParseFile(ctx, path, 0, sitter.SizeSkip); err != nil {
+			return
+		}
+
+		s.Slowest = append(s.Slowest, FileTiming{Path: path, Duration: time.Since(start)})
+		s.Lines += countLines(pf.Content)
+
+		if pf.Root.HasError() {
+			s.Errors++
+		}
+	}
+
+	slices.SortStableFunc(s.Slowest, func(a, b FileTiming) int { return cmp.Compare(b.Duration, a.Duration) })
+	s.Slowest = s.Slowest[:max(0, min(n, len(s.Slowest)))]
+
+	return
+}
+
+// ErrorRate is the fraction of the files with syntax errors.
+func (s LanguageStats) ErrorRate() float64 {
+	if s.Files == 0 {
+		return 0
+	}
+
+	return float64(s.Errors) / float64(s.Files)
+}
+
+// countLines counts the lines of the text, the last one included even if it
+// does not end with a line break.
+func countLines(b []byte) int {
+	n := bytes.Count(b, []byte("\n"))
+	if len(b) > 0 && b[len(b)-1] != '\n' {
+		n++
+	}
+
+	return n
+}
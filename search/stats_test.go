@@ -0,0 +1,84 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alexaandru/go-tree-sitter-bare/batch"
+)
+
+func TestWorkspaceStats(t *testing.T) {
+	t.Parallel()
+
+	Register("calc", gr, ".calc")
+
+	stats, err := WorkspaceStats(context.Background(), []string{"testdata/search", "testdata/snippets"}, 2)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if len(stats) != 1 {
+		t.Fatalf("Expected the calc stats, got %+v", stats)
+	}
+
+	s := stats[0]
+	if s.Language != "calc" || s.Files != 4 || s.Lines != 11 || s.Errors != 1 || len(s.Slowest) != 2 {
+		t.Fatalf("Expected 4 calc files, of 11 lines, one with errors, got %+v", s)
+	}
+
+	if s.Slowest[0].Duration < s.Slowest[1].Duration {
+		t.Fatalf("Expected the slowest file first, got %+v", s.Slowest)
+	}
+
+	if _, err = WorkspaceStats(context.Background(), []string{"testdata/nope"}, 1); err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var partial *batch.Partial
+
+	if stats, err = WorkspaceStats(ctx, []string{"testdata/search"}, 1); !errors.As(err, &partial) || stats != nil {
+		t.Fatalf("Expected a partial error and no stats, got %v and %+v", err, stats)
+	}
+}
+
+func TestLanguageStats(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestWorkspaceStats()")
+}
+
+func TestLanguageStatsErrorRate(t *testing.T) {
+	t.Parallel()
+
+	if act := (LanguageStats{}).ErrorRate(); act != 0 {
+		t.Fatalf("Expected 0, got %v", act)
+	}
+
+	if act := (LanguageStats{Files: 4, Errors: 1}).ErrorRate(); act != 0.25 {
+		t.Fatalf("Expected 0.25, got %v", act)
+	}
+}
+
+func TestCountLines(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		text string
+		exp  int
+	}{
+		{"", 0},
+		{"1", 1},
+		{"1\n", 1},
+		{"1\n2", 2},
+		{"1\n\n", 2},
+	}
+
+	for _, tc := range testCases {
+		if act := countLines([]byte(tc.text)); act != tc.exp {
+			t.Fatalf("Expected %d lines in %q, got %d", tc.exp, tc.text, act)
+		}
+	}
+}
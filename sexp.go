@@ -0,0 +1,232 @@
+package sitter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ExpectedNode is the expected shape of a (sub)tree, as parsed from an
+// S-expression by [ParseSexp] and checked against a node by [CompareTree].
+//
+// The `(UNEXPECTED 'c')` form is parsed as an ERROR node with Unexpected set;
+// the unexpected character itself is not compared.
+type ExpectedNode struct {
+	Type       string
+	Field      string
+	Children   []ExpectedNode
+	Missing    bool
+	Unexpected bool
+}
+
+type sexpParser struct {
+	s   string
+	pos int
+}
+
+// S-expression errors.
+var (
+	ErrSexpSyntax   = errors.New("invalid S-expression")
+	ErrTreeMismatch = errors.New("tree mismatch")
+)
+
+// ParseSexp parses an S-expression, in the format produced by [Node.String]
+// (i.e. `(sum left: (number) right: (MISSING number))`), into an [ExpectedNode].
+func ParseSexp(s string) (ExpectedNode, error) {
+	p := &sexpParser{s: s}
+
+	n, err := p.node()
+	if err != nil {
+		return ExpectedNode{}, err
+	}
+
+	if tok := p.next(); tok != "" {
+		return ExpectedNode{}, p.errorf("unexpected %q after the root node", tok)
+	}
+
+	return n, nil
+}
+
+// CompareTree checks that the tree rooted at n has the expected shape, taking
+// into account the named and missing nodes (the ones included by
+// [Node.String]) along with their field names. The returned error (if any)
+// wraps [ErrTreeMismatch] and includes the path to the first mismatch.
+func CompareTree(n Node, expected ExpectedNode) error {
+	return compareTree(n, expected, expected.Type)
+}
+
+func compareTree(n Node, exp ExpectedNode, path string) error {
+	if act := n.Type(); act != exp.Type {
+		return fmt.Errorf("%w at %s: expected type %q, got %q", ErrTreeMismatch, path, exp.Type, act)
+	}
+
+	if act := n.IsMissing(); act != exp.Missing {
+		return fmt.Errorf("%w at %s: expected missing to be %v, got %v", ErrTreeMismatch, path, exp.Missing, act)
+	}
+
+	children, fields := sexpChildren(n)
+	for i, c := range children {
+		if i >= len(exp.Children) {
+			return fmt.Errorf("%w at %s: unexpected child #%d %q", ErrTreeMismatch, path, i, c.Type())
+		}
+
+		exp := exp.Children[i]
+
+		cpath := fmt.Sprintf("%s > %s[%d]", path, exp.Type, i)
+		if exp.Field != "" {
+			cpath = fmt.Sprintf("%s > %s: %s[%d]", path, exp.Field, exp.Type, i)
+		}
+
+		if fields[i] != exp.Field {
+			return fmt.Errorf("%w at %s: expected field %q, got %q", ErrTreeMismatch, cpath, exp.Field, fields[i])
+		}
+
+		if err := compareTree(c, exp, cpath); err != nil {
+			return err
+		}
+	}
+
+	if len(children) < len(exp.Children) {
+		return fmt.Errorf("%w at %s: missing child #%d %q",
+			ErrTreeMismatch, path, len(children), exp.Children[len(children)].Type)
+	}
+
+	return nil
+}
+
+// sexpChildren returns the children of n that are included in its
+// S-expression, along with their field names.
+func sexpChildren(n Node) (children []Node, fields []string) {
+	for i := range n.ChildCount() {
+		c := n.Child(i)
+		if !c.IsNamed() && !c.IsMissing() {
+			continue
+		}
+
+		children = append(children, c)
+		fields = append(fields, n.FieldNameForChild(int(i)))
+	}
+
+	return
+}
+
+func (p *sexpParser) node() (n ExpectedNode, err error) {
+	if tok := p.next(); tok != "(" {
+		return n, p.errorf("expected '(', got %q", tok)
+	}
+
+	if n.Type, err = p.name(); err != nil {
+		return
+	}
+
+	switch n.Type {
+	case "MISSING":
+		if n.Type, err = p.name(); err != nil {
+			return
+		}
+
+		n.Missing = true
+	case "UNEXPECTED":
+		if tok := p.next(); tok == "" || tok == "(" || tok == ")" {
+			return n, p.errorf("expected a character, got %q", tok)
+		}
+
+		n.Type, n.Unexpected = "ERROR", true
+	}
+
+	for {
+		switch tok := p.peek(); {
+		case tok == ")":
+			p.next()
+			return n, nil
+		case tok == "(":
+			c, err := p.node()
+			if err != nil {
+				return n, err
+			}
+
+			n.Children = append(n.Children, c)
+		case strings.HasSuffix(tok, ":"):
+			p.next()
+
+			c, err := p.node()
+			if err != nil {
+				return n, err
+			}
+
+			c.Field = strings.TrimSuffix(tok, ":")
+			n.Children = append(n.Children, c)
+		default:
+			return n, p.errorf("unexpected %q", tok)
+		}
+	}
+}
+
+func (p *sexpParser) name() (string, error) {
+	tok := p.next()
+
+	switch {
+	case tok == "" || tok == "(" || tok == ")":
+		return "", p.errorf("expected node type, got %q", tok)
+	case tok[0] == '"':
+		s, err := strconv.Unquote(tok)
+		if err != nil {
+			return "", p.errorf("invalid node type %s", tok)
+		}
+
+		return s, nil
+	}
+
+	return tok, nil
+}
+
+func (p *sexpParser) peek() string {
+	pos := p.pos
+	tok := p.next()
+	p.pos = pos
+
+	return tok
+}
+
+func (p *sexpParser) next() string {
+	for p.pos < len(p.s) && strings.ContainsRune(" \t\r\n", rune(p.s[p.pos])) {
+		p.pos++
+	}
+
+	if p.pos >= len(p.s) {
+		return ""
+	}
+
+	start := p.pos
+
+	switch p.s[p.pos] {
+	case '(', ')':
+		p.pos++
+	case '\'':
+		// A single (possibly escaped) character, which may itself be a quote or paren.
+		if p.pos++; p.pos < len(p.s) && p.s[p.pos] == '\\' {
+			p.pos++
+		}
+
+		p.pos = min(p.pos+2, len(p.s))
+	case '"':
+		for p.pos++; p.pos < len(p.s) && p.s[p.pos] != '"'; p.pos++ {
+			if p.s[p.pos] == '\\' {
+				p.pos++
+			}
+		}
+
+		p.pos = min(p.pos+1, len(p.s))
+	default:
+		for p.pos < len(p.s) && !strings.ContainsRune(" \t\r\n()\"", rune(p.s[p.pos])) {
+			p.pos++
+		}
+	}
+
+	return p.s[start:p.pos]
+}
+
+func (p *sexpParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at offset %d: %s", ErrSexpSyntax, p.pos, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,142 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParseSexp(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		s   string
+		err string
+	}{
+		{`(sum left: (number) right: (MISSING number))`, ""},
+		{`(ERROR (MISSING "+") (comment))`, ""},
+		{`(ERROR (UNEXPECTED '(') (UNEXPECTED '\n') (UNEXPECTED '''))`, ""},
+		{`sum`, `invalid S-expression at offset 3: expected '(', got "sum"`},
+		{`(sum`, `invalid S-expression at offset 4: unexpected ""`},
+		{`(sum) (sum)`, `invalid S-expression at offset 7: unexpected "(" after the root node`},
+		{`()`, `invalid S-expression at offset 2: expected node type, got ")"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSexp(tc.s)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatal("Expected no error, got", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrSexpSyntax) || err.Error() != tc.err {
+				t.Fatalf("Expected %q, got %v", tc.err, err)
+			}
+		})
+	}
+
+	exp := ExpectedNode{Type: "ERROR", Children: []ExpectedNode{
+		{Type: "+", Missing: true, Field: "op"},
+	}}
+
+	act, err := ParseSexp(`(ERROR op: (MISSING "+"))`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act.Type != exp.Type || len(act.Children) != 1 || act.Children[0].Type != "+" ||
+		!act.Children[0].Missing || act.Children[0].Field != "op" {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+}
+
+func TestCompareTree(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + (2 + 3) // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	testCases := []struct {
+		s   string
+		err string
+	}{
+		{root.String(), ""},
+		{
+			`(expression (sum left: (expression (number)) right: (expression (expression (sum left: (expression (number)) right: (expression (variable)))))) (comment))`,        //nolint:lll // ok
+			`tree mismatch at expression > sum[0] > right: expression[1] > expression[0] > sum[0] > right: expression[1] > variable[0]: expected type "variable", got "number"`, //nolint:lll // ok
+		},
+		{
+			`(expression (sum left: (expression (number)) (expression (expression (sum)))) (comment))`,
+			`tree mismatch at expression > sum[0] > expression[1]: expected field "", got "right"`,
+		},
+		{
+			`(expression (sum left: (expression (number)) right: (expression (expression (sum left: (expression (number)) right: (expression (number)))))))`, //nolint:lll // ok
+			`tree mismatch at expression: unexpected child #1 "comment"`,
+		},
+		{
+			`(expression (sum left: (expression (number)) right: (expression (expression (sum left: (expression (number)) right: (expression (number)))))) (comment) (comment))`, //nolint:lll // ok
+			`tree mismatch at expression: missing child #2 "comment"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.err, func(t *testing.T) {
+			t.Parallel()
+
+			exp, err := ParseSexp(tc.s)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			err = CompareTree(root, exp)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatal("Expected no error, got", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrTreeMismatch) || err.Error() != tc.err {
+				t.Fatalf("Expected\n%s\ngot\n%v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestCompareTreeErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"1 +", "1 + '", "1 + x", "1 + (2"} {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			root, err := Parse(context.Background(), []byte(input), gr)
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			exp, err := ParseSexp(root.String())
+			if err != nil {
+				t.Fatal("Expected no error, got", err)
+			}
+
+			if err = CompareTree(root, exp); err != nil {
+				t.Fatalf("Expected no error for %s, got %v", root, err)
+			}
+		})
+	}
+}
+
+func TestSexpChildren(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
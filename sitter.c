@@ -1,31 +1,48 @@
 #include "api.h"
+#include "language.h"
 #include "sitter.h"
+#include <stdlib.h>
 #include <string.h>
-#include <stdio.h>
 
-static void stderr_log(void *payload, TSLogType type, const char *msg)
+#ifdef _WIN32
+#include <io.h>
+#define dup _dup
+#else
+#include <unistd.h>
+#endif
+
+static void go_log(void *payload, TSLogType type, const char *msg)
 {
-    bool include_lexing = (bool)payload;
-    switch (type)
-    {
-    case TSLogTypeParse:
-        fprintf(stderr, "* %s\n", msg);
-        break;
-    case TSLogTypeLex:
-        if (include_lexing)
-            fprintf(stderr, "  %s\n", msg);
-        break;
-    }
+    callLogFunc((uintptr_t)payload, type, (char *)msg);
 }
 
-TSLogger stderr_logger_new(bool include_lexing)
+TSLogger go_logger_new(uintptr_t handle)
 {
     TSLogger result;
-    result.payload = (void *)include_lexing;
-    result.log = stderr_log;
+    result.payload = (void *)handle;
+    result.log = go_log;
     return result;
 }
 
+TSFieldId go_node_field_id_for_child(TSNode self, uint32_t child_index)
+{
+    // The field name is returned straight from the language's field names
+    // table, so we can look it up by pointer, rather than by comparing strings.
+    const char *name = ts_node_field_name_for_child(self, child_index);
+    if (name == NULL)
+        return 0;
+
+    const TSLanguage *lang = ts_node_language(self);
+    uint32_t count = ts_language_field_count(lang);
+    for (TSFieldId id = 1; id <= count; id++)
+    {
+        if (ts_language_field_name_for_id(lang, id) == name)
+            return id;
+    }
+
+    return 0;
+}
+
 const char *call_callReadFunc(void *payload, uint32_t byte_index, TSPoint position, uint32_t *bytes_read)
 {
     ParsePayload *p = payload;
@@ -33,18 +50,219 @@ const char *call_callReadFunc(void *payload, uint32_t byte_index, TSPoint positi
     {
         free(p->previous_content);
     }
-    p->previous_content = callReadFunc(p->read_function_id, byte_index, position, bytes_read);
+    p->previous_content = callReadFunc(p->read_handle, byte_index, position, bytes_read);
     return p->previous_content;
 }
 
-TSTree *call_ts_parser_parse(TSParser *self, const TSTree *old_tree, int read_function_id, TSInputEncoding encoding)
+// go_parse_payload is the payload of the parse running on the current thread,
+// for call_callDecodeFunc, as decode functions are not passed one.
+static _Thread_local ParsePayload *go_parse_payload;
+
+static uint32_t call_callDecodeFunc(const uint8_t *string, uint32_t length, int32_t *code_point)
+{
+    return callDecodeFunc(go_parse_payload->read_handle, (uint8_t *)string, length, code_point);
+}
+
+TSTree *call_ts_parser_parse(TSParser *self, const TSTree *old_tree, uintptr_t read_handle, TSInputEncoding encoding)
 {
-    ParsePayload payload = {read_function_id, NULL};
-    TSInput input = {&payload, call_callReadFunc, encoding};
+    ParsePayload payload = {read_handle, NULL};
+    ParsePayload *outer = go_parse_payload;
+    TSInput input = {&payload, call_callReadFunc, encoding, call_callDecodeFunc};
+    go_parse_payload = &payload;
     TSTree *tree = ts_parser_parse(self, old_tree, input);
+    go_parse_payload = outer;
     if (payload.previous_content != NULL)
     {
         free(payload.previous_content);
     }
     return tree;
 }
+
+GoEqFilters *go_eq_filters_new(const GoEqFilter *filters, const uint32_t *starts, uint32_t pattern_count,
+                               const char *values, uint32_t values_len)
+{
+    uint32_t filter_count = starts[pattern_count];
+    GoEqFilters *self = malloc(sizeof(GoEqFilters));
+    self->filters = malloc(filter_count * sizeof(GoEqFilter));
+    self->starts = malloc((pattern_count + 1) * sizeof(uint32_t));
+    self->values = malloc(values_len + 1);
+    self->pattern_count = pattern_count;
+    memcpy(self->filters, filters, filter_count * sizeof(GoEqFilter));
+    memcpy(self->starts, starts, (pattern_count + 1) * sizeof(uint32_t));
+    if (values_len > 0)
+        memcpy(self->values, values, values_len);
+    return self;
+}
+
+void go_eq_filters_delete(GoEqFilters *self)
+{
+    if (self == NULL)
+        return;
+
+    free(self->filters);
+    free(self->starts);
+    free(self->values);
+    free(self);
+}
+
+// go_eq_filters_pass reports whether the match satisfies all the filters of
+// its pattern. Matches having nodes outside of text are left for Go to decide.
+static bool go_eq_filters_pass(const GoEqFilters *self, const TSQueryMatch *match, const char *text,
+                               uint32_t text_len)
+{
+    if (match->pattern_index >= self->pattern_count)
+        return true;
+
+    for (uint32_t i = self->starts[match->pattern_index]; i < self->starts[match->pattern_index + 1]; i++)
+    {
+        const GoEqFilter *filter = &self->filters[i];
+        for (uint16_t j = 0; j < match->capture_count; j++)
+        {
+            const TSQueryCapture *capture = &match->captures[j];
+            if (capture->index != filter->capture_id)
+                continue;
+
+            uint32_t start = ts_node_start_byte(capture->node);
+            uint32_t end = ts_node_end_byte(capture->node);
+            if (end > text_len || start > end)
+                return true;
+
+            bool eq = end - start == filter->length &&
+                      (filter->length == 0 || memcmp(text + start, self->values + filter->offset, filter->length) == 0);
+            if (eq != filter->positive)
+                return false;
+        }
+    }
+
+    return true;
+}
+
+bool go_query_cursor_next_match(TSQueryCursor *self, TSQueryMatch *match, const GoEqFilters *filters,
+                                const char *text, uint32_t text_len)
+{
+    while (ts_query_cursor_next_match(self, match))
+    {
+        if (filters == NULL || go_eq_filters_pass(filters, match, text, text_len))
+            return true;
+    }
+
+    return false;
+}
+
+// go_capture_summaries fills out (which must hold count items) with the
+// summaries of the captures, in one go.
+void go_capture_summaries(const TSQueryCapture *captures, uint32_t count, GoCaptureSummary *out)
+{
+    for (uint32_t i = 0; i < count; i++)
+    {
+        TSNode node = captures[i].node;
+        out[i].range.start_point = ts_node_start_point(node);
+        out[i].range.end_point = ts_node_end_point(node);
+        out[i].range.start_byte = ts_node_start_byte(node);
+        out[i].range.end_byte = ts_node_end_byte(node);
+        out[i].index = captures[i].index;
+        out[i].symbol = ts_node_symbol(node);
+    }
+}
+
+// go_range_index walks the tree rooted at root in preorder, filling the
+// arrays (which must hold as many items as the root's descendant count) with
+// the start and end bytes, symbol, parent (UINT32_MAX for the root) and
+// subtree size of each node, at its descendant index.
+void go_range_index(TSNode root, uint32_t *starts, uint32_t *ends, TSSymbol *symbols, uint32_t *parents,
+                    uint32_t *sizes)
+{
+    TSTreeCursor cursor = ts_tree_cursor_new(root);
+    uint32_t current = UINT32_MAX, next = 0;
+
+    for (bool visit = true;;)
+    {
+        if (visit)
+        {
+            TSNode node = ts_tree_cursor_current_node(&cursor);
+            starts[next] = ts_node_start_byte(node);
+            ends[next] = ts_node_end_byte(node);
+            symbols[next] = ts_node_symbol(node);
+            parents[next] = current;
+            current = next++;
+        }
+
+        if (visit && ts_tree_cursor_goto_first_child(&cursor))
+            continue;
+
+        sizes[current] = next - current;
+        if (current == 0)
+            break;
+
+        if ((visit = ts_tree_cursor_goto_next_sibling(&cursor)))
+        {
+            current = parents[current];
+            continue;
+        }
+
+        ts_tree_cursor_goto_parent(&cursor);
+        current = parents[current];
+    }
+
+    ts_tree_cursor_delete(&cursor);
+}
+
+// go_print_dot_graphs makes the parser write its dot graphs to a duplicate of
+// the given file descriptor (which the parser owns, closing it once done), for
+// the caller to close the original one whenever it wants.
+void go_print_dot_graphs(TSParser *self, int fd)
+{
+    ts_parser_print_dot_graphs(self, dup(fd));
+}
+
+// go_language_subtypes marks (in subtypes, which must hold as many items as the
+// language has symbols, aliases included) the symbols the nodes of the given
+// symbol can wrap, i.e. those of its productions having a single child, as
+// told by the parse table: the states reached by a symbol which reduce to the
+// given one with a single child.
+void go_language_subtypes(const TSLanguage *self, TSSymbol symbol, bool *subtypes)
+{
+    // The production (plus one) of the unary reduction, by state.
+    uint32_t *unary = calloc(self->state_count, sizeof(uint32_t));
+
+    for (TSStateId state = 1; state < self->state_count; state++)
+    {
+        LookaheadIterator it = ts_language_lookaheads(self, state);
+        while (!unary[state] && ts_lookahead_iterator__next(&it))
+        {
+            for (uint16_t i = 0; i < it.action_count; i++)
+            {
+                TSParseAction a = it.actions[i];
+                if (a.type == TSParseActionTypeReduce && a.reduce.child_count == 1 &&
+                    ts_language_public_symbol(self, a.reduce.symbol) == symbol)
+                {
+                    unary[state] = (uint32_t)a.reduce.production_id + 1;
+                    break;
+                }
+            }
+        }
+    }
+
+    for (TSStateId state = 1; state < self->state_count; state++)
+    {
+        LookaheadIterator it = ts_language_lookaheads(self, state);
+        while (ts_lookahead_iterator__next(&it))
+        {
+            TSStateId next = it.next_state;
+            for (uint16_t i = 0; i < it.action_count; i++)
+            {
+                TSParseAction a = it.actions[i];
+                if (a.type == TSParseActionTypeShift && !a.shift.extra && !a.shift.repetition)
+                    next = a.shift.state;
+            }
+
+            if (next == 0 || next >= self->state_count || !unary[next])
+                continue;
+
+            TSSymbol alias = ts_language_alias_at(self, unary[next] - 1, 0);
+            subtypes[alias ? alias : ts_language_public_symbol(self, it.symbol)] = true;
+        }
+    }
+
+    free(unary);
+}
@@ -3,15 +3,58 @@
 
 #include "api.h"
 
-TSLogger stderr_logger_new(bool include_lexing);
+TSLogger go_logger_new(uintptr_t handle);
+TSFieldId go_node_field_id_for_child(TSNode self, uint32_t child_index);
 
 typedef struct
 {
-    int read_function_id;
+    uintptr_t read_handle;
     char *previous_content;
 } ParsePayload;
 
-extern char *callReadFunc(int id, uint32_t byteIndex, TSPoint position, uint32_t *bytesRead);
-TSTree *call_ts_parser_parse(TSParser *self, const TSTree *old_tree, int read_function_id, TSInputEncoding encoding);
+// GoEqFilter is a (positive or negative) #eq? predicate of a capture against
+// a string, which is values[offset:offset+length] of the owning GoEqFilters.
+typedef struct
+{
+    uint32_t capture_id;
+    uint32_t offset;
+    uint32_t length;
+    bool positive;
+} GoEqFilter;
+
+// GoEqFilters holds the GoEqFilter of each pattern of a query: the filters of
+// pattern i are filters[starts[i]:starts[i+1]].
+typedef struct
+{
+    GoEqFilter *filters;
+    uint32_t *starts;
+    char *values;
+    uint32_t pattern_count;
+} GoEqFilters;
+
+GoEqFilters *go_eq_filters_new(const GoEqFilter *filters, const uint32_t *starts, uint32_t pattern_count,
+                               const char *values, uint32_t values_len);
+void go_eq_filters_delete(GoEqFilters *self);
+bool go_query_cursor_next_match(TSQueryCursor *self, TSQueryMatch *match, const GoEqFilters *filters,
+                                const char *text, uint32_t text_len);
+
+// GoCaptureSummary is the range, symbol and capture index of a captured node.
+typedef struct
+{
+    TSRange range;
+    uint32_t index;
+    TSSymbol symbol;
+} GoCaptureSummary;
+
+void go_capture_summaries(const TSQueryCapture *captures, uint32_t count, GoCaptureSummary *out);
+void go_range_index(TSNode root, uint32_t *starts, uint32_t *ends, TSSymbol *symbols, uint32_t *parents,
+                    uint32_t *sizes);
+void go_print_dot_graphs(TSParser *self, int fd);
+void go_language_subtypes(const TSLanguage *self, TSSymbol symbol, bool *subtypes);
+
+extern void callLogFunc(uintptr_t handle, TSLogType type, char *msg);
+extern char *callReadFunc(uintptr_t handle, uint32_t byteIndex, TSPoint position, uint32_t *bytesRead);
+extern uint32_t callDecodeFunc(uintptr_t handle, uint8_t *str, uint32_t length, int32_t *codePoint);
+TSTree *call_ts_parser_parse(TSParser *self, const TSTree *old_tree, uintptr_t read_handle, TSInputEncoding encoding);
 
 #endif
@@ -24,8 +24,10 @@ const (
 
 //nolint:gochecknoglobals // ok
 var (
-	gr       = getTestGrammar()
-	zeroNode Node
+	gr        = getTestGrammar()
+	grSuper   = getTestSupertypesGrammar()
+	grSuper14 = getTestSupertypesABI14Grammar()
+	zeroNode  Node
 )
 
 // github.com/alexaandru/go-tree-sitter-bare/sitter.go:18:		Parse				83.3%
@@ -184,6 +186,15 @@ func TestTree(t *testing.T) {
 		}
 	}
 
+	if act := tree.IncludedRanges(IncludedRangesEmpty); len(act) != 0 {
+		t.Fatal("Expected no ranges, got", act)
+	}
+
+	rngExp = []Range{n.Range()}
+	if act := tree.IncludedRanges(IncludedRangesDocument); !slices.Equal(rngExp, act) {
+		t.Fatalf("Expected %v, got %v", rngExp, act)
+	}
+
 	// check that it changed tree
 	if !n.HasChanges() {
 		t.Fatal("Expected tree to have changes")
@@ -0,0 +1,185 @@
+// Package sittertest provides helpers for snapshotting syntax trees and
+// query results to golden files, with stable formatting and helpful diffs.
+//
+// Golden files are (re)written, rather than checked, when the
+// SITTERTEST_UPDATE environment variable is set to a non empty value:
+//
+//	SITTERTEST_UPDATE=1 go test ./...
+package sittertest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// TB is the subset of [testing.TB] used by the helpers.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+}
+
+// UpdateEnv is the environment variable that, when set, makes the helpers
+// update the golden files instead of checking them.
+const UpdateEnv = "SITTERTEST_UPDATE"
+
+// maxDiffCells caps the size of the diff table, larger inputs are reported
+// in full instead.
+const maxDiffCells = 1 << 20
+
+// Golden compares got with the content of the golden file at path, reporting
+// a line diff on mismatch. If [UpdateEnv] is set, the golden file is written
+// instead (along with any missing parent folders).
+func Golden(tb TB, path string, got []byte) {
+	tb.Helper()
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:mnd // ok
+			tb.Fatalf("cannot create golden file folder: %v", err)
+		}
+
+		if err := os.WriteFile(path, got, 0o644); err != nil { //nolint:gosec,mnd // ok
+			tb.Fatalf("cannot write golden file: %v", err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		tb.Fatalf("golden file %s does not exist, run with %s=1 to create it", path, UpdateEnv)
+		return
+	} else if err != nil {
+		tb.Fatalf("cannot read golden file: %v", err)
+		return
+	}
+
+	if !bytes.Equal(want, got) {
+		tb.Errorf("%s mismatch (-want +got):\n%s", path, Diff(string(want), string(got)))
+	}
+}
+
+// SnapshotTree compares the tree rooted at n with the golden file at path.
+func SnapshotTree(tb TB, path string, n sitter.Node) {
+	tb.Helper()
+	Golden(tb, path, []byte(FormatTree(n)))
+}
+
+// SnapshotMatches compares the query matches with the golden file at path.
+func SnapshotMatches(tb TB, path string, q *sitter.Query, matches sitter.QueryMatches, src []byte) {
+	tb.Helper()
+	Golden(tb, path, []byte(FormatMatches(q, matches, src)))
+}
+
+// SnapshotCaptures compares the query captures (i.e. highlight events) with
+// the golden file at path.
+func SnapshotCaptures(tb TB, path string, q *sitter.Query, captures sitter.QueryCaptures, src []byte) {
+	tb.Helper()
+	Golden(tb, path, []byte(FormatCaptures(q, captures, src)))
+}
+
+// FormatTree formats the tree rooted at n using [sitter.Node.SexpWithFields]
+// (the upstream CLI format) which makes for readable diffs.
+func FormatTree(n sitter.Node) string {
+	return n.SexpWithFields() + "\n"
+}
+
+// FormatMatches formats the query matches, one match per line, followed by
+// its captures, one per line.
+func FormatMatches(q *sitter.Query, matches sitter.QueryMatches, src []byte) string {
+	sb := &strings.Builder{}
+
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		fmt.Fprintf(sb, "pattern %d\n", m.PatternIndex)
+
+		for _, c := range m.Captures {
+			sb.WriteString("  ")
+			formatCapture(sb, q, c, src)
+		}
+	}
+
+	if err := matches.Err(); err != nil {
+		fmt.Fprintf(sb, "error: %v\n", err)
+	}
+
+	return sb.String()
+}
+
+// FormatCaptures formats the query captures, one per line, in the order
+// they are returned by the cursor.
+func FormatCaptures(q *sitter.Query, captures sitter.QueryCaptures, src []byte) string {
+	sb := &strings.Builder{}
+
+	for m, i := captures.Next(); m != nil; m, i = captures.Next() {
+		formatCapture(sb, q, m.Captures[i], src)
+	}
+
+	return sb.String()
+}
+
+// Diff returns a line diff between want and got, where removed lines are
+// prefixed with "-", added lines with "+" and common lines with a space.
+func Diff(want, got string) string {
+	a, b := strings.Split(want, "\n"), strings.Split(got, "\n")
+	if len(a)*len(b) > maxDiffCells {
+		return "-" + want + "\n+" + got + "\n"
+	}
+
+	// lcs[i][j] holds the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	sb := &strings.Builder{}
+	line := func(prefix, s string) {
+		sb.WriteString(prefix + s + "\n")
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			line(" ", a[i])
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			line("-", a[i])
+			i++
+		default:
+			line("+", b[j])
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		line("-", a[i])
+	}
+
+	for ; j < len(b); j++ {
+		line("+", b[j])
+	}
+
+	return sb.String()
+}
+
+func formatCapture(sb *strings.Builder, q *sitter.Query, c sitter.QueryCapture, src []byte) {
+	r := c.Node.Range()
+	fmt.Fprintf(sb, "@%s [%d, %d] - [%d, %d] %q\n", q.CaptureNameForID(c.Index),
+		r.StartPoint.Row, r.StartPoint.Column, r.EndPoint.Row, r.EndPoint.Column, c.Node.Content(src))
+}
@@ -0,0 +1,156 @@
+package sittertest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+const goldenInput = "1 + (2 + 3) // c\n"
+
+//nolint:gochecknoglobals // ok
+var gr = Grammar()
+
+type recorder struct {
+	errors []string
+}
+
+func (*recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+}
+
+func TestGolden(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	path := filepath.Join(t.TempDir(), "sub", "x.golden")
+
+	r := &recorder{}
+	if Golden(r, path, []byte("a\n")); len(r.errors) != 1 || !strings.Contains(r.errors[0], "does not exist") {
+		t.Fatal("Expected missing golden file error, got", r.errors)
+	}
+
+	t.Setenv(UpdateEnv, "1")
+
+	r = &recorder{}
+	if Golden(r, path, []byte("a\nb\n")); len(r.errors) != 0 {
+		t.Fatal("Expected no error, got", r.errors)
+	}
+
+	if b, err := os.ReadFile(path); err != nil || string(b) != "a\nb\n" {
+		t.Fatalf("Expected golden file to be written, got %q, %v", b, err)
+	}
+
+	t.Setenv(UpdateEnv, "")
+
+	r = &recorder{}
+	if Golden(r, path, []byte("a\nb\n")); len(r.errors) != 0 {
+		t.Fatal("Expected no error, got", r.errors)
+	}
+
+	exp := path + " mismatch (-want +got):\n a\n-b\n+c\n \n"
+	r = &recorder{}
+	if Golden(r, path, []byte("a\nc\n")); len(r.errors) != 1 || r.errors[0] != exp {
+		t.Fatalf("Expected\n%s\ngot\n%v", exp, r.errors)
+	}
+}
+
+func TestSnapshotTree(t *testing.T) {
+	t.Parallel()
+
+	root := parseGolden(t)
+	SnapshotTree(t, "testdata/tree.golden", root)
+}
+
+func TestSnapshotMatches(t *testing.T) {
+	t.Parallel()
+
+	root, q := parseGolden(t), queryGolden(t, "(number) @number")
+	qc := sitter.NewQueryCursor()
+	qc.SetByteRange(4, 11) //nolint:mnd // ok
+
+	src := []byte(goldenInput)
+	SnapshotMatches(t, "testdata/set_byte_range.golden", q, qc.Matches(q, root, src), src)
+}
+
+func TestSnapshotCaptures(t *testing.T) {
+	t.Parallel()
+
+	root, q := parseGolden(t), queryGolden(t, "(sum left: (_) @left right: (_) @right) (comment) @comment")
+	captures := sitter.NewQueryCursor().Captures(q, root, []byte(goldenInput))
+
+	SnapshotCaptures(t, "testdata/next_capture.golden", q, captures, []byte(goldenInput))
+}
+
+func TestFormatTree(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestSnapshotTree()")
+}
+
+func TestFormatMatches(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestSnapshotMatches()")
+}
+
+func TestFormatCaptures(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestSnapshotCaptures()")
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		want, got, exp string
+	}{
+		{"a\nb\n", "a\nb\n", " a\n b\n \n"},
+		{"a\nb\nc", "a\nx\nc", " a\n-b\n+x\n c\n"},
+		{"a", "", "-a\n+\n"},
+		{"a\nb", "b\nc", "-a\n b\n+c\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.exp, func(t *testing.T) {
+			t.Parallel()
+
+			if act := Diff(tc.want, tc.got); act != tc.exp {
+				t.Fatalf("Expected\n%q\ngot\n%q", tc.exp, act)
+			}
+		})
+	}
+}
+
+func TestFormatCapture(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func parseGolden(t *testing.T) sitter.Node {
+	t.Helper()
+
+	root, err := sitter.Parse(context.Background(), []byte(goldenInput), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	return root
+}
+
+func queryGolden(t *testing.T, pattern string) *sitter.Query {
+	t.Helper()
+
+	q, err := sitter.NewQuery(gr, []byte(pattern))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	return q
+}
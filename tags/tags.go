@@ -0,0 +1,197 @@
+// Package tags extracts the definitions and references of symbols (functions,
+// classes, methods, etc.) from source files, e.g. for ctags-like indexing and
+// code navigation, as told by tags queries following the conventions of the
+// tags.scm files (the ones of tree-sitter-tags):
+//
+//   - @name: the name of the symbol;
+//   - @definition.<kind>: the definition of a symbol of the given kind (e.g.
+//     @definition.function);
+//   - @reference.<kind>: a reference to a symbol of the given kind (e.g.
+//     @reference.call);
+//   - @doc: the documentation of the symbol (e.g. the comments preceding it),
+//     the text of all the @doc nodes of a match being joined by newlines;
+//   - #strip! @doc "regexp": the matches of the regular expression are removed
+//     from the documentation (e.g. the comment markers);
+//   - #select-adjacent! @doc @capture: only the @doc nodes adjacent to (i.e.
+//     right before) the captured node, or to each other, are kept.
+//
+// For instance, for Go:
+//
+//	((comment)* @doc . (function_declaration name: (identifier) @name) @definition.function
+//	  (#strip! @doc "^//\\s*") (#select-adjacent! @doc @definition.function))
+//	(call_expression function: (identifier) @name) @reference.call
+package tags
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Rules are the tags rules of a language, see [NewRules].
+type Rules struct {
+	query      *sitter.Query
+	directives []directives // By pattern.
+}
+
+// Tag is a definition of, or a reference to, a symbol.
+type Tag struct {
+	Name string
+	// Kind is the kind of the symbol, e.g. "function" for a
+	// @definition.function or "call" for a @reference.call.
+	Kind string
+	Docs string
+	// Range is the range of the whole definition or reference.
+	Range     sitter.Range
+	NameRange sitter.Range
+	// IsDefinition tells whether the tag is a definition, or a reference.
+	IsDefinition bool
+}
+
+// patternTag is a tag, along with the pattern it was found by.
+type patternTag struct {
+	Tag
+	pattern uint
+}
+
+// directives are the #strip! and #select-adjacent! directives of a pattern.
+type directives struct {
+	strip    *regexp.Regexp
+	adjacent *uint // The capture the docs must be adjacent to.
+}
+
+// ErrInvalidDirective is returned for the #strip! and #select-adjacent!
+// directives with the wrong arguments.
+var ErrInvalidDirective = errors.New("invalid tags directive")
+
+const (
+	captureName = "name"
+	captureDoc  = "doc"
+
+	prefixDefinition = "definition."
+	prefixReference  = "reference."
+)
+
+// NewRules compiles the tags rules of a language.
+func NewRules(lang *sitter.Language, pattern string) (*Rules, error) {
+	q, err := sitter.NewQuery(lang, []byte(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Rules{query: q, directives: make([]directives, q.PatternCount())}
+
+	for i := range r.directives {
+		for _, p := range q.GeneralPredicates(uint(i)) {
+			switch p.Operator {
+			case "strip!":
+				if len(p.Args) != 2 || p.Args[0].CaptureID == nil || p.Args[1].String == nil { //nolint:mnd // ok
+					return nil, fmt.Errorf("%w: #strip! expects a capture and a regexp", ErrInvalidDirective)
+				}
+
+				if r.directives[i].strip, err = regexp.Compile(*p.Args[1].String); err != nil {
+					return nil, fmt.Errorf("%w: #strip!: %w", ErrInvalidDirective, err)
+				}
+			case "select-adjacent!":
+				if len(p.Args) != 2 || p.Args[0].CaptureID == nil || p.Args[1].CaptureID == nil { //nolint:mnd // ok
+					return nil, fmt.Errorf("%w: #select-adjacent! expects two captures", ErrInvalidDirective)
+				}
+
+				r.directives[i].adjacent = p.Args[1].CaptureID
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// Extract returns the tags of the file rooted at root, whose source text is
+// src, in order (of their names). Where several patterns tag the same name,
+// the first one wins.
+func (r *Rules) Extract(root sitter.Node, src []byte) (tags []Tag) {
+	var found []patternTag
+
+	names := r.query.CaptureNames()
+
+	matches := sitter.NewQueryCursor().Matches(r.query, root, src)
+	for m := matches.Next(); m != nil; m = matches.Next() {
+		var (
+			tag  Tag
+			docs []sitter.Node
+		)
+
+		for _, c := range m.Captures {
+			switch name := names[c.Index]; {
+			case name == captureName:
+				tag.Name, tag.NameRange = c.Node.Content(src), c.Node.Range()
+			case name == captureDoc:
+				docs = append(docs, c.Node)
+			case strings.HasPrefix(name, prefixDefinition):
+				tag.Kind, tag.Range, tag.IsDefinition = name[len(prefixDefinition):], c.Node.Range(), true
+			case strings.HasPrefix(name, prefixReference):
+				tag.Kind, tag.Range = name[len(prefixReference):], c.Node.Range()
+			}
+		}
+
+		if tag.Name == "" || tag.Kind == "" {
+			continue
+		}
+
+		d := r.directives[m.PatternIndex]
+		if d.adjacent != nil {
+			docs = adjacent(docs, m.NodesForCaptureIndex(*d.adjacent))
+		}
+
+		tag.Docs = text(docs, src, d.strip)
+		found = append(found, patternTag{tag, m.PatternIndex})
+	}
+
+	slices.SortStableFunc(found, func(a, b patternTag) int {
+		return cmp.Or(cmp.Compare(a.NameRange.StartByte, b.NameRange.StartByte),
+			cmp.Compare(a.NameRange.EndByte, b.NameRange.EndByte), cmp.Compare(a.pattern, b.pattern))
+	})
+
+	for i, t := range found {
+		if i == 0 || t.NameRange != found[i-1].NameRange {
+			tags = append(tags, t.Tag)
+		}
+	}
+
+	return
+}
+
+// adjacent returns the doc nodes (in order) adjacent to the anchor node, or to
+// one another: the last ones, with no blank lines in between.
+func adjacent(docs, anchor []sitter.Node) []sitter.Node {
+	if len(anchor) == 0 {
+		return docs
+	}
+
+	i, row := len(docs), anchor[0].StartPoint().Row
+	for ; i > 0 && docs[i-1].EndPoint().Row+1 >= row; i-- {
+		row = docs[i-1].StartPoint().Row
+	}
+
+	return docs[i:]
+}
+
+// text returns the text of the doc nodes, stripped, joined by newlines.
+func text(docs []sitter.Node, src []byte, strip *regexp.Regexp) string {
+	lines := make([]string, 0, len(docs))
+
+	for _, n := range docs {
+		s := n.Content(src)
+		if strip != nil {
+			s = strip.ReplaceAllString(s, "")
+		}
+
+		lines = append(lines, s)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,75 @@
+package tags
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestNewRules(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range []string{
+		`((number) @name @reference.call (#strip! @name))`,
+		`((number) @name @reference.call (#strip! @name "("))`,
+		`((number) @name @reference.call (#select-adjacent! @name "x"))`,
+	} {
+		if _, err := NewRules(gr, pattern); !errors.Is(err, ErrInvalidDirective) {
+			t.Fatalf("Expected %v for %s, got %v", ErrInvalidDirective, pattern, err)
+		}
+	}
+}
+
+func TestRulesExtract(t *testing.T) {
+	t.Parallel()
+
+	// In these calc "programs", sums define their left number, documented by
+	// the comments before them, and the other numbers are references.
+	rules, err := NewRules(gr, `
+((comment)* @doc . (sum left: (expression (number) @name)) @definition.function
+  (#strip! @doc "^//\\s*") (#select-adjacent! @doc @definition.function))
+(number) @name @reference.call
+`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	src := []byte("// ignored\n\n// doc\n// more\n1 + 2")
+
+	root, err := sitter.Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	act := []Tag{}
+	for _, tag := range rules.Extract(root, src) {
+		tag.Range = sitter.Range{StartByte: tag.Range.StartByte}
+		tag.NameRange = sitter.Range{StartByte: tag.NameRange.StartByte}
+		act = append(act, tag)
+	}
+
+	exp := []Tag{
+		{Name: "1", Kind: "function", Docs: "doc\nmore", IsDefinition: true, Range: sitter.Range{StartByte: 27}, NameRange: sitter.Range{StartByte: 27}}, //nolint:lll // ok
+		{Name: "2", Kind: "call", Range: sitter.Range{StartByte: 31}, NameRange: sitter.Range{StartByte: 31}},
+	}
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+}
+
+func TestAdjacent(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesExtract()")
+}
+
+func TestText(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestRulesExtract()")
+}
@@ -1,4 +1,5 @@
-# transforms grammar js file into go
+# transforms grammar js file into go, for the tests of this package and, as
+# sittertest.Grammar, for the ones of the other packages
 # cgo can't be used in tests
 out=test_grammar.go
 
@@ -24,6 +25,28 @@ func getTestGrammar() *Language {
 }
 GO
 
+# the C function is static there, not to clash with the one above, both being
+# linked in the tests importing sittertest
+out=sittertest/grammar.go
+sed -e 's/^package sitter$/package sittertest/' -e '/^import "C"$/,$d' \
+	-e 's/^\/\/TS_PUBLIC const TSLanguage \*tree_sitter_test_grammar/\/\/static const TSLanguage *tree_sitter_test_grammar/' \
+	test_grammar.go > $out
+cat <<GO >> $out
+import "C"
+
+import (
+	"unsafe"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Grammar returns the test grammar (see test_grammar.js), for the tests of the
+// packages built on sitter. It is only built with the test build tag.
+func Grammar() *sitter.Language {
+	return sitter.NewLanguage(unsafe.Pointer(C.tree_sitter_test_grammar()))
+}
+GO
+
 # cleanup
 rm -rf *.toml setup.py grammar.js .editorconfig Package.swift binding.gyp build/ node_modules/ src/ bindings/
 git restore Makefile .gitignore
@@ -0,0 +1,656 @@
+//go:build test
+
+// Code generated by test_supertypes_grammar.sh; DO NOT EDIT.
+package sitter
+
+//#ifndef TREE_SITTER_PARSER_H_
+//#define TREE_SITTER_PARSER_H_
+//
+//#ifdef __cplusplus
+//extern "C" {
+//#endif
+//
+//#include <stdbool.h>
+//#include <stdint.h>
+//#include <stdlib.h>
+//
+//#define ts_builtin_sym_error ((TSSymbol)-1)
+//#define ts_builtin_sym_end 0
+//#define TREE_SITTER_SERIALIZATION_BUFFER_SIZE 1024
+//
+//#ifndef TREE_SITTER_API_H_
+//typedef uint16_t TSStateId;
+//typedef uint16_t TSSymbol;
+//typedef uint16_t TSFieldId;
+//typedef struct TSLanguage TSLanguage;
+//typedef struct TSLanguageMetadata TSLanguageMetadata;
+//typedef struct TSLanguageMetadata {
+//  uint8_t major_version;
+//  uint8_t minor_version;
+//  uint8_t patch_version;
+//} TSLanguageMetadata;
+//#endif
+//
+//typedef struct {
+//  TSFieldId field_id;
+//  uint8_t child_index;
+//  bool inherited;
+//} TSFieldMapEntry;
+//
+//// Used to index the field and supertype maps.
+//typedef struct {
+//  uint16_t index;
+//  uint16_t length;
+//} TSMapSlice;
+//
+//typedef struct {
+//  bool visible;
+//  bool named;
+//  bool supertype;
+//} TSSymbolMetadata;
+//
+//typedef struct TSLexer TSLexer;
+//
+//struct TSLexer {
+//  int32_t lookahead;
+//  TSSymbol result_symbol;
+//  void (*advance)(TSLexer *, bool);
+//  void (*mark_end)(TSLexer *);
+//  uint32_t (*get_column)(TSLexer *);
+//  bool (*is_at_included_range_start)(const TSLexer *);
+//  bool (*eof)(const TSLexer *);
+//  void (*log)(const TSLexer *, const char *, ...);
+//};
+//
+//typedef enum {
+//  TSParseActionTypeShift,
+//  TSParseActionTypeReduce,
+//  TSParseActionTypeAccept,
+//  TSParseActionTypeRecover,
+//} TSParseActionType;
+//
+//typedef union {
+//  struct {
+//    uint8_t type;
+//    TSStateId state;
+//    bool extra;
+//    bool repetition;
+//  } shift;
+//  struct {
+//    uint8_t type;
+//    uint8_t child_count;
+//    TSSymbol symbol;
+//    int16_t dynamic_precedence;
+//    uint16_t production_id;
+//  } reduce;
+//  uint8_t type;
+//} TSParseAction;
+//
+//typedef struct {
+//  uint16_t lex_state;
+//  uint16_t external_lex_state;
+//} TSLexMode;
+//
+//typedef struct {
+//  uint16_t lex_state;
+//  uint16_t external_lex_state;
+//  uint16_t reserved_word_set_id;
+//} TSLexerMode;
+//
+//typedef union {
+//  TSParseAction action;
+//  struct {
+//    uint8_t count;
+//    bool reusable;
+//  } entry;
+//} TSParseActionEntry;
+//
+//typedef struct {
+//  int32_t start;
+//  int32_t end;
+//} TSCharacterRange;
+//
+//struct TSLanguage {
+//  uint32_t abi_version;
+//  uint32_t symbol_count;
+//  uint32_t alias_count;
+//  uint32_t token_count;
+//  uint32_t external_token_count;
+//  uint32_t state_count;
+//  uint32_t large_state_count;
+//  uint32_t production_id_count;
+//  uint32_t field_count;
+//  uint16_t max_alias_sequence_length;
+//  const uint16_t *parse_table;
+//  const uint16_t *small_parse_table;
+//  const uint32_t *small_parse_table_map;
+//  const TSParseActionEntry *parse_actions;
+//  const char * const *symbol_names;
+//  const char * const *field_names;
+//  const TSMapSlice *field_map_slices;
+//  const TSFieldMapEntry *field_map_entries;
+//  const TSSymbolMetadata *symbol_metadata;
+//  const TSSymbol *public_symbol_map;
+//  const uint16_t *alias_map;
+//  const TSSymbol *alias_sequences;
+//  const TSLexerMode *lex_modes;
+//  bool (*lex_fn)(TSLexer *, TSStateId);
+//  bool (*keyword_lex_fn)(TSLexer *, TSStateId);
+//  TSSymbol keyword_capture_token;
+//  struct {
+//    const bool *states;
+//    const TSSymbol *symbol_map;
+//    void *(*create)(void);
+//    void (*destroy)(void *);
+//    bool (*scan)(void *, TSLexer *, const bool *symbol_whitelist);
+//    unsigned (*serialize)(void *, char *);
+//    void (*deserialize)(void *, const char *, unsigned);
+//  } external_scanner;
+//  const TSStateId *primary_state_ids;
+//  const char *name;
+//  const TSSymbol *reserved_words;
+//  uint16_t max_reserved_word_set_size;
+//  uint32_t supertype_count;
+//  const TSSymbol *supertype_symbols;
+//  const TSMapSlice *supertype_map_slices;
+//  const TSSymbol *supertype_map_entries;
+//  TSLanguageMetadata metadata;
+//};
+//
+//static inline bool set_contains(const TSCharacterRange *ranges, uint32_t len, int32_t lookahead) {
+//  uint32_t index = 0;
+//  uint32_t size = len - index;
+//  while (size > 1) {
+//    uint32_t half_size = size / 2;
+//    uint32_t mid_index = index + half_size;
+//    const TSCharacterRange *range = &ranges[mid_index];
+//    if (lookahead >= range->start && lookahead <= range->end) {
+//      return true;
+//    } else if (lookahead > range->end) {
+//      index = mid_index;
+//    }
+//    size -= half_size;
+//  }
+//  const TSCharacterRange *range = &ranges[index];
+//  return (lookahead >= range->start && lookahead <= range->end);
+//}
+//
+///*
+// *  Lexer Macros
+// */
+//
+//#ifdef _MSC_VER
+//#define UNUSED __pragma(warning(suppress : 4101))
+//#else
+//#define UNUSED __attribute__((unused))
+//#endif
+//
+//#define START_LEXER()           \
+//  bool result = false;          \
+//  bool skip = false;            \
+//  UNUSED                        \
+//  bool eof = false;             \
+//  int32_t lookahead;            \
+//  goto start;                   \
+//  next_state:                   \
+//  lexer->advance(lexer, skip);  \
+//  start:                        \
+//  skip = false;                 \
+//  lookahead = lexer->lookahead;
+//
+//#define ADVANCE(state_value) \
+//  {                          \
+//    state = state_value;     \
+//    goto next_state;         \
+//  }
+//
+//#define ADVANCE_MAP(...)                                              \
+//  {                                                                   \
+//    static const uint16_t map[] = { __VA_ARGS__ };                    \
+//    for (uint32_t i = 0; i < sizeof(map) / sizeof(map[0]); i += 2) {  \
+//      if (map[i] == lookahead) {                                      \
+//        state = map[i + 1];                                           \
+//        goto next_state;                                              \
+//      }                                                               \
+//    }                                                                 \
+//  }
+//
+//#define SKIP(state_value) \
+//  {                       \
+//    skip = true;          \
+//    state = state_value;  \
+//    goto next_state;      \
+//  }
+//
+//#define ACCEPT_TOKEN(symbol_value)     \
+//  result = true;                       \
+//  lexer->result_symbol = symbol_value; \
+//  lexer->mark_end(lexer);
+//
+//#define END_STATE() return result;
+//
+///*
+// *  Parse Table Macros
+// */
+//
+//#define SMALL_STATE(id) ((id) - LARGE_STATE_COUNT)
+//
+//#define STATE(id) id
+//
+//#define ACTIONS(id) id
+//
+//#define SHIFT(state_value)            \
+//  {{                                  \
+//    .shift = {                        \
+//      .type = TSParseActionTypeShift, \
+//      .state = (state_value)          \
+//    }                                 \
+//  }}
+//
+//#define SHIFT_REPEAT(state_value)     \
+//  {{                                  \
+//    .shift = {                        \
+//      .type = TSParseActionTypeShift, \
+//      .state = (state_value),         \
+//      .repetition = true              \
+//    }                                 \
+//  }}
+//
+//#define SHIFT_EXTRA()                 \
+//  {{                                  \
+//    .shift = {                        \
+//      .type = TSParseActionTypeShift, \
+//      .extra = true                   \
+//    }                                 \
+//  }}
+//
+//#define REDUCE(symbol_name, children, precedence, prod_id) \
+//  {{                                                       \
+//    .reduce = {                                            \
+//      .type = TSParseActionTypeReduce,                     \
+//      .symbol = symbol_name,                               \
+//      .child_count = children,                             \
+//      .dynamic_precedence = precedence,                    \
+//      .production_id = prod_id                             \
+//    },                                                     \
+//  }}
+//
+//#define RECOVER()                    \
+//  {{                                 \
+//    .type = TSParseActionTypeRecover \
+//  }}
+//
+//#define ACCEPT_INPUT()              \
+//  {{                                \
+//    .type = TSParseActionTypeAccept \
+//  }}
+//
+//#ifdef __cplusplus
+//}
+//#endif
+//
+//#endif  // TREE_SITTER_PARSER_H_
+///* Automatically generated by tree-sitter v0.25.1 */
+//
+//
+//#if defined(__GNUC__) || defined(__clang__)
+//#pragma GCC diagnostic ignored "-Wmissing-field-initializers"
+//#endif
+//
+//#define LANGUAGE_VERSION 14
+//#define STATE_COUNT 12
+//#define LARGE_STATE_COUNT 9
+//#define SYMBOL_COUNT 11
+//#define ALIAS_COUNT 0
+//#define TOKEN_COUNT 6
+//#define EXTERNAL_TOKEN_COUNT 0
+//#define FIELD_COUNT 0
+//#define MAX_ALIAS_SEQUENCE_LENGTH 3
+//#define MAX_RESERVED_WORD_SET_SIZE 0
+//#define PRODUCTION_ID_COUNT 1
+//#define SUPERTYPE_COUNT 0
+//
+//enum ts_symbol_identifiers {
+//  anon_sym_PLUS = 1,
+//  anon_sym_LPAREN = 2,
+//  anon_sym_RPAREN = 3,
+//  sym_number = 4,
+//  sym_variable = 5,
+//  sym_program = 6,
+//  sym__expression = 7,
+//  sym_sum = 8,
+//  sym_parenthesized = 9,
+//  aux_sym_program_repeat1 = 10,
+//};
+//
+//static const char * const ts_symbol_names[] = {
+//  [ts_builtin_sym_end] = "end",
+//  [anon_sym_PLUS] = "+",
+//  [anon_sym_LPAREN] = "(",
+//  [anon_sym_RPAREN] = ")",
+//  [sym_number] = "number",
+//  [sym_variable] = "variable",
+//  [sym_program] = "program",
+//  [sym__expression] = "_expression",
+//  [sym_sum] = "sum",
+//  [sym_parenthesized] = "parenthesized",
+//  [aux_sym_program_repeat1] = "program_repeat1",
+//};
+//
+//static const TSSymbol ts_symbol_map[] = {
+//  [ts_builtin_sym_end] = ts_builtin_sym_end,
+//  [anon_sym_PLUS] = anon_sym_PLUS,
+//  [anon_sym_LPAREN] = anon_sym_LPAREN,
+//  [anon_sym_RPAREN] = anon_sym_RPAREN,
+//  [sym_number] = sym_number,
+//  [sym_variable] = sym_variable,
+//  [sym_program] = sym_program,
+//  [sym__expression] = sym__expression,
+//  [sym_sum] = sym_sum,
+//  [sym_parenthesized] = sym_parenthesized,
+//  [aux_sym_program_repeat1] = aux_sym_program_repeat1,
+//};
+//
+//static const TSSymbolMetadata ts_symbol_metadata[] = {
+//  [ts_builtin_sym_end] = {
+//    .visible = false,
+//    .named = true,
+//  },
+//  [anon_sym_PLUS] = {
+//    .visible = true,
+//    .named = false,
+//  },
+//  [anon_sym_LPAREN] = {
+//    .visible = true,
+//    .named = false,
+//  },
+//  [anon_sym_RPAREN] = {
+//    .visible = true,
+//    .named = false,
+//  },
+//  [sym_number] = {
+//    .visible = true,
+//    .named = true,
+//  },
+//  [sym_variable] = {
+//    .visible = true,
+//    .named = true,
+//  },
+//  [sym_program] = {
+//    .visible = true,
+//    .named = true,
+//  },
+//  [sym__expression] = {
+//    .visible = false,
+//    .named = true,
+//    .supertype = true,
+//  },
+//  [sym_sum] = {
+//    .visible = true,
+//    .named = true,
+//  },
+//  [sym_parenthesized] = {
+//    .visible = true,
+//    .named = true,
+//  },
+//  [aux_sym_program_repeat1] = {
+//    .visible = false,
+//    .named = false,
+//  },
+//};
+//
+//static const TSSymbol ts_alias_sequences[PRODUCTION_ID_COUNT][MAX_ALIAS_SEQUENCE_LENGTH] = {
+//  [0] = {0},
+//};
+//
+//static const uint16_t ts_non_terminal_alias_map[] = {
+//  0,
+//};
+//
+//static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
+//  [0] = 0,
+//  [1] = 1,
+//  [2] = 2,
+//  [3] = 3,
+//  [4] = 4,
+//  [5] = 5,
+//  [6] = 6,
+//  [7] = 7,
+//  [8] = 8,
+//  [9] = 9,
+//  [10] = 10,
+//  [11] = 11,
+//};
+//
+//static bool ts_lex(TSLexer *lexer, TSStateId state) {
+//  START_LEXER();
+//  eof = lexer->eof(lexer);
+//  switch (state) {
+//    case 0:
+//      if (eof) ADVANCE(1);
+//      if (lookahead == '(') ADVANCE(3);
+//      if (lookahead == ')') ADVANCE(4);
+//      if (lookahead == '+') ADVANCE(2);
+//      if (('\t' <= lookahead && lookahead <= '\r') ||
+//          lookahead == ' ') SKIP(0);
+//      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(5);
+//      if (('A' <= lookahead && lookahead <= 'Z') ||
+//          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(6);
+//      END_STATE();
+//    case 1:
+//      ACCEPT_TOKEN(ts_builtin_sym_end);
+//      END_STATE();
+//    case 2:
+//      ACCEPT_TOKEN(anon_sym_PLUS);
+//      END_STATE();
+//    case 3:
+//      ACCEPT_TOKEN(anon_sym_LPAREN);
+//      END_STATE();
+//    case 4:
+//      ACCEPT_TOKEN(anon_sym_RPAREN);
+//      END_STATE();
+//    case 5:
+//      ACCEPT_TOKEN(sym_number);
+//      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(5);
+//      END_STATE();
+//    case 6:
+//      ACCEPT_TOKEN(sym_variable);
+//      if (('0' <= lookahead && lookahead <= '9') ||
+//          ('A' <= lookahead && lookahead <= 'Z') ||
+//          lookahead == '_' ||
+//          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(6);
+//      END_STATE();
+//    default:
+//      return false;
+//  }
+//}
+//
+//static const TSLexMode ts_lex_modes[STATE_COUNT] = {
+//  [0] = {.lex_state = 0},
+//  [1] = {.lex_state = 0},
+//  [2] = {.lex_state = 0},
+//  [3] = {.lex_state = 0},
+//  [4] = {.lex_state = 0},
+//  [5] = {.lex_state = 0},
+//  [6] = {.lex_state = 0},
+//  [7] = {.lex_state = 0},
+//  [8] = {.lex_state = 0},
+//  [9] = {.lex_state = 0},
+//  [10] = {.lex_state = 0},
+//  [11] = {.lex_state = 0},
+//};
+//
+//static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
+//  [STATE(0)] = {
+//    [ts_builtin_sym_end] = ACTIONS(1),
+//    [anon_sym_PLUS] = ACTIONS(1),
+//    [anon_sym_LPAREN] = ACTIONS(1),
+//    [anon_sym_RPAREN] = ACTIONS(1),
+//    [sym_number] = ACTIONS(1),
+//    [sym_variable] = ACTIONS(1),
+//  },
+//  [STATE(1)] = {
+//    [sym_program] = STATE(11),
+//    [sym__expression] = STATE(9),
+//    [sym_sum] = STATE(5),
+//    [sym_parenthesized] = STATE(5),
+//    [aux_sym_program_repeat1] = STATE(2),
+//    [ts_builtin_sym_end] = ACTIONS(3),
+//    [anon_sym_LPAREN] = ACTIONS(5),
+//    [sym_number] = ACTIONS(7),
+//    [sym_variable] = ACTIONS(7),
+//  },
+//  [STATE(2)] = {
+//    [sym__expression] = STATE(9),
+//    [sym_sum] = STATE(5),
+//    [sym_parenthesized] = STATE(5),
+//    [aux_sym_program_repeat1] = STATE(3),
+//    [ts_builtin_sym_end] = ACTIONS(9),
+//    [anon_sym_LPAREN] = ACTIONS(5),
+//    [sym_number] = ACTIONS(7),
+//    [sym_variable] = ACTIONS(7),
+//  },
+//  [STATE(3)] = {
+//    [sym__expression] = STATE(9),
+//    [sym_sum] = STATE(5),
+//    [sym_parenthesized] = STATE(5),
+//    [aux_sym_program_repeat1] = STATE(3),
+//    [ts_builtin_sym_end] = ACTIONS(11),
+//    [anon_sym_LPAREN] = ACTIONS(13),
+//    [sym_number] = ACTIONS(16),
+//    [sym_variable] = ACTIONS(16),
+//  },
+//  [STATE(4)] = {
+//    [sym__expression] = STATE(10),
+//    [sym_sum] = STATE(5),
+//    [sym_parenthesized] = STATE(5),
+//    [anon_sym_LPAREN] = ACTIONS(5),
+//    [sym_number] = ACTIONS(7),
+//    [sym_variable] = ACTIONS(7),
+//  },
+//  [STATE(5)] = {
+//    [ts_builtin_sym_end] = ACTIONS(19),
+//    [anon_sym_PLUS] = ACTIONS(19),
+//    [anon_sym_LPAREN] = ACTIONS(19),
+//    [anon_sym_RPAREN] = ACTIONS(19),
+//    [sym_number] = ACTIONS(19),
+//    [sym_variable] = ACTIONS(19),
+//  },
+//  [STATE(6)] = {
+//    [sym__expression] = STATE(8),
+//    [sym_sum] = STATE(5),
+//    [sym_parenthesized] = STATE(5),
+//    [anon_sym_LPAREN] = ACTIONS(5),
+//    [sym_number] = ACTIONS(7),
+//    [sym_variable] = ACTIONS(7),
+//  },
+//  [STATE(7)] = {
+//    [ts_builtin_sym_end] = ACTIONS(21),
+//    [anon_sym_PLUS] = ACTIONS(21),
+//    [anon_sym_LPAREN] = ACTIONS(21),
+//    [anon_sym_RPAREN] = ACTIONS(21),
+//    [sym_number] = ACTIONS(21),
+//    [sym_variable] = ACTIONS(21),
+//  },
+//  [STATE(8)] = {
+//    [ts_builtin_sym_end] = ACTIONS(23),
+//    [anon_sym_PLUS] = ACTIONS(23),
+//    [anon_sym_LPAREN] = ACTIONS(23),
+//    [anon_sym_RPAREN] = ACTIONS(23),
+//    [sym_number] = ACTIONS(23),
+//    [sym_variable] = ACTIONS(23),
+//  },
+//};
+//
+//static const uint16_t ts_small_parse_table[] = {
+//  [0] = 2,
+//    ACTIONS(27), 1,
+//      anon_sym_PLUS,
+//    ACTIONS(25), 4,
+//      ts_builtin_sym_end,
+//      anon_sym_LPAREN,
+//      sym_number,
+//      sym_variable,
+//  [10] = 2,
+//    ACTIONS(27), 1,
+//      anon_sym_PLUS,
+//    ACTIONS(29), 1,
+//      anon_sym_RPAREN,
+//  [17] = 1,
+//    ACTIONS(31), 1,
+//      ts_builtin_sym_end,
+//};
+//
+//static const uint32_t ts_small_parse_table_map[] = {
+//  [SMALL_STATE(9)] = 0,
+//  [SMALL_STATE(10)] = 10,
+//  [SMALL_STATE(11)] = 17,
+//};
+//
+//static const TSParseActionEntry ts_parse_actions[] = {
+//  [0] = {.entry = {.count = 0, .reusable = false}},
+//  [1] = {.entry = {.count = 1, .reusable = false}}, RECOVER(),
+//  [3] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_program, 0, 0, 0),
+//  [5] = {.entry = {.count = 1, .reusable = true}}, SHIFT(4),
+//  [7] = {.entry = {.count = 1, .reusable = true}}, SHIFT(5),
+//  [9] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_program, 1, 0, 0),
+//  [11] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_program_repeat1, 2, 0, 0),
+//  [13] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_program_repeat1, 2, 0, 0), SHIFT_REPEAT(4),
+//  [16] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_program_repeat1, 2, 0, 0), SHIFT_REPEAT(5),
+//  [19] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__expression, 1, 0, 0),
+//  [21] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_parenthesized, 3, 0, 0),
+//  [23] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_sum, 3, 0, 0),
+//  [25] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_program_repeat1, 1, 0, 0),
+//  [27] = {.entry = {.count = 1, .reusable = true}}, SHIFT(6),
+//  [29] = {.entry = {.count = 1, .reusable = true}}, SHIFT(7),
+//  [31] = {.entry = {.count = 1, .reusable = true}},  ACCEPT_INPUT(),
+//};
+//
+//#ifdef __cplusplus
+//extern "C" {
+//#endif
+//#ifdef TREE_SITTER_HIDE_SYMBOLS
+//#define TS_PUBLIC
+//#elif defined(_WIN32)
+//#define TS_PUBLIC __declspec(dllexport)
+//#else
+//#define TS_PUBLIC __attribute__((visibility("default")))
+//#endif
+//
+//TS_PUBLIC const TSLanguage *tree_sitter_test_supertypes_abi14(void) {
+//  static const TSLanguage language = {
+//    .abi_version = LANGUAGE_VERSION,
+//    .symbol_count = SYMBOL_COUNT,
+//    .alias_count = ALIAS_COUNT,
+//    .token_count = TOKEN_COUNT,
+//    .external_token_count = EXTERNAL_TOKEN_COUNT,
+//    .state_count = STATE_COUNT,
+//    .large_state_count = LARGE_STATE_COUNT,
+//    .production_id_count = PRODUCTION_ID_COUNT,
+//    .field_count = FIELD_COUNT,
+//    .max_alias_sequence_length = MAX_ALIAS_SEQUENCE_LENGTH,
+//    .parse_table = &ts_parse_table[0][0],
+//    .small_parse_table = ts_small_parse_table,
+//    .small_parse_table_map = ts_small_parse_table_map,
+//    .parse_actions = ts_parse_actions,
+//    .symbol_names = ts_symbol_names,
+//    .symbol_metadata = ts_symbol_metadata,
+//    .public_symbol_map = ts_symbol_map,
+//    .alias_map = ts_non_terminal_alias_map,
+//    .alias_sequences = &ts_alias_sequences[0][0],
+//    .lex_modes = (const void*)ts_lex_modes,
+//    .lex_fn = ts_lex,
+//    .primary_state_ids = ts_primary_state_ids,
+//  };
+//  return &language;
+//}
+//#ifdef __cplusplus
+//}
+//#endif
+//
+//static const void *go_test_supertypes_abi14(void) { return tree_sitter_test_supertypes_abi14(); }
+import "C"
+
+func getTestSupertypesABI14Grammar() *Language {
+	return NewLanguage(C.go_test_supertypes_abi14())
+}
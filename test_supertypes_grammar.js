@@ -0,0 +1,16 @@
+module.exports = grammar({
+  name: 'test_supertypes',
+
+  extras: $ => [/\s/],
+
+  supertypes: $ => [$._expression],
+
+  rules: {
+    program: $ => repeat($._expression),
+    _expression: $ => choice($.sum, $.number, $.variable, $.parenthesized),
+    sum: $ => prec.left(seq($._expression, '+', $._expression)),
+    parenthesized: $ => seq('(', $._expression, ')'),
+    number: $ => /\d+/,
+    variable: $ => /[a-zA-Z]\w*/,
+  }
+});
@@ -0,0 +1,47 @@
+# transforms the supertypes grammar js file into go, once per language ABI:
+# the supertypes are only listed by the grammars of ABI 15 and newer
+# cgo can't be used in tests
+export PATH=$PATH:./node_modules/.bin
+
+echo Using $(tree-sitter --version)
+
+dir=$(mktemp -d)
+cp test_supertypes_grammar.js $dir/grammar.js
+cat <<JSON > $dir/tree-sitter.json
+{
+  "grammars": [{"name": "test_supertypes", "scope": "source.test_supertypes", "path": "."}],
+  "metadata": {"version": "0.1.0", "license": "MIT", "description": "Test grammar"}
+}
+JSON
+
+# generate <abi> <go file> <go func> <c func suffix>
+generate() {
+	(cd $dir && rm -rf src && tree-sitter generate --abi $1)
+
+	out=$2
+	echo "//go:build test" > $out
+	echo >> $out
+	echo "// Code generated by $0; DO NOT EDIT." >> $out
+	echo "package sitter" >> $out
+	echo >> $out
+	sed -e 's/^/\/\//' $dir/src/tree_sitter/parser.h >> $out
+	sed -e 's/^/\/\//' -e "s/tree_sitter_test_supertypes(/tree_sitter_test_supertypes$4(/" $dir/src/parser.c |
+		grep -v '#include "tree_sitter/parser.h"' >> $out
+	# the language is returned as void *, as its struct differs from the one of
+	# the other test grammars (cgo does not allow that)
+	cat <<GO >> $out
+//
+//static const void *go_test_supertypes$4(void) { return tree_sitter_test_supertypes$4(); }
+import "C"
+
+func $3() *Language {
+	return NewLanguage(C.go_test_supertypes$4())
+}
+GO
+}
+
+generate 15 test_supertypes_grammar.go getTestSupertypesGrammar ""
+generate 14 test_supertypes_abi14_grammar.go getTestSupertypesABI14Grammar _abi14
+
+# cleanup
+rm -rf $dir
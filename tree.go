@@ -4,7 +4,10 @@ package sitter
 import "C"
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"iter"
 	"os"
 	"sync"
 	"unsafe"
@@ -14,8 +17,9 @@ import (
 // Note: Tree instances are not thread safe;
 // you must copy a tree if you want to use it on multiple threads simultaneously.
 type Tree struct {
-	c    *C.TSTree
-	once sync.Once
+	c     *C.TSTree
+	cache *NodeCache
+	once  sync.Once
 }
 
 // Point represents one location in the input.
@@ -32,6 +36,10 @@ type Range struct {
 	EndByte    uint
 }
 
+// IncludedRangesMode indicates how [Tree.IncludedRanges] reports a whole
+// document parse (one for which no included ranges were set).
+type IncludedRangesMode int
+
 // InputEdit represents one edit in the input.
 type InputEdit struct {
 	StartIndex  uint
@@ -42,6 +50,18 @@ type InputEdit struct {
 	NewEndPoint Point
 }
 
+// The possible included ranges modes.
+const (
+	// IncludedRangesRaw returns the ranges as reported by tree-sitter, which for
+	// whole document parses is a single range ending at the max uint32 sentinel.
+	IncludedRangesRaw IncludedRangesMode = iota
+	// IncludedRangesEmpty returns no ranges for whole document parses.
+	IncludedRangesEmpty
+	// IncludedRangesDocument returns the range of the (whole) root node for
+	// whole document parses.
+	IncludedRangesDocument
+)
+
 func (i InputEdit) c() *C.TSInputEdit {
 	return &C.TSInputEdit{
 		start_byte:    C.uint(i.StartIndex),
@@ -79,8 +99,13 @@ func mkRange(r C.TSRange) Range {
 	}
 }
 
+// mkRanges copies a C array of ranges, that is owned by tree-sitter (and must
+// not be freed), into a Go slice. All C range arrays are converted through it.
 func mkRanges(p *C.TSRange, count C.uint32_t) (out []Range) {
 	out = make([]Range, count)
+	if p == nil {
+		return
+	}
 
 	for i, r := range unsafe.Slice(p, int(count)) {
 		out[i] = mkRange(r)
@@ -89,6 +114,28 @@ func mkRanges(p *C.TSRange, count C.uint32_t) (out []Range) {
 	return
 }
 
+// takeRanges copies a C array of ranges, allocated with `malloc` and owned by
+// the caller, into a Go slice and then frees the array.
+func takeRanges(p *C.TSRange, count C.uint32_t) []Range {
+	defer C.free(unsafe.Pointer(p))
+	return mkRanges(p, count)
+}
+
+// cRanges converts the ranges to a C array, which is allocated in Go memory
+// and must therefore only be used for the duration of the cgo call.
+func cRanges(ranges []Range) (*C.TSRange, C.uint32_t) {
+	if len(ranges) == 0 {
+		return nil, 0
+	}
+
+	out := make([]C.TSRange, len(ranges))
+	for i, r := range ranges {
+		out[i] = r.c()
+	}
+
+	return &out[0], C.uint32_t(len(out))
+}
+
 // newTree creates a new tree object from a C pointer.
 // The function will set a finalizer for the object,
 // thus no free is needed for it.
@@ -123,6 +170,12 @@ func (t *Tree) RootNode() Node {
 	return newNode(C.ts_tree_root_node(t.c))
 }
 
+// Walk returns an iterator over the nodes of the tree, in preorder, along with
+// their depths, see [Node.Walk].
+func (t *Tree) Walk() iter.Seq2[Node, int] {
+	return t.RootNode().Walk()
+}
+
 // RootNodeWithOffset returns the root node of the syntax tree, but with its position
 // shifted forward by the given offset.
 func (t *Tree) RootNodeWithOffset(ofs uint32, extent Point) Node {
@@ -137,13 +190,22 @@ func (t *Tree) Language() *Language {
 // IncludedRanges returns the array of included ranges that was used to parse the syntax tree.
 //
 // The returned pointer must be freed by the caller.
-func (t *Tree) IncludedRanges() []Range {
+//
+// If the optional mode is passed, it will be used to normalize the sentinel
+// range returned for whole document parses, otherwise [IncludedRangesRaw] is used.
+func (t *Tree) IncludedRanges(opts ...IncludedRangesMode) []Range {
 	count := C.uint(0)
 
-	p := C.ts_tree_included_ranges(t.c, &count)
-	defer freeTSRangeArray(p, count)
+	ranges := takeRanges(C.ts_tree_included_ranges(t.c, &count), count)
+	if len(opts) == 0 || opts[0] == IncludedRangesRaw || !isWholeDocument(ranges) {
+		return ranges
+	}
 
-	return mkRanges(p, count)
+	if opts[0] == IncludedRangesDocument {
+		return []Range{t.RootNode().Range()}
+	}
+
+	return []Range{}
 }
 
 // Edit the syntax tree to keep it in sync with source code that has been edited.
@@ -152,6 +214,7 @@ func (t *Tree) IncludedRanges() []Range {
 // (row, column) coordinates.
 func (t *Tree) Edit(i InputEdit) {
 	C.ts_tree_edit(t.c, i.c())
+	t.cache.Clear()
 }
 
 // GetChangedRanges compares an old edited syntax tree to a new syntax tree
@@ -170,10 +233,7 @@ func (t *Tree) Edit(i InputEdit) {
 func (t *Tree) GetChangedRanges(other *Tree) []Range {
 	count := C.uint(0)
 
-	p := C.ts_tree_get_changed_ranges(t.c, other.c, &count)
-	defer freeTSRangeArray(p, count)
-
-	return mkRanges(p, count)
+	return takeRanges(C.ts_tree_get_changed_ranges(t.c, other.c, &count), count)
 }
 
 // PrintDotGraph writes a DOT graph describing the syntax tree to the given file.
@@ -192,11 +252,114 @@ func (t *Tree) PrintDotGraph(name string) (err error) {
 	return
 }
 
-func freeTSRangeArray(p *C.struct_TSRange, count C.uint) {
-	pp := unsafe.Pointer(p)
+// Non API.
 
-	for ; count > 0; count-- {
-		C.free(pp)
-		pp = unsafe.Add(pp, C.sizeof_struct_TSRange)
+// WriteDotGraph is like [Tree.PrintDotGraph], writing the graph to w (through
+// a pipe) instead of a file.
+func (t *Tree) WriteDotGraph(w io.Writer) error {
+	r, pw, err := os.Pipe()
+	if err != nil {
+		return err
 	}
+
+	done := copyPipe(w, r)
+	C.ts_tree_print_dot_graph(t.c, C.int(pw.Fd()))
+
+	if err = pw.Close(); err != nil {
+		return err
+	}
+
+	return <-done
+}
+
+// CoveredRanges returns the ranges of the input covered by the tree's tokens
+// (leaf nodes, including extras and errors), with adjacent ranges merged.
+// Bytes that are not covered were either skipped by the lexer (whitespace) or
+// are outside the included ranges.
+func (t *Tree) CoveredRanges() (out []Range) {
+	c := NewTreeCursor(t.RootNode())
+
+	for visited := false; ; {
+		if !visited && c.GoToFirstChild() {
+			continue
+		}
+
+		if !visited {
+			n := c.CurrentNode()
+			if last := len(out) - 1; last >= 0 && out[last].EndByte >= n.StartByte() {
+				if n.EndByte() > out[last].EndByte {
+					out[last].EndByte, out[last].EndPoint = n.EndByte(), n.EndPoint()
+				}
+			} else if n.EndByte() > n.StartByte() {
+				out = append(out, n.Range())
+			}
+		}
+
+		if visited = !c.GoToNextSibling(); visited && !c.GoToParent() {
+			return
+		}
+	}
+}
+
+// UncoveredRanges returns the parts of the tree's included ranges (clipped to
+// src) that are not covered by any token (see [Tree.CoveredRanges]) and that
+// contain more than just whitespace. Such bytes are a sign of a misconfigured
+// range (i.e. in an injection pipeline), as they ended up outside any node.
+func (t *Tree) UncoveredRanges(src []byte) []Range {
+	return uncoveredRanges(t.IncludedRanges(), t.CoveredRanges(), src)
+}
+
+func uncoveredRanges(included, covered []Range, src []byte) (out []Range) {
+	gap := func(start, end uint, startPoint Point) {
+		end = min(end, uint(len(src)))
+		if start >= end || len(bytes.TrimSpace(src[start:end])) == 0 {
+			return
+		}
+
+		out = append(out, Range{
+			StartByte: start, EndByte: end,
+			StartPoint: startPoint, EndPoint: advancePoint(startPoint, src[start:end]),
+		})
+	}
+
+	for _, ir := range included {
+		pos, point := ir.StartByte, ir.StartPoint
+
+		for _, cr := range covered {
+			if cr.EndByte <= pos || cr.StartByte >= ir.EndByte {
+				continue
+			}
+
+			if cr.StartByte > pos {
+				gap(pos, cr.StartByte, point)
+			}
+
+			pos, point = cr.EndByte, cr.EndPoint
+		}
+
+		gap(pos, ir.EndByte, point)
+	}
+
+	return
+}
+
+// isWholeDocument checks if the ranges are the ones tree-sitter uses when
+// no included ranges were set.
+func isWholeDocument(ranges []Range) bool {
+	return len(ranges) == 1 && ranges[0] == Range{
+		EndPoint: Point{Row: uint(maxUint32), Column: uint(maxUint32)}, EndByte: uint(maxUint32),
+	}
+}
+
+func advancePoint(p Point, b []byte) Point {
+	for _, c := range b {
+		if c == '\n' {
+			p.Row++
+			p.Column = 0
+		} else {
+			p.Column++
+		}
+	}
+
+	return p
 }
@@ -45,7 +45,7 @@ func (c *TreeCursor) close() {
 // Reset re-initializes a tree cursor to start at the original node that the cursor was
 // constructed with.
 func (c *TreeCursor) Reset(n Node) {
-	C.ts_tree_cursor_reset(c.c, n.c)
+	profiledDo(CgoTreeCursor, func() { C.ts_tree_cursor_reset(c.c, n.c) })
 }
 
 // ResetTo re-initializes a tree cursor to the same position as another cursor.
@@ -53,12 +53,12 @@ func (c *TreeCursor) Reset(n Node) {
 // Unlike `ts_tree_cursor_reset`, this will not lose parent information and
 // allows reusing already created cursors.
 func (c *TreeCursor) ResetTo(src *TreeCursor) {
-	C.ts_tree_cursor_reset_to(c.c, src.c)
+	profiledDo(CgoTreeCursor, func() { C.ts_tree_cursor_reset_to(c.c, src.c) })
 }
 
 // CurrentNode returns the cursor's current node.
 func (c *TreeCursor) CurrentNode() Node {
-	return newNode(C.ts_tree_cursor_current_node(c.c))
+	return profiled(CgoTreeCursor, func() Node { return newNode(C.ts_tree_cursor_current_node(c.c)) })
 }
 
 // CurrentFieldName gets the field name of the tree cursor's current node.
@@ -66,7 +66,7 @@ func (c *TreeCursor) CurrentNode() Node {
 // This returns empty string if the current node doesn't have a field.
 // See also `ts_node_child_by_field_name`.
 func (c *TreeCursor) CurrentFieldName() string {
-	return C.GoString(C.ts_tree_cursor_current_field_name(c.c))
+	return profiled(CgoTreeCursor, func() string { return C.GoString(C.ts_tree_cursor_current_field_name(c.c)) })
 }
 
 // CurrentFieldID returns the field id of the tree cursor's current node.
@@ -74,7 +74,7 @@ func (c *TreeCursor) CurrentFieldName() string {
 // This returns zero if the current node doesn't have a field.
 // See also `ts_node_child_by_field_id`, `ts_language_field_id_for_name`.
 func (c *TreeCursor) CurrentFieldID() FieldID {
-	return C.ts_tree_cursor_current_field_id(c.c)
+	return profiled(CgoTreeCursor, func() FieldID { return C.ts_tree_cursor_current_field_id(c.c) })
 }
 
 // GoToParent moves the cursor to the parent of its current node.
@@ -82,7 +82,7 @@ func (c *TreeCursor) CurrentFieldID() FieldID {
 // This returns `true` if the cursor successfully moved, and returns `false`
 // if there was no parent node (the cursor was already on the root node).
 func (c *TreeCursor) GoToParent() bool {
-	return bool(C.ts_tree_cursor_goto_parent(c.c))
+	return profiled(CgoTreeCursor, func() bool { return bool(C.ts_tree_cursor_goto_parent(c.c)) })
 }
 
 // GoToNextSibling moves the cursor to the next sibling of its current node.
@@ -90,7 +90,7 @@ func (c *TreeCursor) GoToParent() bool {
 // This returns `true` if the cursor successfully moved, and returns `false`
 // if there was no next sibling node.
 func (c *TreeCursor) GoToNextSibling() bool {
-	return bool(C.ts_tree_cursor_goto_next_sibling(c.c))
+	return profiled(CgoTreeCursor, func() bool { return bool(C.ts_tree_cursor_goto_next_sibling(c.c)) })
 }
 
 // GotoPreviousSibling moves the cursor to the previous sibling of its current node.
@@ -103,7 +103,7 @@ func (c *TreeCursor) GoToNextSibling() bool {
 // the worst case, this will need to iterate through all the children upto the
 // previous sibling node to recalculate its position.
 func (c *TreeCursor) GotoPreviousSibling() bool {
-	return bool(C.ts_tree_cursor_goto_previous_sibling(c.c))
+	return profiled(CgoTreeCursor, func() bool { return bool(C.ts_tree_cursor_goto_previous_sibling(c.c)) })
 }
 
 // GoToFirstChild moves the cursor to the first child of its current node.
@@ -111,7 +111,7 @@ func (c *TreeCursor) GotoPreviousSibling() bool {
 // This returns `true` if the cursor successfully moved, and returns `false`
 // if there were no children.
 func (c *TreeCursor) GoToFirstChild() bool {
-	return bool(C.ts_tree_cursor_goto_first_child(c.c))
+	return profiled(CgoTreeCursor, func() bool { return bool(C.ts_tree_cursor_goto_first_child(c.c)) })
 }
 
 // GotoLastChild moves the cursor to the last child of its current node.
@@ -123,26 +123,26 @@ func (c *TreeCursor) GoToFirstChild() bool {
 // because it needs to iterate through all the children to compute the child's
 // position.
 func (c *TreeCursor) GotoLastChild() bool {
-	return bool(C.ts_tree_cursor_goto_last_child(c.c))
+	return profiled(CgoTreeCursor, func() bool { return bool(C.ts_tree_cursor_goto_last_child(c.c)) })
 }
 
 // GotoDescendant moves the cursor to the node that is the nth descendant of
 // the original node that the cursor was constructed with, where
 // zero represents the original node itself.
 func (c *TreeCursor) GotoDescendant(goalDescendantIndex uint32) {
-	C.ts_tree_cursor_goto_descendant(c.c, C.uint(goalDescendantIndex))
+	profiledDo(CgoTreeCursor, func() { C.ts_tree_cursor_goto_descendant(c.c, C.uint(goalDescendantIndex)) })
 }
 
 // CurrentDescendantIndex returns the index of the cursor's current node out of all of the
 // descendants of the original node that the cursor was constructed with.
 func (c *TreeCursor) CurrentDescendantIndex() uint32 {
-	return uint32(C.ts_tree_cursor_current_descendant_index(c.c))
+	return profiled(CgoTreeCursor, func() uint32 { return uint32(C.ts_tree_cursor_current_descendant_index(c.c)) })
 }
 
 // CurrentDepth returns the depth of the cursor's current node relative to the
 // original node that the cursor was constructed with.
 func (c *TreeCursor) CurrentDepth() uint32 {
-	return uint32(C.ts_tree_cursor_current_depth(c.c))
+	return profiled(CgoTreeCursor, func() uint32 { return uint32(C.ts_tree_cursor_current_depth(c.c)) })
 }
 
 // GoToFirstChildForByte moves the cursor to the first child of its current node
@@ -151,7 +151,9 @@ func (c *TreeCursor) CurrentDepth() uint32 {
 // This returns the index of the child node if one was found, and returns -1
 // if no such child was found.
 func (c *TreeCursor) GoToFirstChildForByte(b uint32) int64 {
-	return int64(C.ts_tree_cursor_goto_first_child_for_byte(c.c, C.uint(b)))
+	return profiled(CgoTreeCursor, func() int64 {
+		return int64(C.ts_tree_cursor_goto_first_child_for_byte(c.c, C.uint(b)))
+	})
 }
 
 // GoToFirstChildForPoint moves the cursor to the first child of its current node
@@ -160,10 +162,38 @@ func (c *TreeCursor) GoToFirstChildForByte(b uint32) int64 {
 // This returns the index of the child node if one was found, and returns -1
 // if no such child was found.
 func (c *TreeCursor) GoToFirstChildForPoint(p Point) int64 {
-	return int64(C.ts_tree_cursor_goto_first_child_for_point(c.c, p.c()))
+	return profiled(CgoTreeCursor, func() int64 {
+		return int64(C.ts_tree_cursor_goto_first_child_for_point(c.c, p.c()))
+	})
 }
 
 // Copy returns a copy of the tree cursor.
 func (c *TreeCursor) Copy() *TreeCursor {
-	return newTreeCursor(C.ts_tree_cursor_copy(c.c))
+	return profiled(CgoTreeCursor, func() *TreeCursor { return newTreeCursor(C.ts_tree_cursor_copy(c.c)) })
+}
+
+// Non API.
+
+//nolint:gochecknoglobals // ok
+var cursorPool sync.Pool
+
+// AcquireCursor returns a tree cursor starting from the given node, reusing
+// (and resetting) one released with [ReleaseCursor] if available, rather than
+// creating a new one, for the code paths walking trees often (e.g. on each
+// keystroke), as creating and deleting cursors crosses into C.
+func AcquireCursor(n Node) *TreeCursor {
+	if c, ok := cursorPool.Get().(*TreeCursor); ok {
+		c.Reset(n)
+		return c
+	}
+
+	return NewTreeCursor(n)
+}
+
+// ReleaseCursor releases a cursor acquired with [AcquireCursor] (or created
+// with [NewTreeCursor]) for reuse. The cursor must not be used afterwards.
+func ReleaseCursor(c *TreeCursor) {
+	if c != nil {
+		cursorPool.Put(c)
+	}
 }
@@ -1,6 +1,9 @@
 package sitter
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
 func TestNewTreeCursor(t *testing.T) {
 	t.Parallel()
@@ -24,7 +27,26 @@ func TestTreeCursorReset(t *testing.T) {
 
 func TestTreeCursorResetTo(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewTreeCursor(root)
+
+	c.GoToFirstChild()
+
+	c2 := NewTreeCursor(root.Child(1))
+	c2.ResetTo(c)
+
+	if act := c2.CurrentNode().Type(); act != "sum" {
+		t.Fatal("Expected sum, got", act)
+	}
+
+	if !c2.GoToParent() {
+		t.Fatal("Expected the cursor to keep the path to the root")
+	}
 }
 
 func TestTreeCursorCurrentNode(t *testing.T) {
@@ -39,7 +61,25 @@ func TestTreeCursorCurrentFieldName(t *testing.T) {
 
 func TestTreeCursorCurrentFieldID(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewTreeCursor(root)
+
+	c.GoToFirstChild()
+
+	if act := c.CurrentFieldID(); act != 0 {
+		t.Fatal("Expected no field, got", act)
+	}
+
+	c.GoToFirstChild()
+
+	if act, exp := c.CurrentFieldID(), gr.FieldID("left"); act != exp {
+		t.Fatalf("Expected %d, got %d", exp, act)
+	}
 }
 
 func TestTreeCursorGoToParent(t *testing.T) {
@@ -54,7 +94,28 @@ func TestTreeCursorGoToNextSibling(t *testing.T) {
 
 func TestTreeCursorGotoPreviousSibling(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewTreeCursor(root)
+
+	if c.GotoPreviousSibling() {
+		t.Fatal("Expected the root to have no siblings")
+	}
+
+	c.GoToFirstChild()
+	c.GoToNextSibling()
+
+	if !c.GotoPreviousSibling() || c.CurrentNode().Type() != "sum" {
+		t.Fatal("Expected the cursor to move back to the sum, got", c.CurrentNode())
+	}
+
+	if c.GotoPreviousSibling() {
+		t.Fatal("Expected the sum to have no previous sibling")
+	}
 }
 
 func TestTreeCursorGoToFirstChild(t *testing.T) {
@@ -64,22 +125,80 @@ func TestTreeCursorGoToFirstChild(t *testing.T) {
 
 func TestTreeCursorGotoLastChild(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewTreeCursor(root)
+
+	if !c.GotoLastChild() || c.CurrentNode().Type() != "comment" {
+		t.Fatal("Expected the cursor to move to the comment, got", c.CurrentNode())
+	}
+
+	if c.GotoLastChild() {
+		t.Fatal("Expected the comment to have no children")
+	}
 }
 
 func TestTreeCursorGotoDescendant(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewTreeCursor(root)
+
+	c.GotoDescendant(6)
+
+	if act := c.CurrentNode(); !act.Equal(root.Child(0).Child(2).Child(0)) {
+		t.Fatal("Expected the second number, got", act)
+	}
 }
 
 func TestTreeCursorCurrentDescendantIndex(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewTreeCursor(root)
+
+	for i := range uint32(8) {
+		c.GotoDescendant(i)
+
+		if act := c.CurrentDescendantIndex(); act != i {
+			t.Fatalf("Expected %d, got %d", i, act)
+		}
+	}
 }
 
 func TestTreeCursorCurrentDepth(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewTreeCursor(root)
+
+	for exp, move := range []func() bool{c.GoToFirstChild, c.GoToFirstChild, c.GoToFirstChild} {
+		if act := c.CurrentDepth(); act != uint32(exp) { //nolint:gosec // ok
+			t.Fatalf("Expected %d, got %d", exp, act)
+		}
+
+		move()
+	}
+
+	if act := c.CurrentDepth(); act != 3 {
+		t.Fatal("Expected 3, got", act)
+	}
 }
 
 func TestTreeCursorGoToFirstChildForByte(t *testing.T) {
@@ -89,10 +208,72 @@ func TestTreeCursorGoToFirstChildForByte(t *testing.T) {
 
 func TestTreeCursorGoToFirstChildForPoint(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewTreeCursor(root)
+
+	if act := c.GoToFirstChildForPoint(Point{Column: 7}); act != 1 || c.CurrentNode().Type() != "comment" {
+		t.Fatalf("Expected the comment as child #1, got %s as #%d", c.CurrentNode(), act)
+	}
+
+	if act := c.GoToFirstChildForPoint(Point{Column: 20}); act != -1 {
+		t.Fatal("Expected no child, got", act)
+	}
 }
 
 func TestTreeCursorCopy(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // c"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	c := NewTreeCursor(root)
+
+	c.GoToFirstChild()
+
+	c2 := c.Copy()
+	c2.GoToFirstChild()
+
+	if act := c.CurrentNode().Type(); act != "sum" {
+		t.Fatal("Expected the original cursor to stay on the sum, got", act)
+	}
+
+	if act := c2.CurrentNode().Type(); act != "expression" || !c2.GoToParent() || !c2.GoToParent() {
+		t.Fatal("Expected the copy to move on its own, got", act)
+	}
+}
+
+func TestAcquireCursor(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for _, n := range []Node{root, root.Child(0), root.Child(0).Child(2)} {
+		c := AcquireCursor(n)
+
+		if act := c.CurrentNode(); !act.Equal(n) {
+			t.Fatalf("Expected %s, got %s", n, act)
+		}
+
+		if c.GoToParent() {
+			t.Fatal("Expected the cursor to start from the node, with no parent")
+		}
+
+		c.GoToFirstChild() // Moved, to be reset when acquired again.
+		ReleaseCursor(c)
+	}
+}
+
+func TestReleaseCursor(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
 }
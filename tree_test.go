@@ -1,6 +1,15 @@
 package sitter
 
-import "testing"
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestInputEditC(t *testing.T) {
 	t.Parallel()
@@ -39,7 +48,25 @@ func TestParserNewTree(t *testing.T) {
 
 func TestTreeCopy(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	cp := tree.Copy()
+	cp.Edit(InputEdit{NewEndIndex: 1, NewEndPoint: Point{Column: 1}})
+
+	if act := tree.RootNode().EndByte(); act != 5 {
+		t.Fatal("Expected the original tree to be left as it was, got", act)
+	}
+
+	if act := cp.RootNode().EndByte(); act != 6 {
+		t.Fatal("Expected the copy to be edited, got", act)
+	}
 }
 
 func TestBaseTreeClose(t *testing.T) {
@@ -52,14 +79,64 @@ func TestTreeRootNode(t *testing.T) {
 	t.Skip("tested implicitly")
 }
 
+func TestTreeWalk(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2 // c"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	n, maxDepth := 0, 0
+
+	for _, depth := range tree.Walk() {
+		n, maxDepth = n+1, max(maxDepth, depth)
+	}
+
+	if n != 8 || maxDepth != 3 {
+		t.Fatalf("Expected 8 nodes up to depth 3, got %d up to %d", n, maxDepth)
+	}
+}
+
 func TestTreeRootNodeWithOffset(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	n := tree.RootNodeWithOffset(10, Point{Row: 2, Column: 3})
+
+	if n.StartByte() != 10 || n.EndByte() != 15 || n.StartPoint() != (Point{Row: 2, Column: 3}) {
+		t.Fatal("Expected the root to be shifted, got", n.Range())
+	}
+
+	if act := n.Child(0).Child(2).StartByte(); act != 14 {
+		t.Fatal("Expected the descendants to be shifted, got", act)
+	}
 }
 
 func TestTreeLanguage(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := tree.Language(); act.ptr != gr.ptr {
+		t.Fatal("Expected the parser's language, got", act)
+	}
 }
 
 func TestTreeIncludedRanges(t *testing.T) {
@@ -74,12 +151,85 @@ func TestTreeEdit(t *testing.T) {
 
 func TestTreeGetChangedRanges(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// Replace the "2" with "(3)".
+	tree.Edit(InputEdit{
+		StartIndex: 4, OldEndIndex: 5, NewEndIndex: 7,
+		StartPoint: Point{Column: 4}, OldEndPoint: Point{Column: 5}, NewEndPoint: Point{Column: 7},
+	})
+
+	tree2, err := p.ParseString(context.Background(), tree, []byte("1 + (3)"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := []Range{{StartByte: 4, EndByte: 7, StartPoint: Point{Column: 4}, EndPoint: Point{Column: 7}}}
+	if act := tree.GetChangedRanges(tree2); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %v, got %v", exp, act)
+	}
+
+	if act := tree2.GetChangedRanges(tree2.Copy()); len(act) != 0 {
+		t.Fatal("Expected no changes, got", act)
+	}
 }
 
 func TestTreePrintDotGraph(t *testing.T) {
 	t.Parallel()
-	t.Skip("TODO")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	name := filepath.Join(t.TempDir(), "tree.dot")
+	if err = tree.PrintDotGraph(name); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act, err := os.ReadFile(name); err != nil || !bytes.HasPrefix(act, []byte("digraph tree {")) {
+		t.Fatalf("Expected the tree's dot graph and no error, got %q and %v", act, err)
+	}
+
+	if err = tree.PrintDotGraph(t.TempDir()); err == nil {
+		t.Fatal("Expected an error")
+	}
+}
+
+func TestTreeWriteDotGraph(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err = tree.WriteDotGraph(buf); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := buf.String(); !strings.HasPrefix(act, "digraph tree {") || !strings.Contains(act, "sum") {
+		t.Fatal("Expected the tree's dot graph, got", act)
+	}
+
+	errBoom := errors.New("boom")
+	if err = tree.WriteDotGraph(errWriter{errBoom}); !errors.Is(err, errBoom) {
+		t.Fatalf("Expected %v, got %v", errBoom, err)
+	}
 }
 
 func TestTreeCachedNode(t *testing.T) {
@@ -87,7 +237,112 @@ func TestTreeCachedNode(t *testing.T) {
 	t.Skip("tested implicitly")
 }
 
-func TestFreeTSRangeArray(t *testing.T) {
+func TestTreeCoveredRanges(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, []byte("1 + 22\n// c"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := []Range{
+		{StartByte: 0, EndByte: 1, EndPoint: Point{Column: 1}},
+		{StartByte: 2, EndByte: 3, StartPoint: Point{Column: 2}, EndPoint: Point{Column: 3}},
+		{StartByte: 4, EndByte: 6, StartPoint: Point{Column: 4}, EndPoint: Point{Column: 6}},
+		{StartByte: 7, EndByte: 11, StartPoint: Point{Row: 1}, EndPoint: Point{Row: 1, Column: 4}},
+	}
+	if act := tree.CoveredRanges(); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected\n%v\ngot\n%v", exp, act)
+	}
+}
+
+func TestTreeUncoveredRanges(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("1 + 2\n//3 + 5")
+
+	p := NewParser()
+	p.SetLanguage(gr)
+	p.SetIncludedRanges([]Range{{
+		StartByte: 8, EndByte: uint(len(src)),
+		StartPoint: Point{Row: 1, Column: 2}, EndPoint: Point{Row: 1, Column: 7},
+	}})
+
+	tree, err := p.ParseString(context.Background(), nil, src)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act := tree.UncoveredRanges(src); act != nil {
+		t.Fatal("Expected no uncovered ranges, got", act)
+	}
+}
+
+func TestUncoveredRanges(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("ab cd\n ef gh")
+	included := []Range{
+		{StartByte: 0, EndByte: 5, EndPoint: Point{Column: 5}},
+		{StartByte: 6, EndByte: 100, StartPoint: Point{Row: 1}, EndPoint: Point{Row: 1, Column: 94}},
+	}
+	covered := []Range{
+		{StartByte: 0, EndByte: 2, EndPoint: Point{Column: 2}},
+		{StartByte: 10, EndByte: 12, StartPoint: Point{Row: 1, Column: 4}, EndPoint: Point{Row: 1, Column: 6}},
+	}
+
+	exp := []Range{
+		{StartByte: 2, EndByte: 5, StartPoint: Point{Column: 2}, EndPoint: Point{Column: 5}},
+		{StartByte: 6, EndByte: 10, StartPoint: Point{Row: 1}, EndPoint: Point{Row: 1, Column: 4}},
+	}
+	if act := uncoveredRanges(included, covered, src); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected\n%v\ngot\n%v", exp, act)
+	}
+}
+
+func TestIsWholeDocument(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestAdvancePoint(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestTakeRanges(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("1 + 2\n//3 + 5\n//7")
+	ranges := []Range{
+		{StartByte: 8, EndByte: 13, StartPoint: Point{Row: 1, Column: 2}, EndPoint: Point{Row: 1, Column: 7}},
+		{StartByte: 16, EndByte: 17, StartPoint: Point{Row: 2, Column: 2}, EndPoint: Point{Row: 2, Column: 3}},
+	}
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	if !p.SetIncludedRanges(ranges) {
+		t.Fatal("Expected included ranges to be set")
+	}
+
+	tree, err := p.ParseString(context.Background(), nil, src)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// Each call allocates (and frees) an array with multiple ranges.
+	for range 1_000 {
+		if act := tree.IncludedRanges(); !reflect.DeepEqual(act, ranges) {
+			t.Fatalf("Expected\n%v\ngot\n%v", ranges, act)
+		}
+	}
+}
+
+func TestCRanges(t *testing.T) {
 	t.Parallel()
 	t.Skip("tested implicitly")
 }
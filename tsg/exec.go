@@ -0,0 +1,420 @@
+package tsg
+
+import (
+	"fmt"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// executor holds the state of a [File.Execute] run.
+type executor struct {
+	file     *File
+	graph    *Graph
+	globals  map[string]any
+	scoped   map[scopedKey]*thunk
+	src      []byte
+	deferred []deferred
+}
+
+// scopedKey identifies a scoped variable, i.e. a variable of a syntax node.
+type scopedKey struct {
+	node sitter.Node
+	name string
+}
+
+// thunk is a lazily evaluated variable.
+type thunk struct {
+	e     expr
+	env   *env
+	value any
+	state int // One of the thunk* constants.
+}
+
+// deferred is an edge or attr statement, applied once all the stanzas ran.
+type deferred struct {
+	st  stmt
+	env *env
+}
+
+// env holds the variables of a stanza's match, with a child env for each
+// nested block.
+type env struct {
+	parent   *env
+	captures map[string]any
+	locals   map[string]*thunk
+	mutable  map[string]bool
+}
+
+const (
+	thunkPending = iota
+	thunkForcing
+	thunkDone
+)
+
+func newEnv(parent *env, caps map[string]any) *env {
+	if parent != nil {
+		caps = parent.captures
+	}
+
+	return &env{parent: parent, captures: caps, locals: map[string]*thunk{}, mutable: map[string]bool{}}
+}
+
+func (e *env) lookup(name string) (*env, *thunk) {
+	for ; e != nil; e = e.parent {
+		if t, ok := e.locals[name]; ok {
+			return e, t
+		}
+	}
+
+	return nil, nil
+}
+
+func (x *executor) run(stmts []stmt, e *env) (err error) { //nolint:cyclop,funlen // ok
+	for _, st := range stmts {
+		switch st := st.(type) {
+		case nodeStmt:
+			n := &GraphNode{ID: len(x.graph.Nodes), Attrs: map[string]any{}}
+			x.graph.Nodes = append(x.graph.Nodes, n)
+			err = x.define(st.v, e, &thunk{value: n, state: thunkDone})
+		case letStmt:
+			t := &thunk{e: st.e, env: e}
+
+			if st.mutable {
+				if t.value, err = x.eval(st.e, e); err != nil {
+					return
+				}
+
+				t.state = thunkDone
+			}
+
+			if err = x.define(st.v, e, t); err == nil && st.mutable {
+				e.mutable[st.v.name] = true
+			}
+		case setStmt:
+			owner, t := e.lookup(st.v.name)
+			if t == nil || !owner.mutable[st.v.name] {
+				return fmt.Errorf("%w: mutable variable %s", ErrUndefined, st.v.name)
+			}
+
+			t.value, err = x.eval(st.e, e)
+		case edgeStmt, attrStmt:
+			x.deferred = append(x.deferred, deferred{st: st, env: e})
+		case ifStmt:
+			err = x.runIf(st, e)
+		case forStmt:
+			err = x.runFor(st, e)
+		case printStmt:
+			var out []string
+
+			for _, arg := range st.args {
+				var v any
+
+				if v, err = x.eval(arg, e); err != nil {
+					return
+				}
+
+				out = append(out, display(v))
+			}
+
+			x.graph.Output = append(x.graph.Output, strings.Join(out, ""))
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (x *executor) runIf(st ifStmt, e *env) error {
+	for _, arm := range st.arms {
+		ok := true
+
+		for _, c := range arm.conds {
+			v, err := x.eval(c.e, e)
+			if err != nil {
+				return err
+			}
+
+			switch c.kind {
+			case "some":
+				ok = v != nil
+			case "none":
+				ok = v == nil
+			default:
+				b, isBool := v.(bool)
+				if !isBool {
+					return fmt.Errorf("%w: condition must be a boolean, got %s", ErrType, Format(v))
+				}
+
+				ok = b
+			}
+
+			if !ok {
+				break
+			}
+		}
+
+		if ok {
+			return x.run(arm.body, newEnv(e, nil))
+		}
+	}
+
+	return nil
+}
+
+func (x *executor) runFor(st forStmt, e *env) error {
+	v, err := x.eval(st.e, e)
+	if err != nil {
+		return err
+	}
+
+	list, ok := v.([]any)
+	if !ok {
+		return fmt.Errorf("%w: can only iterate over lists, got %s", ErrType, Format(v))
+	}
+
+	for _, elem := range list {
+		child := newEnv(e, nil)
+		child.locals[st.name] = &thunk{value: elem, state: thunkDone}
+
+		if err = x.run(st.body, child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// define defines a local or a scoped variable.
+func (x *executor) define(v variable, e *env, t *thunk) error {
+	if v.capture == "" {
+		if _, ok := e.locals[v.name]; ok {
+			return fmt.Errorf("%w: variable %s", ErrDuplicate, v.name)
+		}
+
+		e.locals[v.name] = t
+
+		return nil
+	}
+
+	key, err := x.scopedKey(captureExpr{name: v.capture}, v.name, e)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := x.scoped[key]; ok {
+		return fmt.Errorf("%w: scoped variable @%s.%s", ErrDuplicate, v.capture, v.name)
+	}
+
+	x.scoped[key] = t
+
+	return nil
+}
+
+func (x *executor) scopedKey(node expr, name string, e *env) (key scopedKey, err error) {
+	v, err := x.eval(node, e)
+	if err != nil {
+		return
+	}
+
+	n, ok := v.(sitter.Node)
+	if !ok {
+		return key, fmt.Errorf("%w: scoped variables need a syntax node, got %s", ErrType, Format(v))
+	}
+
+	return scopedKey{node: n, name: name}, nil
+}
+
+func (x *executor) eval(ex expr, e *env) (any, error) { //nolint:cyclop // ok
+	switch ex := ex.(type) {
+	case literal:
+		return ex.v, nil
+	case captureExpr:
+		v, ok := e.captures[ex.name]
+		if !ok {
+			return nil, fmt.Errorf("%w: capture @%s", ErrUndefined, ex.name)
+		}
+
+		return v, nil
+	case varExpr:
+		if _, t := e.lookup(ex.name); t != nil {
+			return x.force(t)
+		}
+
+		if v, ok := x.globals[ex.name]; ok {
+			return v, nil
+		}
+
+		return nil, fmt.Errorf("%w: variable %s", ErrUndefined, ex.name)
+	case scopedExpr:
+		key, err := x.scopedKey(ex.node, ex.name, e)
+		if err != nil {
+			return nil, err
+		}
+
+		t, ok := x.scoped[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: scoped variable %s of %s", ErrUndefined, ex.name, Format(key.node))
+		}
+
+		return x.force(t)
+	case listExpr:
+		list := make([]any, 0, len(ex.elems))
+
+		for _, elem := range ex.elems {
+			v, err := x.eval(elem, e)
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, v)
+		}
+
+		return list, nil
+	case callExpr:
+		fn, ok := x.file.functions[ex.fn]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownFunction, ex.fn)
+		}
+
+		args, err := x.eval(listExpr{elems: ex.args}, e)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := fn(x.src, args.([]any)) //nolint:forcetypeassert // always a list
+		if err != nil {
+			return nil, fmt.Errorf("(%s): %w", ex.fn, err)
+		}
+
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown expression %T", ErrType, ex)
+}
+
+func (x *executor) force(t *thunk) (v any, err error) {
+	switch t.state {
+	case thunkDone:
+		return t.value, nil
+	case thunkForcing:
+		return nil, ErrCycle
+	}
+
+	t.state = thunkForcing
+
+	if v, err = x.eval(t.e, t.env); err != nil {
+		t.state = thunkPending
+		return
+	}
+
+	t.value, t.state = v, thunkDone
+
+	return
+}
+
+// apply applies a deferred edge or attr statement.
+func (x *executor) apply(d deferred) error {
+	switch st := d.st.(type) {
+	case edgeStmt:
+		src, sink, err := x.edgeNodes(st.src, st.dst, d.env)
+		if err != nil {
+			return err
+		}
+
+		if findEdge(src, sink) == nil {
+			src.Edges = append(src.Edges, &Edge{Sink: sink, Attrs: map[string]any{}})
+		}
+	case attrStmt:
+		return x.applyAttr(st, d.env)
+	}
+
+	return nil
+}
+
+func (x *executor) applyAttr(st attrStmt, e *env) (err error) {
+	var attrs map[string]any
+
+	if st.sink == nil {
+		var v any
+
+		if v, err = x.eval(st.node, e); err != nil {
+			return
+		}
+
+		n, ok := v.(*GraphNode)
+		if !ok {
+			return fmt.Errorf("%w: attr needs a graph node, got %s", ErrType, Format(v))
+		}
+
+		attrs = n.Attrs
+	} else {
+		src, sink, err := x.edgeNodes(st.node, st.sink, e)
+		if err != nil {
+			return err
+		}
+
+		edge := findEdge(src, sink)
+		if edge == nil {
+			return fmt.Errorf("%w: edge %d -> %d", ErrUndefined, src.ID, sink.ID)
+		}
+
+		attrs = edge.Attrs
+	}
+
+	for _, a := range st.attrs {
+		if _, ok := attrs[a.name]; ok {
+			return fmt.Errorf("%w: attribute %s", ErrDuplicate, a.name)
+		}
+
+		if attrs[a.name], err = x.eval(a.value, e); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (x *executor) edgeNodes(srcExpr, sinkExpr expr, e *env) (src, sink *GraphNode, err error) {
+	for _, n := range []struct {
+		e expr
+		p **GraphNode
+	}{{srcExpr, &src}, {sinkExpr, &sink}} {
+		var v any
+
+		if v, err = x.eval(n.e, e); err != nil {
+			return
+		}
+
+		var ok bool
+
+		if *n.p, ok = v.(*GraphNode); !ok {
+			return nil, nil, fmt.Errorf("%w: edges need graph nodes, got %s", ErrType, Format(v))
+		}
+	}
+
+	return
+}
+
+func findEdge(src, sink *GraphNode) *Edge {
+	for _, e := range src.Edges {
+		if e.Sink == sink {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// display formats the value for print and format: as [Format] does, except
+// for strings, which are not quoted.
+func display(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+
+	return Format(v)
+}
@@ -0,0 +1,56 @@
+package tsg
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestExecutorRun(t *testing.T) {
+	t.Parallel()
+
+	p, err := parse(`(x) @x {
+  var l = []
+  for i in [1, 2, 3] {
+    if (eq i 2) {
+      set l = (concat l [i])
+    }
+  }
+
+  let b = (plus a 1)
+  let a = (length l)
+  print "l=", l, " b=", b
+}`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	x := &executor{graph: &Graph{}, file: &File{functions: Builtins()}}
+	if err = x.run(p.stanzas[0].stmts, newEnv(nil, nil)); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act, exp := x.graph.Output, "l=[2] b=2"; len(act) != 1 || act[0] != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	p, err = parse(`(x) @x { let a = 1 set a = 2 }`)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if err = x.run(p.stanzas[0].stmts, newEnv(nil, nil)); !errors.Is(err, ErrUndefined) {
+		t.Fatalf("Expected %v, got %v", ErrUndefined, err)
+	}
+}
+
+func TestExecutorForce(t *testing.T) {
+	t.Parallel()
+
+	x := &executor{file: &File{}}
+	e := newEnv(nil, nil)
+	e.locals["a"] = &thunk{e: varExpr{name: "a"}, env: e}
+
+	if _, err := x.eval(varExpr{name: "a"}, e); !errors.Is(err, ErrCycle) {
+		t.Fatalf("Expected %v, got %v", ErrCycle, err)
+	}
+}
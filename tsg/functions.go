@@ -0,0 +1,231 @@
+package tsg
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// Builtins returns the builtin functions:
+//
+//   - (source-text n), (node-type n): the source text and the type of the
+//     syntax node n;
+//   - (start-row n), (start-column n), (end-row n), (end-column n): the (zero
+//     based) positions of the syntax node n;
+//   - (named-child-index n), (named-child-count n): the index of the syntax
+//     node n among its parent's named children, the number of its own;
+//   - (eq a b), (not b), (and b...), (or b...): comparisons and logic;
+//   - (plus i...): the sum of the numbers;
+//   - (format "fmt" v...): fmt, with each {} replaced by the next value;
+//   - (concat l...), (length l): concatenation and length of lists;
+//   - (is-null v): whether v is #null;
+//   - (replace s "regex" "replacement"): regexp replacement, with $1 style
+//     references to the submatches.
+func Builtins() map[string]Func {
+	return map[string]Func{
+		"source-text": nodeFunc(func(src []byte, n sitter.Node) any { return n.Content(src) }),
+		"node-type":   nodeFunc(func(_ []byte, n sitter.Node) any { return n.Type() }),
+		"start-row":   nodeFunc(func(_ []byte, n sitter.Node) any { return int(n.StartPoint().Row) }),
+		"start-column": nodeFunc(func(_ []byte, n sitter.Node) any {
+			return int(n.StartPoint().Column)
+		}),
+		"end-row":    nodeFunc(func(_ []byte, n sitter.Node) any { return int(n.EndPoint().Row) }),
+		"end-column": nodeFunc(func(_ []byte, n sitter.Node) any { return int(n.EndPoint().Column) }),
+		"named-child-index": nodeFunc(func(_ []byte, n sitter.Node) any {
+			parent := n.Parent()
+			for i := range int(parent.NamedChildCount()) {
+				if parent.NamedChild(uint32(i)).Equal(n) { //nolint:gosec // i < NamedChildCount()
+					return i
+				}
+			}
+
+			return nil
+		}),
+		"named-child-count": nodeFunc(func(_ []byte, n sitter.Node) any { return int(n.NamedChildCount()) }),
+		"eq":                eqFunc,
+		"not":               notFunc,
+		"and":               boolsFunc(true),
+		"or":                boolsFunc(false),
+		"plus":              plusFunc,
+		"format":            formatFunc,
+		"concat":            concatFunc,
+		"length":            lengthFunc,
+		"is-null":           isNullFunc,
+		"replace":           replaceFunc,
+	}
+}
+
+// nodeFunc adapts a function of a syntax node to a [Func].
+func nodeFunc(fn func(src []byte, n sitter.Node) any) Func {
+	return func(src []byte, args []any) (any, error) {
+		if err := arity(args, 1); err != nil {
+			return nil, err
+		}
+
+		n, ok := args[0].(sitter.Node)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a syntax node, got %s", ErrType, Format(args[0]))
+		}
+
+		return fn(src, n), nil
+	}
+}
+
+func eqFunc(_ []byte, args []any) (any, error) {
+	if err := arity(args, 2); err != nil { //nolint:mnd // ok
+		return nil, err
+	}
+
+	a, b := args[0], args[1]
+	if _, ok := a.([]any); ok {
+		return Format(a) == Format(b), nil
+	}
+
+	if _, ok := b.([]any); ok {
+		return false, nil
+	}
+
+	return a == b, nil
+}
+
+func notFunc(_ []byte, args []any) (any, error) {
+	if err := arity(args, 1); err != nil {
+		return nil, err
+	}
+
+	b, ok := args[0].(bool)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a boolean, got %s", ErrType, Format(args[0]))
+	}
+
+	return !b, nil
+}
+
+// boolsFunc returns and (all) or or (!all).
+func boolsFunc(all bool) Func {
+	return func(_ []byte, args []any) (any, error) {
+		for _, arg := range args {
+			b, ok := arg.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%w: expected a boolean, got %s", ErrType, Format(arg))
+			}
+
+			if b != all {
+				return !all, nil
+			}
+		}
+
+		return all, nil
+	}
+}
+
+func plusFunc(_ []byte, args []any) (any, error) {
+	sum := 0
+
+	for _, arg := range args {
+		i, ok := arg.(int)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a number, got %s", ErrType, Format(arg))
+		}
+
+		sum += i
+	}
+
+	return sum, nil
+}
+
+func formatFunc(_ []byte, args []any) (any, error) {
+	if len(args) == 0 {
+		return nil, arity(args, 1)
+	}
+
+	f, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a format string, got %s", ErrType, Format(args[0]))
+	}
+
+	parts := strings.Split(f, "{}")
+	if len(parts) != len(args) {
+		return nil, fmt.Errorf("%w: format %q needs %d values, got %d", ErrType, f, len(parts)-1, len(args)-1)
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString(parts[0])
+
+	for i, arg := range args[1:] {
+		sb.WriteString(display(arg))
+		sb.WriteString(parts[i+1])
+	}
+
+	return sb.String(), nil
+}
+
+func concatFunc(_ []byte, args []any) (any, error) {
+	out := []any{}
+
+	for _, arg := range args {
+		l, ok := arg.([]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a list, got %s", ErrType, Format(arg))
+		}
+
+		out = append(out, l...)
+	}
+
+	return out, nil
+}
+
+func lengthFunc(_ []byte, args []any) (any, error) {
+	if err := arity(args, 1); err != nil {
+		return nil, err
+	}
+
+	l, ok := args[0].([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a list, got %s", ErrType, Format(args[0]))
+	}
+
+	return len(l), nil
+}
+
+func isNullFunc(_ []byte, args []any) (any, error) {
+	if err := arity(args, 1); err != nil {
+		return nil, err
+	}
+
+	return args[0] == nil, nil
+}
+
+func replaceFunc(_ []byte, args []any) (any, error) {
+	if err := arity(args, 3); err != nil { //nolint:mnd // ok
+		return nil, err
+	}
+
+	var strs [3]string
+
+	for i, arg := range args {
+		s, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a string, got %s", ErrType, Format(arg))
+		}
+
+		strs[i] = s
+	}
+
+	r, err := regexp.Compile(strs[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ReplaceAllString(strs[0], strs[2]), nil
+}
+
+func arity(args []any, n int) error {
+	if len(args) != n {
+		return fmt.Errorf("%w: expected %d arguments, got %d", ErrType, n, len(args))
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package tsg
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBuiltins(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		exp  any
+		fn   string
+		args []any
+	}{
+		{true, "eq", []any{"a", "a"}},
+		{false, "eq", []any{1, "1"}},
+		{true, "eq", []any{[]any{1, "a"}, []any{1, "a"}}},
+		{false, "not", []any{true}},
+		{true, "and", []any{true, true}},
+		{false, "and", []any{true, false}},
+		{true, "or", []any{false, true}},
+		{false, "or", []any{}},
+		{6, "plus", []any{1, 2, 3}},
+		{"a1-#true", "format", []any{"a{}-{}", 1, true}},
+		{[]any{1, 2, 3}, "concat", []any{[]any{1}, []any{}, []any{2, 3}}},
+		{2, "length", []any{[]any{1, 2}}},
+		{true, "is-null", []any{nil}},
+		{"a-b-c", "replace", []any{"a.b.c", `\.`, "-"}},
+	}
+
+	fns := Builtins()
+
+	for _, tc := range testCases {
+		act, err := fns[tc.fn](nil, tc.args)
+		if err != nil {
+			t.Fatalf("Expected no error for %s, got %v", tc.fn, err)
+		}
+
+		if !reflect.DeepEqual(act, tc.exp) {
+			t.Fatalf("Expected %v for %s, got %v", tc.exp, tc.fn, act)
+		}
+	}
+
+	for _, tc := range []struct {
+		fn   string
+		args []any
+	}{
+		{"source-text", []any{"not a node"}},
+		{"not", []any{1}},
+		{"plus", []any{"1"}},
+		{"format", []any{"{}"}},
+		{"length", []any{1, 2}},
+	} {
+		if _, err := fns[tc.fn](nil, tc.args); !errors.Is(err, ErrType) {
+			t.Fatalf("Expected %v for %s, got %v", ErrType, tc.fn, err)
+		}
+	}
+}
+
+func TestNodeFunc(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestFileExecute()")
+}
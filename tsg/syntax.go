@@ -0,0 +1,684 @@
+package tsg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type (
+	stanza struct {
+		query string
+		stmts []stmt
+		row   int
+	}
+
+	global struct {
+		def  expr // The default value, if any.
+		name string
+	}
+
+	stmt interface{}
+
+	// variable is either a local variable, or a scoped one (@capture.name).
+	variable struct {
+		capture string
+		name    string
+	}
+
+	nodeStmt struct{ v variable }
+	edgeStmt struct{ src, dst expr }
+
+	attrStmt struct {
+		node, sink expr // The sink is only set for edge attributes.
+		attrs      []attrDef
+	}
+
+	attrDef struct {
+		value expr
+		name  string
+	}
+
+	letStmt struct {
+		e       expr
+		v       variable
+		mutable bool
+	}
+
+	setStmt struct {
+		e expr
+		v variable
+	}
+
+	ifStmt struct{ arms []ifArm }
+
+	// ifArm is an if/elif arm, or the else arm (with no conditions).
+	ifArm struct {
+		conds []cond
+		body  []stmt
+	}
+
+	cond struct {
+		e    expr
+		kind string // "some", "none" or "" for a plain (boolean) expression.
+	}
+
+	forStmt struct {
+		e    expr
+		name string
+		body []stmt
+	}
+
+	printStmt struct{ args []expr }
+
+	expr interface{}
+
+	literal     struct{ v any }
+	captureExpr struct{ name string }
+	varExpr     struct{ name string }
+	listExpr    struct{ elems []expr }
+
+	scopedExpr struct {
+		node expr
+		name string
+	}
+
+	callExpr struct {
+		fn   string
+		args []expr
+	}
+)
+
+// parser parses the graph DSL. Queries are not parsed, just cut out of the
+// source, to be compiled by tree-sitter.
+type parser struct {
+	src      string
+	pos      int
+	stanzas  []stanza
+	globals  []global
+	tok      token
+	peeked   bool
+	tokStart int
+}
+
+type token struct {
+	text string
+	kind byte // One of the tok* constants.
+}
+
+const (
+	tokEOF     = 0
+	tokIdent   = 'i'
+	tokCapture = '@'
+	tokString  = '"'
+	tokNumber  = '0'
+	tokHash    = '#'
+	tokPunct   = 'p'
+)
+
+func parse(src string) (p *parser, err error) {
+	p = &parser{src: src}
+
+	for {
+		if p.peeked { // Rewind, as stanzas are not tokenized.
+			p.pos, p.peeked = p.tokStart, false
+		}
+
+		p.skipSpace()
+
+		if p.pos >= len(p.src) {
+			return
+		}
+
+		if strings.HasPrefix(p.src[p.pos:], "global") && p.isWordEnd(p.pos+len("global")) {
+			p.next()
+
+			var g global
+
+			if g, err = p.global(); err != nil {
+				return nil, err
+			}
+
+			p.globals = append(p.globals, g)
+
+			continue
+		}
+
+		var s stanza
+
+		if s, err = p.stanza(); err != nil {
+			return nil, err
+		}
+
+		p.stanzas = append(p.stanzas, s)
+	}
+}
+
+func (p *parser) global() (g global, err error) {
+	if g.name, err = p.ident(); err != nil {
+		return
+	}
+
+	if p.peek() == (token{kind: tokPunct, text: "="}) {
+		p.next()
+		g.def, err = p.expr()
+	}
+
+	return
+}
+
+func (p *parser) stanza() (s stanza, err error) {
+	start, inString := p.pos, false
+	s.row, p.tokStart = p.row(start), start
+
+	for ; p.pos < len(p.src); p.pos++ {
+		switch c := p.src[p.pos]; {
+		case inString && c == '\\':
+			p.pos++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == ';':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case c == '{':
+			s.query = strings.TrimSpace(p.src[start:p.pos])
+			if s.query == "" {
+				return s, p.errorf("expected a query")
+			}
+
+			p.pos++
+			s.stmts, err = p.block()
+
+			return
+		}
+	}
+
+	return s, p.errorf("expected '{' after the query")
+}
+
+// block parses statements up to (and including) the closing brace.
+func (p *parser) block() (stmts []stmt, err error) {
+	for {
+		var st stmt
+
+		switch tok := p.peek(); {
+		case tok.kind == tokEOF:
+			return nil, p.errorf("expected '}'")
+		case tok == token{kind: tokPunct, text: "}"}:
+			p.next()
+			return
+		default:
+			if st, err = p.stmt(); err != nil {
+				return
+			}
+
+			stmts = append(stmts, st)
+		}
+	}
+}
+
+func (p *parser) stmt() (_ stmt, err error) { //nolint:cyclop // ok
+	tok := p.next()
+	if tok.kind != tokIdent {
+		return nil, p.errorf("expected a statement, got %q", tok.text)
+	}
+
+	switch tok.text {
+	case "node":
+		st := nodeStmt{}
+		st.v, err = p.variable()
+
+		return st, err
+	case "edge":
+		return p.edge()
+	case "attr":
+		return p.attr()
+	case "let", "var":
+		return p.let(tok.text == "var")
+	case "set":
+		st := setStmt{}
+		if st.v, err = p.variable(); err != nil {
+			return
+		}
+
+		if err = p.expect("="); err != nil {
+			return
+		}
+
+		st.e, err = p.expr()
+
+		return st, err
+	case "if":
+		return p.ifStmt()
+	case "for":
+		return p.forStmt()
+	case "print":
+		st := printStmt{}
+		st.args, err = p.exprList()
+
+		return st, err
+	}
+
+	return nil, p.errorf("unknown statement %q", tok.text)
+}
+
+func (p *parser) edge() (st edgeStmt, err error) {
+	if st.src, err = p.expr(); err != nil {
+		return
+	}
+
+	if err = p.expect("->"); err != nil {
+		return
+	}
+
+	st.dst, err = p.expr()
+
+	return
+}
+
+func (p *parser) attr() (st attrStmt, err error) {
+	if err = p.expect("("); err != nil {
+		return
+	}
+
+	if st.node, err = p.expr(); err != nil {
+		return
+	}
+
+	if p.peek() == (token{kind: tokPunct, text: "->"}) {
+		p.next()
+
+		if st.sink, err = p.expr(); err != nil {
+			return
+		}
+	}
+
+	if err = p.expect(")"); err != nil {
+		return
+	}
+
+	for {
+		a := attrDef{}
+		if a.name, err = p.ident(); err != nil {
+			return
+		}
+
+		if p.peek() == (token{kind: tokPunct, text: "="}) {
+			p.next()
+
+			if a.value, err = p.expr(); err != nil {
+				return
+			}
+		} else {
+			a.value = literal{v: true}
+		}
+
+		st.attrs = append(st.attrs, a)
+
+		if p.peek() != (token{kind: tokPunct, text: ","}) {
+			return
+		}
+
+		p.next()
+	}
+}
+
+func (p *parser) let(mutable bool) (st letStmt, err error) {
+	st.mutable = mutable
+
+	if st.v, err = p.variable(); err != nil {
+		return
+	}
+
+	if mutable && st.v.capture != "" {
+		return st, p.errorf("scoped variables cannot be mutable")
+	}
+
+	if err = p.expect("="); err != nil {
+		return
+	}
+
+	st.e, err = p.expr()
+
+	return
+}
+
+func (p *parser) ifStmt() (st ifStmt, err error) {
+	for {
+		arm := ifArm{}
+
+		for {
+			c := cond{}
+			if tok := p.peek(); tok.kind == tokIdent && (tok.text == "some" || tok.text == "none") {
+				c.kind = p.next().text
+			}
+
+			if c.e, err = p.expr(); err != nil {
+				return
+			}
+
+			arm.conds = append(arm.conds, c)
+
+			if p.peek() != (token{kind: tokPunct, text: ","}) {
+				break
+			}
+
+			p.next()
+		}
+
+		if arm.body, err = p.body(); err != nil {
+			return
+		}
+
+		st.arms = append(st.arms, arm)
+
+		switch p.peek() {
+		case token{kind: tokIdent, text: "elif"}:
+			p.next()
+		case token{kind: tokIdent, text: "else"}:
+			p.next()
+
+			arm = ifArm{}
+			if arm.body, err = p.body(); err != nil {
+				return
+			}
+
+			st.arms = append(st.arms, arm)
+
+			return
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) forStmt() (st forStmt, err error) {
+	if st.name, err = p.ident(); err != nil {
+		return
+	}
+
+	if tok := p.next(); tok != (token{kind: tokIdent, text: "in"}) {
+		return st, p.errorf("expected 'in', got %q", tok.text)
+	}
+
+	if st.e, err = p.expr(); err != nil {
+		return
+	}
+
+	st.body, err = p.body()
+
+	return
+}
+
+func (p *parser) body() ([]stmt, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	return p.block()
+}
+
+func (p *parser) variable() (v variable, err error) {
+	switch tok := p.next(); tok.kind {
+	case tokIdent:
+		v.name = tok.text
+	case tokCapture:
+		v.capture = tok.text
+
+		if err = p.expect("."); err != nil {
+			return
+		}
+
+		v.name, err = p.ident()
+	default:
+		err = p.errorf("expected a variable, got %q", tok.text)
+	}
+
+	return
+}
+
+func (p *parser) exprList() (exprs []expr, err error) {
+	for {
+		var e expr
+
+		if e, err = p.expr(); err != nil {
+			return
+		}
+
+		exprs = append(exprs, e)
+
+		if p.peek() != (token{kind: tokPunct, text: ","}) {
+			return
+		}
+
+		p.next()
+	}
+}
+
+func (p *parser) expr() (e expr, err error) {
+	if e, err = p.primary(); err != nil {
+		return
+	}
+
+	for p.peek() == (token{kind: tokPunct, text: "."}) {
+		p.next()
+
+		var name string
+
+		if name, err = p.ident(); err != nil {
+			return
+		}
+
+		e = scopedExpr{node: e, name: name}
+	}
+
+	return
+}
+
+func (p *parser) primary() (expr, error) { //nolint:cyclop // ok
+	switch tok := p.next(); tok.kind {
+	case tokString:
+		s, err := strconv.Unquote(tok.text)
+		if err != nil {
+			return nil, p.errorf("invalid string %s", tok.text)
+		}
+
+		return literal{v: s}, nil
+	case tokNumber:
+		n, err := strconv.Atoi(tok.text)
+		if err != nil {
+			return nil, p.errorf("invalid number %s", tok.text)
+		}
+
+		return literal{v: n}, nil
+	case tokHash:
+		switch tok.text {
+		case "#true":
+			return literal{v: true}, nil
+		case "#false":
+			return literal{v: false}, nil
+		case "#null":
+			return literal{}, nil
+		}
+
+		return nil, p.errorf("unknown literal %s", tok.text)
+	case tokCapture:
+		return captureExpr{name: tok.text}, nil
+	case tokIdent:
+		return varExpr{name: tok.text}, nil
+	case tokPunct:
+		switch tok.text {
+		case "(":
+			return p.call()
+		case "[":
+			return p.list()
+		}
+	}
+
+	return nil, p.errorf("expected an expression")
+}
+
+func (p *parser) call() (e callExpr, err error) {
+	if e.fn, err = p.ident(); err != nil {
+		return
+	}
+
+	for p.peek() != (token{kind: tokPunct, text: ")"}) {
+		var arg expr
+
+		if arg, err = p.expr(); err != nil {
+			return
+		}
+
+		e.args = append(e.args, arg)
+	}
+
+	p.next()
+
+	return
+}
+
+func (p *parser) list() (e listExpr, err error) {
+	if p.peek() == (token{kind: tokPunct, text: "]"}) {
+		p.next()
+		return
+	}
+
+	if e.elems, err = p.exprList(); err != nil {
+		return
+	}
+
+	err = p.expect("]")
+
+	return
+}
+
+func (p *parser) ident() (string, error) {
+	tok := p.next()
+	if tok.kind != tokIdent {
+		return "", p.errorf("expected an identifier, got %q", tok.text)
+	}
+
+	return tok.text, nil
+}
+
+func (p *parser) expect(punct string) error {
+	if tok := p.next(); tok != (token{kind: tokPunct, text: punct}) {
+		return p.errorf("expected %q, got %q", punct, tok.text)
+	}
+
+	return nil
+}
+
+func (p *parser) peek() token {
+	if !p.peeked {
+		p.tok, p.peeked = p.lex(), true
+	}
+
+	return p.tok
+}
+
+func (p *parser) next() token {
+	tok := p.peek()
+	p.peeked = false
+
+	return tok
+}
+
+// lex returns the next token. Errors are returned as punctuation tokens with
+// the offending text, for the parser to report.
+func (p *parser) lex() (tok token) { //nolint:cyclop // ok
+	p.skipSpace()
+	p.tokStart = p.pos
+
+	if p.pos >= len(p.src) {
+		return token{kind: tokEOF, text: "end of file"}
+	}
+
+	start, c := p.pos, p.src[p.pos]
+
+	switch {
+	case c == '"':
+		for p.pos++; p.pos < len(p.src) && p.src[p.pos] != '"'; p.pos++ {
+			if p.src[p.pos] == '\\' {
+				p.pos++
+			}
+		}
+
+		p.pos = min(p.pos+1, len(p.src))
+
+		return token{kind: tokString, text: p.src[start:p.pos]}
+	case c == '@':
+		p.pos++
+		p.skipIdent()
+
+		return token{kind: tokCapture, text: p.src[start+1 : p.pos]}
+	case c == '#':
+		p.pos++
+		p.skipIdent()
+
+		return token{kind: tokHash, text: p.src[start:p.pos]}
+	case c >= '0' && c <= '9':
+		for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+			p.pos++
+		}
+
+		return token{kind: tokNumber, text: p.src[start:p.pos]}
+	case strings.HasPrefix(p.src[p.pos:], "->"):
+		p.pos += 2
+
+		return token{kind: tokPunct, text: "->"}
+	case isIdent(rune(c)):
+		p.skipIdent()
+
+		return token{kind: tokIdent, text: p.src[start:p.pos]}
+	}
+
+	p.pos++
+
+	return token{kind: tokPunct, text: p.src[start:p.pos]}
+}
+
+func (p *parser) skipIdent() {
+	for p.pos < len(p.src) && isIdent(rune(p.src[p.pos])) && !strings.HasPrefix(p.src[p.pos:], "->") {
+		p.pos++
+	}
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == ';':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case unicode.IsSpace(rune(c)):
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) isWordEnd(i int) bool {
+	return i >= len(p.src) || !isIdent(rune(p.src[i]))
+}
+
+func (p *parser) row(pos int) int {
+	return strings.Count(p.src[:pos], "\n") + 1
+}
+
+// errorf reports an error at the last token read.
+func (p *parser) errorf(format string, args ...any) error {
+	pos := min(p.tokStart, p.pos)
+	col := pos - strings.LastIndexByte(p.src[:pos], '\n')
+
+	return fmt.Errorf("%w: %d:%d: %s", ErrSyntax, p.row(pos), col, fmt.Sprintf(format, args...))
+}
+
+func isIdent(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune("_-?!<>*/+%", c)
+}
@@ -0,0 +1,80 @@
+package tsg
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSyntax(t *testing.T) {
+	t.Parallel()
+
+	src := `global FILE
+global LANG = "go" ; with a default
+
+; A stanza.
+((identifier) @id (#eq? @id "{")) @x {
+  node @x.def
+  edge @x.def -> n
+  attr (@x.def -> n) precedence = 1, exported
+  let @x.name = (source-text @id)
+  var i = [1, #true, #null]
+}`
+
+	p, err := parse(src)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	expGlobals := []global{{name: "FILE"}, {name: "LANG", def: literal{v: "go"}}}
+	if !reflect.DeepEqual(p.globals, expGlobals) {
+		t.Fatalf("Expected %v, got %v", expGlobals, p.globals)
+	}
+
+	expStanzas := []stanza{{
+		query: `((identifier) @id (#eq? @id "{")) @x`,
+		row:   5,
+		stmts: []stmt{
+			nodeStmt{v: variable{capture: "x", name: "def"}},
+			edgeStmt{src: scopedExpr{node: captureExpr{name: "x"}, name: "def"}, dst: varExpr{name: "n"}},
+			attrStmt{
+				node: scopedExpr{node: captureExpr{name: "x"}, name: "def"}, sink: varExpr{name: "n"},
+				attrs: []attrDef{{name: "precedence", value: literal{v: 1}}, {name: "exported", value: literal{v: true}}},
+			},
+			letStmt{v: variable{capture: "x", name: "name"}, e: callExpr{fn: "source-text", args: []expr{captureExpr{name: "id"}}}},
+			letStmt{v: variable{name: "i"}, e: listExpr{elems: []expr{literal{v: 1}, literal{v: true}, literal{}}}, mutable: true},
+		},
+	}}
+	if !reflect.DeepEqual(p.stanzas, expStanzas) {
+		t.Fatalf("Expected\n%#v\ngot\n%#v", expStanzas, p.stanzas)
+	}
+}
+
+func TestParseSyntaxErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct{ src, exp string }{
+		{`(x) @x`, "1:1: expected '{' after the query"},
+		{"(x) @x {\n  nope\n}", "2:3: unknown statement \"nope\""},
+		{`(x) @x { edge a b }`, `1:17: expected "->", got "b"`},
+		{`(x) @x { node a`, "1:16: expected '}'"},
+		{`(x) @x { var @x.a = 1 }`, "1:17: scoped variables cannot be mutable"},
+		{`(x) @x { let a = }`, "1:18: expected an expression"},
+		{`(x) @x { let a = #nope }`, "1:18: unknown literal #nope"},
+		{`(x) @x { for a of b {} }`, `1:16: expected 'in', got "of"`},
+		{`{}`, "1:1: expected a query"},
+	}
+
+	for _, tc := range testCases {
+		_, err := parse(tc.src)
+		if !errors.Is(err, ErrSyntax) || !strings.HasSuffix(err.Error(), tc.exp) {
+			t.Fatalf("Expected %q for %s, got %v", tc.exp, tc.src, err)
+		}
+	}
+}
+
+func TestIsIdent(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
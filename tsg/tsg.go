@@ -0,0 +1,273 @@
+// Package tsg implements an execution engine for tree-sitter-graph style
+// (.tsg) files, which declare how to build a graph (e.g. the name binding
+// graph of a stack graphs analysis) out of the matches of tree-sitter queries.
+//
+// A file is a list of stanzas, each made of a query and of the statements to
+// run for each of its matches:
+//
+//	global FILE
+//
+//	(sum left: (_) @left right: (_) @right) @sum {
+//		node @sum.def
+//		attr (@sum.def) kind = "sum", text = (source-text @sum), file = FILE
+//		edge @sum.def -> @left.def
+//		edge @sum.def -> @right.def
+//	}
+//
+//	(number) @n {
+//		node @n.def
+//		attr (@n.def) kind = "number"
+//	}
+//
+// The supported statements are node, edge, attr (on nodes and on edges, as in
+// attr (@a.def -> @b.def) precedence = 1), let, var, set, if/elif/else (with
+// some and none conditions on the captures), for and print. The expressions
+// are strings, numbers, #true, #false, #null, lists ([a, b]), captures,
+// local, global and scoped (@capture.name) variables and function calls, see
+// [Builtins].
+//
+// As in tree-sitter-graph, the (immutable) variables are evaluated lazily, so
+// stanzas can refer to the scoped variables defined by other stanzas, in any
+// order; edges and attributes are only applied once all the stanzas ran.
+// Conditions, loops and mutable variables are evaluated eagerly though. The
+// scan statement is not supported.
+package tsg
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+)
+
+// File is a parsed graph DSL file, see [Parse].
+type File struct {
+	lang      *sitter.Language
+	functions map[string]Func
+	stanzas   []stanza
+	queries   []*sitter.Query
+	globals   []global
+}
+
+// Option configures a [File] created with [Parse].
+type Option func(*File)
+
+// Func is a function callable from the graph DSL. It gets the source text of
+// the tree the file is executed on and its (evaluated) arguments, see
+// [Builtins] for the possible values.
+type Func func(src []byte, args []any) (any, error)
+
+// Graph is the graph built by [File.Execute].
+type Graph struct {
+	Nodes []*GraphNode
+	// Output holds the output of the print statements.
+	Output []string
+}
+
+// GraphNode is a node of a [Graph].
+type GraphNode struct {
+	Attrs map[string]any
+	Edges []*Edge
+	ID    int
+}
+
+// Edge is an edge of a [Graph], from the [GraphNode] holding it to Sink.
+type Edge struct {
+	Attrs map[string]any
+	Sink  *GraphNode
+}
+
+// Possible errors.
+var (
+	ErrSyntax          = errors.New("syntax error")
+	ErrUndefined       = errors.New("undefined")
+	ErrDuplicate       = errors.New("duplicate definition")
+	ErrType            = errors.New("type error")
+	ErrCycle           = errors.New("circular variable definition")
+	ErrUnknownFunction = errors.New("unknown function")
+	ErrMissingGlobal   = errors.New("missing global variable")
+)
+
+// Parse parses a graph DSL file, compiling its queries for lang.
+func Parse(lang *sitter.Language, src []byte, opts ...Option) (f *File, err error) {
+	p, err := parse(string(src))
+	if err != nil {
+		return
+	}
+
+	f = &File{lang: lang, stanzas: p.stanzas, globals: p.globals, functions: Builtins()}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	for _, s := range f.stanzas {
+		var q *sitter.Query
+
+		if q, err = sitter.NewQuery(lang, []byte(s.query)); err != nil {
+			return nil, fmt.Errorf("stanza at line %d: %w", s.row, err)
+		}
+
+		f.queries = append(f.queries, q)
+	}
+
+	return
+}
+
+// WithFunctions adds the given functions to the ones callable from the file,
+// on top of the [Builtins], which can be overridden by name.
+func WithFunctions(fns map[string]Func) Option {
+	return func(f *File) {
+		maps.Copy(f.functions, fns)
+	}
+}
+
+// Execute builds the graph of the tree rooted at root, whose source text is
+// src. All the globals declared by the file (without a default value) must be
+// given.
+func (f *File) Execute(root sitter.Node, src []byte, globals map[string]any) (g *Graph, err error) {
+	x := &executor{
+		file: f, graph: &Graph{}, src: src,
+		globals: map[string]any{}, scoped: map[scopedKey]*thunk{},
+	}
+
+	for _, gl := range f.globals {
+		v, ok := globals[gl.name]
+		if !ok && gl.def == nil {
+			return nil, fmt.Errorf("%w: %s", ErrMissingGlobal, gl.name)
+		}
+
+		if !ok {
+			if v, err = x.eval(gl.def, newEnv(nil, nil)); err != nil {
+				return
+			}
+		}
+
+		x.globals[gl.name] = v
+	}
+
+	qc := sitter.NewQueryCursor()
+
+	for i, s := range f.stanzas {
+		q := f.queries[i]
+
+		matches := qc.Matches(q, root, src)
+		for m := matches.Next(); m != nil; m = matches.Next() {
+			if err = x.run(s.stmts, newEnv(nil, captures(q, m))); err != nil {
+				return nil, fmt.Errorf("stanza at line %d: %w", s.row, err)
+			}
+		}
+	}
+
+	// Edges go first, as edge attributes need them.
+	slices.SortStableFunc(x.deferred, func(a, b deferred) int {
+		_, aIsEdge := a.st.(edgeStmt)
+		_, bIsEdge := b.st.(edgeStmt)
+
+		switch {
+		case aIsEdge == bIsEdge:
+			return 0
+		case aIsEdge:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	for _, d := range x.deferred {
+		if err = x.apply(d); err != nil {
+			return
+		}
+	}
+
+	return x.graph, nil
+}
+
+// String returns the graph in the tree-sitter-graph text format, with the
+// attributes sorted by name.
+func (g *Graph) String() string {
+	sb := &strings.Builder{}
+
+	writeAttrs := func(attrs map[string]any) {
+		for _, k := range slices.Sorted(maps.Keys(attrs)) {
+			fmt.Fprintf(sb, "  %s: %s\n", k, Format(attrs[k]))
+		}
+	}
+
+	for _, n := range g.Nodes {
+		fmt.Fprintf(sb, "node %d\n", n.ID)
+		writeAttrs(n.Attrs)
+
+		for _, e := range n.Edges {
+			fmt.Fprintf(sb, "edge %d -> %d\n", n.ID, e.Sink.ID)
+			writeAttrs(e.Attrs)
+		}
+	}
+
+	return sb.String()
+}
+
+// Format formats a value as in the graph's text format: strings are quoted,
+// booleans and null are #true, #false and #null, syntax nodes are shown with
+// their type and (one based) start position, graph nodes by their ID.
+func Format(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "#null"
+	case bool:
+		if v {
+			return "#true"
+		}
+
+		return "#false"
+	case string:
+		return strconv.Quote(v)
+	case sitter.Node:
+		p := v.StartPoint()
+		return fmt.Sprintf("[syntax node %s (%d, %d)]", v.Type(), p.Row+1, p.Column+1)
+	case *GraphNode:
+		return fmt.Sprintf("[graph node %d]", v.ID)
+	case []any:
+		elems := make([]string, 0, len(v))
+		for _, e := range v {
+			elems = append(elems, Format(e))
+		}
+
+		return "[" + strings.Join(elems, ", ") + "]"
+	}
+
+	return fmt.Sprint(v)
+}
+
+// captures returns the match's captures by name: a list for the quantified
+// (* or +) captures, a node or nil (if the capture is optional) otherwise.
+func captures(q *sitter.Query, m *sitter.QueryMatch) map[string]any {
+	names, quantifiers := q.CaptureNames(), q.CaptureQuantifiers(m.PatternIndex)
+	caps := map[string]any{}
+
+	for i, name := range names {
+		var nodes []any
+
+		for _, c := range m.Captures {
+			if int(c.Index) == i {
+				nodes = append(nodes, c.Node)
+			}
+		}
+
+		switch quantifiers[i] {
+		case sitter.CaptureQuantifierZero:
+		case sitter.CaptureQuantifierZeroOrMore, sitter.CaptureQuantifierOneOrMore:
+			caps[name] = nodes
+		default:
+			caps[name] = nil
+			if len(nodes) > 0 {
+				caps[name] = nodes[0]
+			}
+		}
+	}
+
+	return caps
+}
@@ -0,0 +1,194 @@
+package tsg
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	sitter "github.com/alexaandru/go-tree-sitter-bare"
+	"github.com/alexaandru/go-tree-sitter-bare/sittertest"
+)
+
+//nolint:gochecknoglobals // ok
+var gr = sittertest.Grammar()
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestFileExecute()")
+}
+
+func TestWithFunctions(t *testing.T) {
+	t.Parallel()
+
+	f := &File{functions: Builtins()}
+	WithFunctions(map[string]Func{"one": func([]byte, []any) (any, error) { return 1, nil }})(f)
+
+	if f.functions["one"] == nil || f.functions["plus"] == nil {
+		t.Fatal("Expected both the builtin and the added functions")
+	}
+}
+
+func TestFileExecute(t *testing.T) {
+	t.Parallel()
+
+	src := `
+global FILE
+global LANG = "calc"
+
+; Sums point to their operands.
+(sum left: (expression (_) @left) right: (expression (_) @right)) @sum {
+  node @sum.def
+  attr (@sum.def) kind = "sum", text = (source-text @sum), file = FILE, lang = LANG
+  edge @sum.def -> @left.def
+  edge @sum.def -> @right.def
+  attr (@sum.def -> @right.def) side = "right"
+
+  var count = 0
+  for x in [@left, @right] {
+    set count = (plus count 1)
+  }
+
+  print (format "{} operands for {}" count (node-type @sum)), " at ", (start-column @sum)
+}
+
+(number) @n {
+  node @n.def
+  let @n.value = (source-text @n)
+  attr (@n.def) kind = "number", value = @n.value
+
+  if (eq @n.value "1") {
+    attr (@n.def) first
+  } elif (eq @n.value "2") {
+    attr (@n.def) second
+  } else {
+    attr (@n.def) rest
+  }
+}
+`
+	f, err := Parse(gr, []byte(src))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	input := []byte("1 + 2 + 3")
+
+	root, err := sitter.Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	g, err := f.Execute(root, input, map[string]any{"FILE": "a.calc"})
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := `node 0
+  file: "a.calc"
+  kind: "sum"
+  lang: "calc"
+  text: "1 + 2"
+edge 0 -> 2
+edge 0 -> 3
+  side: "right"
+node 1
+  file: "a.calc"
+  kind: "sum"
+  lang: "calc"
+  text: "1 + 2 + 3"
+edge 1 -> 0
+edge 1 -> 4
+  side: "right"
+node 2
+  first: #true
+  kind: "number"
+  value: "1"
+node 3
+  kind: "number"
+  second: #true
+  value: "2"
+node 4
+  kind: "number"
+  rest: #true
+  value: "3"
+`
+	if act := g.String(); act != exp {
+		t.Fatalf("Expected\n%s\ngot\n%s", exp, act)
+	}
+
+	if act, exp := strings.Join(g.Output, "|"), "2 operands for sum at 0|2 operands for sum at 0"; act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	if _, err = f.Execute(root, input, nil); !errors.Is(err, ErrMissingGlobal) {
+		t.Fatalf("Expected %v, got %v", ErrMissingGlobal, err)
+	}
+}
+
+func TestFileExecuteErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		src string
+		exp error
+	}{
+		{`(number) @n { node @n.def node @n.def }`, ErrDuplicate},
+		{`(number) @n { node x attr (x) a = 1, a = 2 }`, ErrDuplicate},
+		{`(number) @n { node x edge x -> @n.nope }`, ErrUndefined},
+		{`(number) @n { let @n.a = @n.b let @n.b = @n.a print @n.a }`, ErrCycle},
+		{`(number) @n { print (nope @n) }`, ErrUnknownFunction},
+		{`(number) @n { node x attr (x -> x) a = 1 }`, ErrUndefined},
+		{`(number) @n { edge @n -> @n }`, ErrType},
+		{`(number) @n { if @n { } }`, ErrType},
+		{`(number) @n { print @m }`, ErrUndefined},
+	}
+
+	input := []byte("1")
+
+	root, err := sitter.Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for _, tc := range testCases {
+		f, err := Parse(gr, []byte(tc.src))
+		if err != nil {
+			t.Fatal("Expected no error, got", err)
+		}
+
+		if _, err = f.Execute(root, input, nil); !errors.Is(err, tc.exp) {
+			t.Fatalf("Expected %v for %s, got %v", tc.exp, tc.src, err)
+		}
+	}
+
+	if _, err = Parse(gr, []byte("\n(nope) @n {}")); err == nil ||
+		!strings.HasPrefix(err.Error(), "stanza at line 2: ") {
+		t.Fatal("Expected a query error, got", err)
+	}
+}
+
+func TestGraphString(t *testing.T) {
+	t.Parallel()
+
+	n0, n1 := &GraphNode{ID: 0, Attrs: map[string]any{"b": 1, "a": "x"}}, &GraphNode{ID: 1}
+	n0.Edges = []*Edge{{Sink: n1, Attrs: map[string]any{"p": false}}}
+
+	exp := "node 0\n  a: \"x\"\n  b: 1\nedge 0 -> 1\n  p: #false\nnode 1\n"
+	if act := (&Graph{Nodes: []*GraphNode{n0, n1}}).String(); act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	exp := `[#null, #true, "a\"b", 42, [graph node 3]]`
+	if act := Format([]any{nil, true, `a"b`, 42, &GraphNode{ID: 3}}); act != exp {
+		t.Fatalf("Expected %s, got %s", exp, act)
+	}
+}
+
+func TestCaptures(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly via TestFileExecute()")
+}
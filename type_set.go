@@ -0,0 +1,51 @@
+package sitter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TypeSet is a set of node types, stored as a bitset over the language's
+// symbols, for cheap "is this node one of these kinds" checks inside walkers,
+// without compiling a query.
+type TypeSet struct {
+	bits []uint64
+}
+
+// ErrUnknownType is returned by [NewTypeSet] for node types unknown to the language.
+var ErrUnknownType = errors.New("unknown node type")
+
+// NewTypeSet returns the set of all the language's symbols having one of the
+// given names (which includes aliases and anonymous nodes, i.e. "+").
+func NewTypeSet(lang *Language, names ...string) (s TypeSet, err error) {
+	symbols := lang.Symbols()
+	s.bits = make([]uint64, (len(symbols)+63)/64) //nolint:mnd // ok
+
+	for _, name := range names {
+		found := false
+
+		for i, sym := range symbols {
+			if sym.Name == name {
+				s.bits[i/64] |= 1 << (i % 64)
+				found = true
+			}
+		}
+
+		if !found {
+			return TypeSet{}, fmt.Errorf("%w: %q", ErrUnknownType, name)
+		}
+	}
+
+	return
+}
+
+// Contains checks if the node's type is in the set.
+func (s TypeSet) Contains(n Node) bool {
+	return s.ContainsSymbol(n.Symbol())
+}
+
+// ContainsSymbol checks if the symbol is in the set.
+func (s TypeSet) ContainsSymbol(sym Symbol) bool {
+	i := int(sym)
+	return i/64 < len(s.bits) && s.bits[i/64]&(1<<(i%64)) != 0
+}
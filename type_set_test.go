@@ -0,0 +1,59 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNewTypeSet(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewTypeSet(gr, "number", "nope"); !errors.Is(err, ErrUnknownType) {
+		t.Fatalf("Expected %v, got %v", ErrUnknownType, err)
+	}
+}
+
+func TestTypeSetContains(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("1 + (2 + 3) // c")
+
+	root, err := Parse(context.Background(), input, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	s, err := NewTypeSet(gr, "number", "+", "comment")
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	act := []string{}
+
+	_ = NewIterator(root).ForEach(func(n Node) error { //nolint:errcheck // ok
+		if s.Contains(n) {
+			act = append(act, n.Content(input))
+		}
+
+		return nil
+	})
+
+	if exp := []string{"1", "+", "2", "+", "3", "// c"}; !slices.Equal(act, exp) {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestTypeSetContainsSymbol(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewTypeSet(gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if s.ContainsSymbol(gr.MustSymbol("number")) || s.ContainsSymbol(Symbol(maxUint16)) {
+		t.Fatal("Expected empty set to contain nothing")
+	}
+}
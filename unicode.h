@@ -12,34 +12,59 @@ extern "C" {
 #define U_EXPORT2
 #include "utf8.h"
 #include "utf16.h"
+#include "portable_endian.h"
+
+#define U16_NEXT_LE(s, i, length, c) UPRV_BLOCK_MACRO_BEGIN { \
+    (c)=le16toh((s)[(i)++]); \
+    if(U16_IS_LEAD(c)) { \
+        uint16_t __c2; \
+        if((i)!=(length) && U16_IS_TRAIL(__c2=(s)[(i)])) { \
+            ++(i); \
+            (c)=U16_GET_SUPPLEMENTARY((c), __c2); \
+        } \
+    } \
+} UPRV_BLOCK_MACRO_END
+
+#define U16_NEXT_BE(s, i, length, c) UPRV_BLOCK_MACRO_BEGIN { \
+    (c)=be16toh((s)[(i)++]); \
+    if(U16_IS_LEAD(c)) { \
+        uint16_t __c2; \
+        if((i)!=(length) && U16_IS_TRAIL(__c2=(s)[(i)])) { \
+            ++(i); \
+            (c)=U16_GET_SUPPLEMENTARY((c), __c2); \
+        } \
+    } \
+} UPRV_BLOCK_MACRO_END
 
 static const int32_t TS_DECODE_ERROR = U_SENTINEL;
 
-// These functions read one unicode code point from the given string,
-// returning the number of bytes consumed.
-typedef uint32_t (*UnicodeDecodeFunction)(
+static inline uint32_t ts_decode_utf8(
   const uint8_t *string,
   uint32_t length,
   int32_t *code_point
-);
+) {
+  uint32_t i = 0;
+  U8_NEXT(string, i, length, *code_point);
+  return i;
+}
 
-static inline uint32_t ts_decode_utf8(
+static inline uint32_t ts_decode_utf16_le(
   const uint8_t *string,
   uint32_t length,
   int32_t *code_point
 ) {
   uint32_t i = 0;
-  U8_NEXT(string, i, length, *code_point);
-  return i;
+  U16_NEXT_LE(((uint16_t *)string), i, length, *code_point);
+  return i * 2;
 }
 
-static inline uint32_t ts_decode_utf16(
+static inline uint32_t ts_decode_utf16_be(
   const uint8_t *string,
   uint32_t length,
   int32_t *code_point
 ) {
   uint32_t i = 0;
-  U16_NEXT(((uint16_t *)string), i, length, *code_point);
+  U16_NEXT_BE(((uint16_t *)string), i, length, *code_point);
   return i * 2;
 }
 
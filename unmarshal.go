@@ -0,0 +1,223 @@
+package sitter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrUnmarshal is returned when a node cannot be unmarshaled (see [Unmarshal]).
+var ErrUnmarshal = errors.New("cannot unmarshal node")
+
+// nodeSelector selects the nodes a struct field is unmarshaled from, as told
+// by its "ts" tag.
+type nodeSelector struct {
+	field    string
+	typ      string
+	self     bool
+	children bool
+}
+
+//nolint:gochecknoglobals // ok
+var (
+	nodeType  = reflect.TypeFor[Node]()
+	rangeType = reflect.TypeFor[Range]()
+)
+
+// Unmarshal maps the node (whose source text is src) into v, which must be a
+// non-nil pointer, turning the concrete syntax tree into a typed one:
+//
+//   - a string (or []byte) gets the node's text, and so do the numbers and
+//     booleans, parsed (the integers with their base prefix, if any, see
+//     [strconv.ParseInt]);
+//   - a [Node] gets the node itself and a [Range], its range;
+//   - a pointer is allocated, then the value it points to is unmarshaled;
+//   - a struct gets the fields with a "ts" tag unmarshaled from the node's
+//     children it selects (the first one, or all of them, for a slice), the
+//     fields left alone when none is selected.
+//
+// The "ts" tag is a comma separated list of:
+//
+//   - field=name: the children with the given field name;
+//   - type=type: the children of the given type (e.g. "identifier" or "+"),
+//     among the ones with the field name, if also given;
+//   - children: the named children;
+//   - self: the node itself (e.g. for its text or range).
+//
+// For instance:
+//
+//	type Binary struct {
+//		Left     Expr   `ts:"field=left"`
+//		Operator string `ts:"field=operator"`
+//		Right    Expr   `ts:"field=right"`
+//		Text     string `ts:"self"`
+//	}
+func Unmarshal(n Node, src []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("%w: expected a non-nil pointer, got %T", ErrUnmarshal, v)
+	}
+
+	return unmarshalValue(n, src, rv.Elem())
+}
+
+func unmarshalValue(n Node, src []byte, v reflect.Value) (err error) {
+	switch t := v.Type(); t {
+	case nodeType:
+		v.Set(reflect.ValueOf(n))
+		return
+	case rangeType:
+		v.Set(reflect.ValueOf(n.Range()))
+		return
+	}
+
+	text := func() string { return n.Content(src) }
+
+	switch v.Kind() { //nolint:exhaustive // ok
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		return unmarshalValue(n, src, v.Elem())
+	case reflect.Struct:
+		return unmarshalStruct(n, src, v)
+	case reflect.String:
+		v.SetString(text())
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("%w: unsupported type %s", ErrUnmarshal, v.Type())
+		}
+
+		v.SetBytes([]byte(text()))
+	case reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(text()); err == nil {
+			v.SetBool(b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if i, err = strconv.ParseInt(text(), 0, v.Type().Bits()); err == nil {
+			v.SetInt(i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		if u, err = strconv.ParseUint(text(), 0, v.Type().Bits()); err == nil {
+			v.SetUint(u)
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if f, err = strconv.ParseFloat(text(), v.Type().Bits()); err == nil {
+			v.SetFloat(f)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported type %s", ErrUnmarshal, v.Type())
+	}
+
+	if err != nil {
+		p := n.StartPoint()
+		err = fmt.Errorf("%w: %s at %d:%d: %w", ErrUnmarshal, n.Type(), p.Row+1, p.Column+1, err)
+	}
+
+	return
+}
+
+func unmarshalStruct(n Node, src []byte, v reflect.Value) error {
+	t := v.Type()
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+
+		tag, ok := f.Tag.Lookup("ts")
+		if !ok || tag == "-" || !f.IsExported() {
+			continue
+		}
+
+		sel, err := parseNodeSelector(tag)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+		}
+
+		if err = sel.unmarshal(n, src, v.Field(i)); err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func parseNodeSelector(tag string) (sel nodeSelector, err error) {
+	for _, opt := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(opt, "=")
+
+		switch key {
+		case "field":
+			sel.field = value
+		case "type":
+			sel.typ = value
+		case "children":
+			sel.children = true
+		case "self":
+			sel.self = true
+		default:
+			return sel, fmt.Errorf("%w: invalid tag option %q", ErrUnmarshal, opt)
+		}
+	}
+
+	if sel.self && (sel.field != "" || sel.typ != "" || sel.children) {
+		return sel, fmt.Errorf("%w: self cannot be combined with other options in tag %q", ErrUnmarshal, tag)
+	}
+
+	return
+}
+
+// unmarshal unmarshals the selected children of the node into v (all of them,
+// for slices other than []byte, or the first one).
+func (sel nodeSelector) unmarshal(n Node, src []byte, v reflect.Value) error {
+	if sel.self {
+		return unmarshalValue(n, src, v)
+	}
+
+	nodes := sel.nodes(n)
+
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8 {
+		if len(nodes) == 0 {
+			return nil
+		}
+
+		return unmarshalValue(nodes[0], src, v)
+	}
+
+	s := reflect.MakeSlice(v.Type(), len(nodes), len(nodes))
+	for i, c := range nodes {
+		if err := unmarshalValue(c, src, s.Index(i)); err != nil {
+			return err
+		}
+	}
+
+	v.Set(s)
+
+	return nil
+}
+
+// nodes returns the children of the node selected.
+func (sel nodeSelector) nodes(n Node) (out []Node) {
+	children := n.Children()
+
+	switch {
+	case sel.field != "":
+		children = n.ChildrenByFieldName(sel.field)
+	case sel.children || sel.typ == "":
+		children = n.NamedChildren()
+	}
+
+	for c := range children {
+		if sel.typ == "" || c.Type() == sel.typ {
+			out = append(out, c)
+		}
+	}
+
+	return
+}
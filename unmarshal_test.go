@@ -0,0 +1,115 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type calcExpr struct {
+	Number *int      `ts:"type=number"`
+	Sum    *calcSum  `ts:"type=sum"`
+	Inner  *calcExpr `ts:"type=expression"`
+	Text   []byte    `ts:"self"`
+}
+
+type calcSum struct {
+	Left     calcExpr   `ts:"field=left"`
+	Operator string     `ts:"type=+"`
+	Right    *calcExpr  `ts:"field=right"`
+	Operands []calcExpr `ts:"children"`
+	Range    Range      `ts:"self"`
+	Node     Node       `ts:"self"`
+	ignored  string     `ts:"self"` //nolint:unused // ok
+	Skipped  string     `ts:"-"`
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	src := []byte("1 + (2)")
+
+	root, err := Parse(context.Background(), src, gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	var act calcExpr
+	if err = Unmarshal(root, src, &act); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	one, two := 1, 2
+	left := calcExpr{Number: &one, Text: []byte("1")}
+	right := calcExpr{Inner: &calcExpr{Number: &two, Text: []byte("2")}, Text: []byte("(2)")}
+	sum := root.NamedChild(0)
+	exp := calcExpr{
+		Sum: &calcSum{
+			Left: left, Operator: "+", Right: &right, Operands: []calcExpr{left, right},
+			Range: sum.Range(), Node: sum,
+		},
+		Text: src,
+	}
+
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+
+	testCases := []struct {
+		v   any
+		exp string
+	}{
+		{act, "cannot unmarshal node: expected a non-nil pointer, got sitter.calcExpr"},
+		{(*calcExpr)(nil), "cannot unmarshal node: expected a non-nil pointer, got *sitter.calcExpr"},
+		{&struct {
+			Sum struct {
+				Op int `ts:"type=+"`
+			} `ts:"type=sum"`
+		}{}, `.Sum: .Op: cannot unmarshal node: + at 1:3: strconv.ParseInt: parsing "+": invalid syntax`},
+		{&struct {
+			X string `ts:"foo"`
+		}{}, `.X: cannot unmarshal node: invalid tag option "foo"`},
+		{&struct {
+			X string `ts:"self,type=sum"`
+		}{}, `.X: cannot unmarshal node: self cannot be combined with other options in tag "self,type=sum"`},
+		{&struct {
+			X []int `ts:"self"`
+		}{}, `.X: cannot unmarshal node: unsupported type []int`},
+		{&struct {
+			X map[string]int `ts:"self"`
+		}{}, `.X: cannot unmarshal node: unsupported type map[string]int`},
+	}
+
+	for _, tc := range testCases {
+		err := Unmarshal(root, src, tc.v)
+		if !errors.Is(err, ErrUnmarshal) || err.Error() != tc.exp {
+			t.Fatalf("Expected %q, got %v", tc.exp, err)
+		}
+	}
+}
+
+func TestUnmarshalValue(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestUnmarshalStruct(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestParseNodeSelector(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeSelectorUnmarshal(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNodeSelectorNodes(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
@@ -0,0 +1,87 @@
+package sitter
+
+import (
+	"context"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// UTF16Column converts a byte column (offset) of the line to a UTF-16 one,
+// i.e. to the number of UTF-16 code units the text before it encodes to, as
+// used by the LSP positions. Invalid UTF-8 bytes count as one code unit each
+// (that of U+FFFD) and columns past the end of the line are clamped to it.
+func UTF16Column(line []byte, col uint) (col16 uint) {
+	line = line[:min(col, uint(len(line)))]
+
+	for len(line) > 0 {
+		r, size := utf8.DecodeRune(line)
+		col16 += uint(utf16.RuneLen(r))
+		line = line[size:]
+	}
+
+	return
+}
+
+// ByteColumn is the reverse of [UTF16Column], converting a UTF-16 column of
+// the line to a byte one. A column falling in the middle of a surrogate pair
+// is rounded down to the start of its character.
+func ByteColumn(line []byte, col16 uint) (col uint) {
+	for col < uint(len(line)) {
+		r, size := utf8.DecodeRune(line[col:])
+
+		n := uint(utf16.RuneLen(r))
+		if n > col16 {
+			break
+		}
+
+		col16 -= n
+		col += uint(size)
+	}
+
+	return
+}
+
+// ContentUTF16 is like [Node.Content], for the trees parsed from UTF-16 text
+// (see [InputEncodingUTF16]), whose byte offsets are twice the code unit ones.
+func (n Node) ContentUTF16(src []uint16) string {
+	return string(utf16.Decode(src[n.StartByte()/2 : n.EndByte()/2]))
+}
+
+// UTF16Point converts a position of the document to its UTF-16 counterpart,
+// i.e. with its column counted in UTF-16 code units, as in LSP. The position
+// must be within the document, as with [Document.Offset].
+func (d *Document) UTF16Point(p Point) (Point, error) {
+	line, err := d.line(p)
+	if err != nil {
+		return Point{}, err
+	}
+
+	return Point{Row: p.Row, Column: UTF16Column(line, p.Column)}, nil
+}
+
+// PointFromUTF16 is the reverse of [Document.UTF16Point]. Columns past the
+// end of the row are clamped to it, as LSP requires.
+func (d *Document) PointFromUTF16(p Point) (Point, error) {
+	line, err := d.line(Point{Row: p.Row})
+	if err != nil {
+		return Point{}, err
+	}
+
+	return Point{Row: p.Row, Column: ByteColumn(line, p.Column)}, nil
+}
+
+// ApplyUTF16Edit is like [Document.ApplyPointEdit], for the positions with
+// UTF-16 columns (e.g. the LSP ones), see [Document.PointFromUTF16].
+func (d *Document) ApplyUTF16Edit(ctx context.Context, start, oldEnd Point, newText []byte) ([]Range, error) {
+	start, err := d.PointFromUTF16(start)
+	if err != nil {
+		return nil, err
+	}
+
+	oldEnd, err = d.PointFromUTF16(oldEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.ApplyPointEdit(ctx, start, oldEnd, newText)
+}
@@ -0,0 +1,135 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"unicode/utf16"
+	"unsafe"
+)
+
+func TestUTF16Column(t *testing.T) {
+	t.Parallel()
+
+	line := []byte("aé😀b")
+
+	for col, exp := range map[uint]uint{0: 0, 1: 1, 3: 2, 7: 4, 8: 5, 100: 5} {
+		if act := UTF16Column(line, col); act != exp {
+			t.Fatalf("Expected %d for %d, got %d", exp, col, act)
+		}
+	}
+
+	if act := UTF16Column([]byte("\xffa"), 2); act != 2 {
+		t.Fatal("Expected invalid bytes to count as one code unit, got", act)
+	}
+}
+
+func TestByteColumn(t *testing.T) {
+	t.Parallel()
+
+	line := []byte("aé😀b")
+
+	for col16, exp := range map[uint]uint{0: 0, 1: 1, 2: 3, 3: 3, 4: 7, 5: 8, 100: 8} {
+		if act := ByteColumn(line, col16); act != exp {
+			t.Fatalf("Expected %d for %d, got %d", exp, col16, act)
+		}
+	}
+}
+
+func TestNodeContentUTF16(t *testing.T) {
+	t.Parallel()
+
+	src := utf16.Encode([]rune("1 + 2 // é😀"))
+	// In the native byte order, as tree-sitter expects.
+	b := unsafe.Slice((*byte)(unsafe.Pointer(&src[0])), 2*len(src))
+
+	p := NewParser()
+	p.SetLanguage(gr)
+
+	tree, err := p.ParseString(context.Background(), nil, b, InputEncodingUTF16)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	root := tree.RootNode()
+	comment := root.NamedChild(root.NamedChildCount() - 1)
+
+	if act, exp := comment.ContentUTF16(src), "// é😀"; act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+}
+
+func TestDocumentUTF16Point(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewDocument(context.Background(), gr, []byte("1 + 2\n// é😀 x"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for p, exp := range map[Point]Point{
+		{Column: 3}:         {Column: 3},
+		{Row: 1, Column: 5}: {Row: 1, Column: 4},
+		{Row: 1, Column: 9}: {Row: 1, Column: 6},
+	} {
+		if act, err := d.UTF16Point(p); err != nil || act != exp {
+			t.Fatalf("Expected %v for %v, got %v (%v)", exp, p, act, err)
+		}
+	}
+
+	for _, p := range []Point{{Row: 2}, {Column: 6}} {
+		if _, err = d.UTF16Point(p); !errors.Is(err, ErrInvalidEdit) {
+			t.Fatalf("Expected %v for %v, got %v", ErrInvalidEdit, p, err)
+		}
+	}
+}
+
+func TestDocumentPointFromUTF16(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewDocument(context.Background(), gr, []byte("1 + 2\n// é😀 x"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	for p, exp := range map[Point]Point{
+		{Column: 3}:          {Column: 3},
+		{Column: 30}:         {Column: 5},
+		{Row: 1, Column: 4}:  {Row: 1, Column: 5},
+		{Row: 1, Column: 6}:  {Row: 1, Column: 9},
+		{Row: 1, Column: 30}: {Row: 1, Column: 11},
+	} {
+		if act, err := d.PointFromUTF16(p); err != nil || act != exp {
+			t.Fatalf("Expected %v for %v, got %v (%v)", exp, p, act, err)
+		}
+	}
+
+	if _, err = d.PointFromUTF16(Point{Row: 2}); !errors.Is(err, ErrInvalidEdit) {
+		t.Fatalf("Expected %v, got %v", ErrInvalidEdit, err)
+	}
+}
+
+func TestDocumentApplyUTF16Edit(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewDocument(context.Background(), gr, []byte("// é😀 x\n1 + 2"))
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	// Replaces the "😀" (2 code units).
+	if _, err = d.ApplyUTF16Edit(context.Background(), Point{Column: 4}, Point{Column: 6}, []byte("y")); err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	if act, exp := string(d.Text()), "// éy x\n1 + 2"; act != exp {
+		t.Fatalf("Expected %q, got %q", exp, act)
+	}
+
+	testDocumentTree(t, d)
+
+	p := Point{Row: 3}
+	if _, err = d.ApplyUTF16Edit(context.Background(), p, p, nil); !errors.Is(err, ErrInvalidEdit) {
+		t.Fatalf("Expected %v, got %v", ErrInvalidEdit, err)
+	}
+}
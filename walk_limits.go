@@ -0,0 +1,45 @@
+package sitter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// WalkOption configures the limits of the built-in tree walkers (see
+// [NewIteratorWith] and [WalkStatsWith]).
+type WalkOption func(*walkLimits)
+
+type walkLimits struct {
+	maxDepth uint
+}
+
+// ErrMaxDepthExceeded is returned by the walkers reaching a node deeper than
+// allowed (see [WithMaxDepth]).
+var ErrMaxDepthExceeded = errors.New("max depth exceeded")
+
+// WithMaxDepth limits the depth of the nodes walked (relative to the walked
+// node, at depth 0) to n, the walkers stopping with [ErrMaxDepthExceeded] past
+// it, instead of exhausting the memory (or, in recursive code, the stack) on
+// pathological (e.g. deeply nested or adversarial) inputs. Zero means no limit.
+func WithMaxDepth(n uint) WalkOption {
+	return func(l *walkLimits) {
+		l.maxDepth = n
+	}
+}
+
+func newWalkLimits(opts []WalkOption) (l walkLimits) {
+	for _, opt := range opts {
+		opt(&l)
+	}
+
+	return
+}
+
+// check returns an error if the given depth is past the limit.
+func (l walkLimits) check(depth uint) error {
+	if l.maxDepth > 0 && depth > l.maxDepth {
+		return fmt.Errorf("%w: %d > %d", ErrMaxDepthExceeded, depth, l.maxDepth)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package sitter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithMaxDepth(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestNewWalkLimits(t *testing.T) {
+	t.Parallel()
+	t.Skip("tested implicitly")
+}
+
+func TestWalkLimitsCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		opts  []WalkOption
+		depth uint
+		exp   error
+	}{
+		{nil, 1000, nil},
+		{[]WalkOption{WithMaxDepth(0)}, 1000, nil},
+		{[]WalkOption{WithMaxDepth(2)}, 2, nil},
+		{[]WalkOption{WithMaxDepth(2)}, 3, ErrMaxDepthExceeded},
+	}
+
+	for _, tc := range testCases {
+		if err := newWalkLimits(tc.opts).check(tc.depth); !errors.Is(err, tc.exp) {
+			t.Fatalf("Expected %v at depth %d, got %v", tc.exp, tc.depth, err)
+		}
+	}
+}
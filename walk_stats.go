@@ -0,0 +1,94 @@
+package sitter
+
+// TreeStats holds the shape metrics of a (sub)tree, as computed by [WalkStats].
+type TreeStats struct {
+	// Depths is the depth histogram: Depths[d] is the number of nodes at depth d
+	// (relative to the walked node); len(Depths)-1 is the maximum depth.
+	Depths []uint
+	// Branching is the branching factor histogram: Branching[k] is the number
+	// of nodes having exactly k children (Branching[0] being the leaves).
+	Branching []uint
+	// Nodes is the total number of nodes.
+	Nodes uint
+}
+
+// WalkStats walks the tree rooted at n with a [TreeCursor] and returns its
+// depth and branching factor histograms, without materializing the nodes.
+// This is useful for tuning [QueryCursor.SetMaxStartDepth] and for diagnosing
+// pathological grammars (i.e. deep right-recursive lists).
+func WalkStats(n Node) TreeStats {
+	s, _ := WalkStatsWith(n) //nolint:errcheck // ok, it cannot fail without limits
+	return s
+}
+
+// WalkStatsWith is like [WalkStats], within the given walk limits (see
+// [WithMaxDepth]), returning the stats gathered so far along with the error,
+// once past them.
+func WalkStatsWith(n Node, opts ...WalkOption) (s TreeStats, err error) {
+	limits := newWalkLimits(opts)
+	c := NewTreeCursor(n)
+	counts := []uint{} // The children count for each node on the current path.
+
+	enter := func() {
+		d := len(counts)
+		if d > 0 {
+			counts[d-1]++
+		}
+
+		if d == len(s.Depths) {
+			s.Depths = append(s.Depths, 0)
+		}
+
+		s.Depths[d]++
+		s.Nodes++
+		counts = append(counts, 0)
+	}
+
+	leave := func() {
+		k := counts[len(counts)-1]
+		counts = counts[:len(counts)-1]
+
+		for uint(len(s.Branching)) <= k {
+			s.Branching = append(s.Branching, 0)
+		}
+
+		s.Branching[k]++
+	}
+
+	for enter(); ; enter() {
+		if c.GoToFirstChild() {
+			if err = limits.check(uint(len(counts))); err != nil {
+				return
+			}
+
+			continue
+		}
+
+		for leave(); !c.GoToNextSibling(); leave() {
+			if len(counts) == 0 || !c.GoToParent() {
+				return
+			}
+		}
+	}
+}
+
+// MaxDepth returns the maximum depth of the tree.
+func (s TreeStats) MaxDepth() int {
+	return len(s.Depths) - 1
+}
+
+// MeanBranching returns the average number of children of the non leaf nodes.
+func (s TreeStats) MeanBranching() float64 {
+	var internal, children uint
+
+	for k, count := range s.Branching[min(1, len(s.Branching)):] {
+		internal += count
+		children += uint(k+1) * count
+	}
+
+	if internal == 0 {
+		return 0
+	}
+
+	return float64(children) / float64(internal)
+}
@@ -0,0 +1,74 @@
+package sitter
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestWalkStats(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := TreeStats{Depths: []uint{1, 1, 3, 2}, Branching: []uint{3, 3, 0, 1}, Nodes: 7}
+	if act := WalkStats(root); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+
+	exp = TreeStats{Depths: []uint{1}, Branching: []uint{1}, Nodes: 1}
+	if act := WalkStats(root.Child(0).Child(1)); !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v", exp, act)
+	}
+}
+
+func TestTreeStatsMaxDepth(t *testing.T) {
+	t.Parallel()
+
+	if act := (TreeStats{Depths: []uint{1, 1, 3, 2}}).MaxDepth(); act != 3 {
+		t.Fatal("Expected 3, got", act)
+	}
+}
+
+func TestTreeStatsMeanBranching(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		branching []uint
+		exp       float64
+	}{
+		{nil, 0},
+		{[]uint{1}, 0},
+		{[]uint{3, 3, 0, 1}, 1.5},
+	}
+
+	for _, tc := range testCases {
+		if act := (TreeStats{Branching: tc.branching}).MeanBranching(); act != tc.exp {
+			t.Fatalf("Expected %v, got %v", tc.exp, act)
+		}
+	}
+}
+
+func TestWalkStatsWith(t *testing.T) {
+	t.Parallel()
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), gr)
+	if err != nil {
+		t.Fatal("Expected no error, got", err)
+	}
+
+	exp := TreeStats{Depths: []uint{1, 1, 3, 2}, Branching: []uint{3, 3, 0, 1}, Nodes: 7}
+	if act, err := WalkStatsWith(root, WithMaxDepth(3)); err != nil || !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v, got %+v (%v)", exp, act, err)
+	}
+
+	exp = TreeStats{Depths: []uint{1, 1, 1}, Nodes: 3}
+	act, err := WalkStatsWith(root, WithMaxDepth(2))
+	if !errors.Is(err, ErrMaxDepthExceeded) || !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected %+v and %v, got %+v and %v", exp, ErrMaxDepthExceeded, act, err)
+	}
+}